@@ -4,6 +4,7 @@
 
 import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
 import { enumDesc, fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import { file_buf_validate_validate } from "../../buf/validate/validate_pb";
 import type { Timestamp } from "@bufbuild/protobuf/wkt";
 import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
 import type { Message } from "@bufbuild/protobuf";
@@ -12,7 +13,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file ai/v1/ai_tasks.proto.
  */
 export const file_ai_v1_ai_tasks: GenFile = /*@__PURE__*/
-  fileDesc("ChRhaS92MS9haV90YXNrcy5wcm90bxIFYWkudjEirQEKBkFJVGFzaxIKCgJpZBgBIAEoCRISCgpwcm9qZWN0X2lkGAIgASgJEg8KB3VzZXJfaWQYAyABKAkSDAoEdHlwZRgEIAEoCRIPCgdwYXlsb2FkGAUgASgMEiMKBnN0YXR1cxgGIAEoDjITLmFpLnYxLkFJVGFza1N0YXR1cxIuCgpjcmVhdGVkX2F0GAcgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCKVAQoMQUlUYXNrUmVzdWx0Eg8KB3Rhc2tfaWQYASABKAkSIwoGc3RhdHVzGAIgASgOMhMuYWkudjEuQUlUYXNrU3RhdHVzEg4KBm91dHB1dBgDIAEoDBINCgVlcnJvchgEIAEoCRIwCgxjb21wbGV0ZWRfYXQYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIkUKEFJ1bkFJVGFza1JlcXVlc3QSEgoKcHJvamVjdF9pZBgBIAEoCRIMCgR0eXBlGAIgASgJEg8KB3BheWxvYWQYAyABKAwiZgoRUnVuQUlUYXNrUmVzcG9uc2USDwoHdGFza19pZBgBIAEoCRIjCgZzdGF0dXMYAiABKA4yEy5haS52MS5BSVRhc2tTdGF0dXMSDQoFY2h1bmsYAyABKAkSDAoEZG9uZRgEIAEoCCqaAQoMQUlUYXNrU3RhdHVzEh4KGkFJX1RBU0tfU1RBVFVTX1VOU1BFQ0lGSUVEEAASGgoWQUlfVEFTS19TVEFUVVNfUEVORElORxABEhoKFkFJX1RBU0tfU1RBVFVTX1JVTk5JTkcQAhIXChNBSV9UQVNLX1NUQVRVU19ET05FEAMSGQoVQUlfVEFTS19TVEFUVVNfRkFJTEVEEAQyUQoNQUlUYXNrU2VydmljZRJACglSdW5BSVRhc2sSFy5haS52MS5SdW5BSVRhc2tSZXF1ZXN0GhguYWkudjEuUnVuQUlUYXNrUmVzcG9uc2UwAUJAWj5naXRodWIuY29tL0FwZWlyb25Gb3VuZGF0aW9uL2F4bGUvY29udHJhY3RzL2dvL2FpL3YxO2dlbl9haV92MWIGcHJvdG8z", [file_google_protobuf_timestamp]);
+  fileDesc("ChRhaS92MS9haV90YXNrcy5wcm90bxIFYWkudjEirQEKBkFJVGFzaxIKCgJpZBgBIAEoCRISCgpwcm9qZWN0X2lkGAIgASgJEg8KB3VzZXJfaWQYAyABKAkSDAoEdHlwZRgEIAEoCRIPCgdwYXlsb2FkGAUgASgMEiMKBnN0YXR1cxgGIAEoDjITLmFpLnYxLkFJVGFza1N0YXR1cxIuCgpjcmVhdGVkX2F0GAcgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCKVAQoMQUlUYXNrUmVzdWx0Eg8KB3Rhc2tfaWQYASABKAkSIwoGc3RhdHVzGAIgASgOMhMuYWkudjEuQUlUYXNrU3RhdHVzEg4KBm91dHB1dBgDIAEoDBINCgVlcnJvchgEIAEoCRIwCgxjb21wbGV0ZWRfYXQYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wInoKEFJ1bkFJVGFza1JlcXVlc3QSHAoKcHJvamVjdF9pZBgBIAEoCUIIukgFcgOwAQESLAoEdHlwZRgCIAEoCUIeukgbchkQARhAMhNeW2Etel1bYS16MC05Xy4tXSokEhoKB3BheWxvYWQYAyABKAxCCbpIBnoEGICAQCJmChFSdW5BSVRhc2tSZXNwb25zZRIPCgd0YXNrX2lkGAEgASgJEiMKBnN0YXR1cxgCIAEoDjITLmFpLnYxLkFJVGFza1N0YXR1cxINCgVjaHVuaxgDIAEoCRIMCgRkb25lGAQgASgIKpoBCgxBSVRhc2tTdGF0dXMSHgoaQUlfVEFTS19TVEFUVVNfVU5TUEVDSUZJRUQQABIaChZBSV9UQVNLX1NUQVRVU19QRU5ESU5HEAESGgoWQUlfVEFTS19TVEFUVVNfUlVOTklORxACEhcKE0FJX1RBU0tfU1RBVFVTX0RPTkUQAxIZChVBSV9UQVNLX1NUQVRVU19GQUlMRUQQBDJRCg1BSVRhc2tTZXJ2aWNlEkAKCVJ1bkFJVGFzaxIXLmFpLnYxLlJ1bkFJVGFza1JlcXVlc3QaGC5haS52MS5SdW5BSVRhc2tSZXNwb25zZTABQkBaPmdpdGh1Yi5jb20vQXBlaXJvbkZvdW5kYXRpb24vYXhsZS9jb250cmFjdHMvZ28vYWkvdjE7Z2VuX2FpX3YxYgZwcm90bzM", [file_buf_validate_validate, file_google_protobuf_timestamp]);
 
 /**
  * AITask is the NATS-serialised envelope shared between Gateway and AI Service.
@@ -112,6 +113,8 @@ export type RunAITaskRequest = Message<"ai.v1.RunAITaskRequest"> & {
   projectId: string;
 
   /**
+   * type names the task kind, e.g. "summarize" or "chat.completion".
+   *
    * @generated from field: string type = 2;
    */
   type: string;
@@ -0,0 +1,231 @@
+// @generated by protoc-gen-es v2.11.0 with parameter "target=ts"
+// @generated from file bff/v1/access_tokens.proto (package bff.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import { file_buf_validate_validate } from "../../buf/validate/validate_pb";
+import type { Timestamp } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file bff/v1/access_tokens.proto.
+ */
+export const file_bff_v1_access_tokens: GenFile = /*@__PURE__*/
+  fileDesc("ChpiZmYvdjEvYWNjZXNzX3Rva2Vucy5wcm90bxIGYmZmLnYxIokCCgtBY2Nlc3NUb2tlbhIKCgJpZBgBIAEoCRIMCgRuYW1lGAIgASgJEg4KBnByZWZpeBgDIAEoCRIOCgZzY29wZXMYBCADKAkSLgoKZXhwaXJlc19hdBgFIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASMAoMbGFzdF91c2VkX2F0GAYgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgpyZXZva2VkX2F0GAcgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgpjcmVhdGVkX2F0GAggASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCLOAQoYQ3JlYXRlQWNjZXNzVG9rZW5SZXF1ZXN0EhkKBG5hbWUYASABKAlCC7pICHIGGGQyAlxTEl0KBnNjb3BlcxgCIAMoCUJNukhKkgFHCAEYASJBcj9SDXByb2plY3RzOnJlYWRSDnByb2plY3RzOndyaXRlUgp1c2VyczpyZWFkUgZhaTpydW5SCmF1ZGl0OnJlYWQSOAoKZXhwaXJlc19hdBgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCCLpIBbIBAkABIlUKGUNyZWF0ZUFjY2Vzc1Rva2VuUmVzcG9uc2USKQoMYWNjZXNzX3Rva2VuGAEgASgLMhMuYmZmLnYxLkFjY2Vzc1Rva2VuEg0KBXRva2VuGAIgASgJIhkKF0xpc3RBY2Nlc3NUb2tlbnNSZXF1ZXN0IkYKGExpc3RBY2Nlc3NUb2tlbnNSZXNwb25zZRIqCg1hY2Nlc3NfdG9rZW5zGAEgAygLMhMuYmZmLnYxLkFjY2Vzc1Rva2VuIjAKGFJldm9rZUFjY2Vzc1Rva2VuUmVxdWVzdBIUCgJpZBgBIAEoCUIIukgFcgOwAQEiGwoZUmV2b2tlQWNjZXNzVG9rZW5SZXNwb25zZTKfAgoSQWNjZXNzVG9rZW5TZXJ2aWNlElgKEUNyZWF0ZUFjY2Vzc1Rva2VuEiAuYmZmLnYxLkNyZWF0ZUFjY2Vzc1Rva2VuUmVxdWVzdBohLmJmZi52MS5DcmVhdGVBY2Nlc3NUb2tlblJlc3BvbnNlElUKEExpc3RBY2Nlc3NUb2tlbnMSHy5iZmYudjEuTGlzdEFjY2Vzc1Rva2Vuc1JlcXVlc3QaIC5iZmYudjEuTGlzdEFjY2Vzc1Rva2Vuc1Jlc3BvbnNlElgKEVJldm9rZUFjY2Vzc1Rva2VuEiAuYmZmLnYxLlJldm9rZUFjY2Vzc1Rva2VuUmVxdWVzdBohLmJmZi52MS5SZXZva2VBY2Nlc3NUb2tlblJlc3BvbnNlQkJaQGdpdGh1Yi5jb20vQXBlaXJvbkZvdW5kYXRpb24vYXhsZS9jb250cmFjdHMvZ28vYmZmL3YxO2dlbl9iZmZfdjFiBnByb3RvMw", [file_buf_validate_validate, file_google_protobuf_timestamp]);
+
+/**
+ * AccessToken describes a personal access token. The secret itself is only
+ * returned once, by CreateAccessToken.
+ *
+ * @generated from message bff.v1.AccessToken
+ */
+export type AccessToken = Message<"bff.v1.AccessToken"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * prefix is the start of the secret ("axle_pat_AbCd"), for telling tokens apart.
+   *
+   * @generated from field: string prefix = 3;
+   */
+  prefix: string;
+
+  /**
+   * @generated from field: repeated string scopes = 4;
+   */
+  scopes: string[];
+
+  /**
+   * expires_at is unset for tokens that never expire.
+   *
+   * @generated from field: google.protobuf.Timestamp expires_at = 5;
+   */
+  expiresAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp last_used_at = 6;
+   */
+  lastUsedAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp revoked_at = 7;
+   */
+  revokedAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 8;
+   */
+  createdAt?: Timestamp;
+};
+
+/**
+ * Describes the message bff.v1.AccessToken.
+ * Use `create(AccessTokenSchema)` to create a new message.
+ */
+export const AccessTokenSchema: GenMessage<AccessToken> = /*@__PURE__*/
+  messageDesc(file_bff_v1_access_tokens, 0);
+
+/**
+ * Scopes limit what a token can call:
+ *   projects:read  - list and read projects, their settings and members
+ *   projects:write - create, update and delete projects and manage members
+ *   users:read     - list and read users
+ *   ai:run         - run AI tasks
+ *   audit:read     - read and export the audit log (admins only)
+ *
+ * @generated from message bff.v1.CreateAccessTokenRequest
+ */
+export type CreateAccessTokenRequest = Message<"bff.v1.CreateAccessTokenRequest"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * @generated from field: repeated string scopes = 2;
+   */
+  scopes: string[];
+
+  /**
+   * expires_at must be in the future; leave unset for a token that never expires.
+   *
+   * @generated from field: google.protobuf.Timestamp expires_at = 3;
+   */
+  expiresAt?: Timestamp;
+};
+
+/**
+ * Describes the message bff.v1.CreateAccessTokenRequest.
+ * Use `create(CreateAccessTokenRequestSchema)` to create a new message.
+ */
+export const CreateAccessTokenRequestSchema: GenMessage<CreateAccessTokenRequest> = /*@__PURE__*/
+  messageDesc(file_bff_v1_access_tokens, 1);
+
+/**
+ * @generated from message bff.v1.CreateAccessTokenResponse
+ */
+export type CreateAccessTokenResponse = Message<"bff.v1.CreateAccessTokenResponse"> & {
+  /**
+   * @generated from field: bff.v1.AccessToken access_token = 1;
+   */
+  accessToken?: AccessToken;
+
+  /**
+   * token is the secret to send as "Authorization: Bearer <token>". It cannot
+   * be retrieved again.
+   *
+   * @generated from field: string token = 2;
+   */
+  token: string;
+};
+
+/**
+ * Describes the message bff.v1.CreateAccessTokenResponse.
+ * Use `create(CreateAccessTokenResponseSchema)` to create a new message.
+ */
+export const CreateAccessTokenResponseSchema: GenMessage<CreateAccessTokenResponse> = /*@__PURE__*/
+  messageDesc(file_bff_v1_access_tokens, 2);
+
+/**
+ * @generated from message bff.v1.ListAccessTokensRequest
+ */
+export type ListAccessTokensRequest = Message<"bff.v1.ListAccessTokensRequest"> & {
+};
+
+/**
+ * Describes the message bff.v1.ListAccessTokensRequest.
+ * Use `create(ListAccessTokensRequestSchema)` to create a new message.
+ */
+export const ListAccessTokensRequestSchema: GenMessage<ListAccessTokensRequest> = /*@__PURE__*/
+  messageDesc(file_bff_v1_access_tokens, 3);
+
+/**
+ * @generated from message bff.v1.ListAccessTokensResponse
+ */
+export type ListAccessTokensResponse = Message<"bff.v1.ListAccessTokensResponse"> & {
+  /**
+   * @generated from field: repeated bff.v1.AccessToken access_tokens = 1;
+   */
+  accessTokens: AccessToken[];
+};
+
+/**
+ * Describes the message bff.v1.ListAccessTokensResponse.
+ * Use `create(ListAccessTokensResponseSchema)` to create a new message.
+ */
+export const ListAccessTokensResponseSchema: GenMessage<ListAccessTokensResponse> = /*@__PURE__*/
+  messageDesc(file_bff_v1_access_tokens, 4);
+
+/**
+ * @generated from message bff.v1.RevokeAccessTokenRequest
+ */
+export type RevokeAccessTokenRequest = Message<"bff.v1.RevokeAccessTokenRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message bff.v1.RevokeAccessTokenRequest.
+ * Use `create(RevokeAccessTokenRequestSchema)` to create a new message.
+ */
+export const RevokeAccessTokenRequestSchema: GenMessage<RevokeAccessTokenRequest> = /*@__PURE__*/
+  messageDesc(file_bff_v1_access_tokens, 5);
+
+/**
+ * @generated from message bff.v1.RevokeAccessTokenResponse
+ */
+export type RevokeAccessTokenResponse = Message<"bff.v1.RevokeAccessTokenResponse"> & {
+};
+
+/**
+ * Describes the message bff.v1.RevokeAccessTokenResponse.
+ * Use `create(RevokeAccessTokenResponseSchema)` to create a new message.
+ */
+export const RevokeAccessTokenResponseSchema: GenMessage<RevokeAccessTokenResponse> = /*@__PURE__*/
+  messageDesc(file_bff_v1_access_tokens, 6);
+
+/**
+ * AccessTokenService manages the caller's personal access tokens. It only
+ * accepts OIDC sessions, so a token can never mint or revoke tokens.
+ *
+ * @generated from service bff.v1.AccessTokenService
+ */
+export const AccessTokenService: GenService<{
+  /**
+   * @generated from rpc bff.v1.AccessTokenService.CreateAccessToken
+   */
+  createAccessToken: {
+    methodKind: "unary";
+    input: typeof CreateAccessTokenRequestSchema;
+    output: typeof CreateAccessTokenResponseSchema;
+  },
+  /**
+   * @generated from rpc bff.v1.AccessTokenService.ListAccessTokens
+   */
+  listAccessTokens: {
+    methodKind: "unary";
+    input: typeof ListAccessTokensRequestSchema;
+    output: typeof ListAccessTokensResponseSchema;
+  },
+  /**
+   * @generated from rpc bff.v1.AccessTokenService.RevokeAccessToken
+   */
+  revokeAccessToken: {
+    methodKind: "unary";
+    input: typeof RevokeAccessTokenRequestSchema;
+    output: typeof RevokeAccessTokenResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_bff_v1_access_tokens, 0);
+
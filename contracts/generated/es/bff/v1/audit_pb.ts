@@ -0,0 +1,194 @@
+// @generated by protoc-gen-es v2.11.0 with parameter "target=ts"
+// @generated from file bff/v1/audit.proto (package bff.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import { file_buf_validate_validate } from "../../buf/validate/validate_pb";
+import type { Timestamp } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_struct, file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
+import type { JsonObject, Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file bff/v1/audit.proto.
+ */
+export const file_bff_v1_audit: GenFile = /*@__PURE__*/
+  fileDesc("ChJiZmYvdjEvYXVkaXQucHJvdG8SBmJmZi52MSL/AQoKQXVkaXRFdmVudBIKCgJpZBgBIAEoCRIvCgtvY2N1cnJlZF9hdBgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASEAoIYWN0b3JfaWQYAyABKAkSFgoOYWN0b3JfdG9rZW5faWQYBCABKAkSEQoJcHJvY2VkdXJlGAUgASgJEhMKC2VudGl0eV90eXBlGAYgASgJEhEKCWVudGl0eV9pZBgHIAEoCRIoCgdjaGFuZ2VzGAggASgLMhcuZ29vZ2xlLnByb3RvYnVmLlN0cnVjdBISCgpyZXF1ZXN0X2lkGAkgASgJEhEKCWNsaWVudF9pcBgKIAEoCSKrAwoWTGlzdEF1ZGl0RXZlbnRzUmVxdWVzdBIdCghhY3Rvcl9pZBgBIAEoCUILukgI2AEBcgOwAQESVgoLZW50aXR5X3R5cGUYAiABKAlCQbpIPtgBAXI5Ugdwcm9qZWN0Ug5wcm9qZWN0X21lbWJlclIEdXNlclIMYWNjZXNzX3Rva2VuUgppbnZpdGF0aW9uEh4KCWVudGl0eV9pZBgDIAEoCUILukgI2AEBcgOwAQESKQoFc2luY2UYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEikKBXVudGlsGAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIaCglwYWdlX3NpemUYBiABKAVCB7pIBBoCKAASEgoKcGFnZV90b2tlbhgHIAEoCTp0ukhxGm8KEXVudGlsX2FmdGVyX3NpbmNlEhl1bnRpbCBtdXN0IGJlIGFmdGVyIHNpbmNlGj8haGFzKHRoaXMuc2luY2UpIHx8ICFoYXModGhpcy51bnRpbCkgfHwgdGhpcy51bnRpbCA+IHRoaXMuc2luY2UiVgoXTGlzdEF1ZGl0RXZlbnRzUmVzcG9uc2USIgoGZXZlbnRzGAEgAygLMhIuYmZmLnYxLkF1ZGl0RXZlbnQSFwoPbmV4dF9wYWdlX3Rva2VuGAIgASgJMmIKDEF1ZGl0U2VydmljZRJSCg9MaXN0QXVkaXRFdmVudHMSHi5iZmYudjEuTGlzdEF1ZGl0RXZlbnRzUmVxdWVzdBofLmJmZi52MS5MaXN0QXVkaXRFdmVudHNSZXNwb25zZUJCWkBnaXRodWIuY29tL0FwZWlyb25Gb3VuZGF0aW9uL2F4bGUvY29udHJhY3RzL2dvL2JmZi92MTtnZW5fYmZmX3YxYgZwcm90bzM", [file_buf_validate_validate, file_google_protobuf_struct, file_google_protobuf_timestamp]);
+
+/**
+ * AuditEvent records one change made through the BFF.
+ *
+ * @generated from message bff.v1.AuditEvent
+ */
+export type AuditEvent = Message<"bff.v1.AuditEvent"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp occurred_at = 2;
+   */
+  occurredAt?: Timestamp;
+
+  /**
+   * actor_id is the user who made the call; empty when the BFF runs without
+   * authentication.
+   *
+   * @generated from field: string actor_id = 3;
+   */
+  actorId: string;
+
+  /**
+   * actor_token_id is set when the call was made with a personal access token.
+   *
+   * @generated from field: string actor_token_id = 4;
+   */
+  actorTokenId: string;
+
+  /**
+   * procedure is the RPC that made the change, e.g. "/bff.v1.ProjectService/UpdateProject".
+   *
+   * @generated from field: string procedure = 5;
+   */
+  procedure: string;
+
+  /**
+   * entity_type is one of "project", "project_member", "user", "access_token"
+   * or "invitation".
+   *
+   * @generated from field: string entity_type = 6;
+   */
+  entityType: string;
+
+  /**
+   * @generated from field: string entity_id = 7;
+   */
+  entityId: string;
+
+  /**
+   * changes maps each changed field to {"before": ..., "after": ...}. Nested
+   * settings use dotted paths such as "settings.ai_model".
+   *
+   * @generated from field: google.protobuf.Struct changes = 8;
+   */
+  changes?: JsonObject;
+
+  /**
+   * @generated from field: string request_id = 9;
+   */
+  requestId: string;
+
+  /**
+   * @generated from field: string client_ip = 10;
+   */
+  clientIp: string;
+};
+
+/**
+ * Describes the message bff.v1.AuditEvent.
+ * Use `create(AuditEventSchema)` to create a new message.
+ */
+export const AuditEventSchema: GenMessage<AuditEvent> = /*@__PURE__*/
+  messageDesc(file_bff_v1_audit, 0);
+
+/**
+ * Events are returned newest first. Pagination is keyset-based: pass the
+ * previous next_page_token as page_token with the same filters.
+ *
+ * @generated from message bff.v1.ListAuditEventsRequest
+ */
+export type ListAuditEventsRequest = Message<"bff.v1.ListAuditEventsRequest"> & {
+  /**
+   * @generated from field: string actor_id = 1;
+   */
+  actorId: string;
+
+  /**
+   * @generated from field: string entity_type = 2;
+   */
+  entityType: string;
+
+  /**
+   * @generated from field: string entity_id = 3;
+   */
+  entityId: string;
+
+  /**
+   * since is inclusive and until exclusive.
+   *
+   * @generated from field: google.protobuf.Timestamp since = 4;
+   */
+  since?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp until = 5;
+   */
+  until?: Timestamp;
+
+  /**
+   * page_size is clamped to 100; zero selects the default of 20.
+   *
+   * @generated from field: int32 page_size = 6;
+   */
+  pageSize: number;
+
+  /**
+   * @generated from field: string page_token = 7;
+   */
+  pageToken: string;
+};
+
+/**
+ * Describes the message bff.v1.ListAuditEventsRequest.
+ * Use `create(ListAuditEventsRequestSchema)` to create a new message.
+ */
+export const ListAuditEventsRequestSchema: GenMessage<ListAuditEventsRequest> = /*@__PURE__*/
+  messageDesc(file_bff_v1_audit, 1);
+
+/**
+ * @generated from message bff.v1.ListAuditEventsResponse
+ */
+export type ListAuditEventsResponse = Message<"bff.v1.ListAuditEventsResponse"> & {
+  /**
+   * @generated from field: repeated bff.v1.AuditEvent events = 1;
+   */
+  events: AuditEvent[];
+
+  /**
+   * next_page_token is empty on the last page.
+   *
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken: string;
+};
+
+/**
+ * Describes the message bff.v1.ListAuditEventsResponse.
+ * Use `create(ListAuditEventsResponseSchema)` to create a new message.
+ */
+export const ListAuditEventsResponseSchema: GenMessage<ListAuditEventsResponse> = /*@__PURE__*/
+  messageDesc(file_bff_v1_audit, 2);
+
+/**
+ * AuditService reads the audit log. It is open to global admins only.
+ *
+ * For SIEM ingestion the same filters are served as newline-delimited JSON,
+ * oldest first, by GET /audit/events.ndjson with the query parameters
+ * actor_id, entity_type, entity_id, since and until (RFC 3339). Each line is
+ * an AuditEvent in its JSON form.
+ *
+ * @generated from service bff.v1.AuditService
+ */
+export const AuditService: GenService<{
+  /**
+   * @generated from rpc bff.v1.AuditService.ListAuditEvents
+   */
+  listAuditEvents: {
+    methodKind: "unary";
+    input: typeof ListAuditEventsRequestSchema;
+    output: typeof ListAuditEventsResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_bff_v1_audit, 0);
+
@@ -0,0 +1,372 @@
+// @generated by protoc-gen-es v2.11.0 with parameter "target=ts"
+// @generated from file bff/v1/invitations.proto (package bff.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { enumDesc, fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import type { ProjectMember } from "./project_members_pb";
+import { file_bff_v1_project_members } from "./project_members_pb";
+import type { UserRole } from "./users_pb";
+import { file_bff_v1_users } from "./users_pb";
+import { file_buf_validate_validate } from "../../buf/validate/validate_pb";
+import type { Timestamp } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file bff/v1/invitations.proto.
+ */
+export const file_bff_v1_invitations: GenFile = /*@__PURE__*/
+  fileDesc("ChhiZmYvdjEvaW52aXRhdGlvbnMucHJvdG8SBmJmZi52MSKrAgoKSW52aXRhdGlvbhIKCgJpZBgBIAEoCRISCgpwcm9qZWN0X2lkGAIgASgJEg0KBWVtYWlsGAMgASgJEh4KBHJvbGUYBCABKA4yEC5iZmYudjEuVXNlclJvbGUSKAoGc3RhdHVzGAUgASgOMhguYmZmLnYxLkludml0YXRpb25TdGF0dXMSEgoKaW52aXRlZF9ieRgGIAEoCRIuCgpleHBpcmVzX2F0GAcgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIwCgxyZXNwb25kZWRfYXQYCCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KCmNyZWF0ZWRfYXQYCSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIngKFkludml0ZVRvUHJvamVjdFJlcXVlc3QSHAoKcHJvamVjdF9pZBgBIAEoCUIIukgFcgOwAQESFgoFZW1haWwYAiABKAlCB7pIBHICYAESKAoEcm9sZRgDIAEoDjIQLmJmZi52MS5Vc2VyUm9sZUIIukgFggECEAEiVQoXSW52aXRlVG9Qcm9qZWN0UmVzcG9uc2USJgoKaW52aXRhdGlvbhgBIAEoCzISLmJmZi52MS5JbnZpdGF0aW9uEhIKCmVtYWlsX3NlbnQYAiABKAgiNgoWTGlzdEludml0YXRpb25zUmVxdWVzdBIcCgpwcm9qZWN0X2lkGAEgASgJQgi6SAVyA7ABASJCChdMaXN0SW52aXRhdGlvbnNSZXNwb25zZRInCgtpbnZpdGF0aW9ucxgBIAMoCzISLmJmZi52MS5JbnZpdGF0aW9uIk0KF1Jldm9rZUludml0YXRpb25SZXF1ZXN0EhwKCnByb2plY3RfaWQYASABKAlCCLpIBXIDsAEBEhQKAmlkGAIgASgJQgi6SAVyA7ABASJCChhSZXZva2VJbnZpdGF0aW9uUmVzcG9uc2USJgoKaW52aXRhdGlvbhgBIAEoCzISLmJmZi52MS5JbnZpdGF0aW9uIjQKF0FjY2VwdEludml0YXRpb25SZXF1ZXN0EhkKBXRva2VuGAEgASgJQgq6SAdyBRABGIACIkEKGEFjY2VwdEludml0YXRpb25SZXNwb25zZRIlCgZtZW1iZXIYASABKAsyFS5iZmYudjEuUHJvamVjdE1lbWJlciI1ChhEZWNsaW5lSW52aXRhdGlvblJlcXVlc3QSGQoFdG9rZW4YASABKAlCCrpIB3IFEAEYgAIiGwoZRGVjbGluZUludml0YXRpb25SZXNwb25zZSqzAQoQSW52aXRhdGlvblN0YXR1cxIhCh1JTlZJVEFUSU9OX1NUQVRVU19VTlNQRUNJRklFRBAAEh0KGUlOVklUQVRJT05fU1RBVFVTX1BFTkRJTkcQARIeChpJTlZJVEFUSU9OX1NUQVRVU19BQ0NFUFRFRBACEh4KGklOVklUQVRJT05fU1RBVFVTX0RFQ0xJTkVEEAMSHQoZSU5WSVRBVElPTl9TVEFUVVNfUkVWT0tFRBAEMsMDChFJbnZpdGF0aW9uU2VydmljZRJSCg9JbnZpdGVUb1Byb2plY3QSHi5iZmYudjEuSW52aXRlVG9Qcm9qZWN0UmVxdWVzdBofLmJmZi52MS5JbnZpdGVUb1Byb2plY3RSZXNwb25zZRJSCg9MaXN0SW52aXRhdGlvbnMSHi5iZmYudjEuTGlzdEludml0YXRpb25zUmVxdWVzdBofLmJmZi52MS5MaXN0SW52aXRhdGlvbnNSZXNwb25zZRJVChBSZXZva2VJbnZpdGF0aW9uEh8uYmZmLnYxLlJldm9rZUludml0YXRpb25SZXF1ZXN0GiAuYmZmLnYxLlJldm9rZUludml0YXRpb25SZXNwb25zZRJVChBBY2NlcHRJbnZpdGF0aW9uEh8uYmZmLnYxLkFjY2VwdEludml0YXRpb25SZXF1ZXN0GiAuYmZmLnYxLkFjY2VwdEludml0YXRpb25SZXNwb25zZRJYChFEZWNsaW5lSW52aXRhdGlvbhIgLmJmZi52MS5EZWNsaW5lSW52aXRhdGlvblJlcXVlc3QaIS5iZmYudjEuRGVjbGluZUludml0YXRpb25SZXNwb25zZUJCWkBnaXRodWIuY29tL0FwZWlyb25Gb3VuZGF0aW9uL2F4bGUvY29udHJhY3RzL2dvL2JmZi92MTtnZW5fYmZmX3YxYgZwcm90bzM", [file_bff_v1_project_members, file_bff_v1_users, file_buf_validate_validate, file_google_protobuf_timestamp]);
+
+/**
+ * Invitation offers a project role to an email address. The invitee receives
+ * a signed link and answers it after signing in with that address.
+ *
+ * @generated from message bff.v1.Invitation
+ */
+export type Invitation = Message<"bff.v1.Invitation"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string project_id = 2;
+   */
+  projectId: string;
+
+  /**
+   * @generated from field: string email = 3;
+   */
+  email: string;
+
+  /**
+   * @generated from field: bff.v1.UserRole role = 4;
+   */
+  role: UserRole;
+
+  /**
+   * @generated from field: bff.v1.InvitationStatus status = 5;
+   */
+  status: InvitationStatus;
+
+  /**
+   * invited_by is empty once the inviting user has been deleted.
+   *
+   * @generated from field: string invited_by = 6;
+   */
+  invitedBy: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp expires_at = 7;
+   */
+  expiresAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp responded_at = 8;
+   */
+  respondedAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 9;
+   */
+  createdAt?: Timestamp;
+};
+
+/**
+ * Describes the message bff.v1.Invitation.
+ * Use `create(InvitationSchema)` to create a new message.
+ */
+export const InvitationSchema: GenMessage<Invitation> = /*@__PURE__*/
+  messageDesc(file_bff_v1_invitations, 0);
+
+/**
+ * InviteToProject emails an invitation. Inviting an address again replaces its
+ * pending invitation, so it doubles as "resend".
+ *
+ * @generated from message bff.v1.InviteToProjectRequest
+ */
+export type InviteToProjectRequest = Message<"bff.v1.InviteToProjectRequest"> & {
+  /**
+   * @generated from field: string project_id = 1;
+   */
+  projectId: string;
+
+  /**
+   * @generated from field: string email = 2;
+   */
+  email: string;
+
+  /**
+   * role defaults to USER_ROLE_MEMBER.
+   *
+   * @generated from field: bff.v1.UserRole role = 3;
+   */
+  role: UserRole;
+};
+
+/**
+ * Describes the message bff.v1.InviteToProjectRequest.
+ * Use `create(InviteToProjectRequestSchema)` to create a new message.
+ */
+export const InviteToProjectRequestSchema: GenMessage<InviteToProjectRequest> = /*@__PURE__*/
+  messageDesc(file_bff_v1_invitations, 1);
+
+/**
+ * @generated from message bff.v1.InviteToProjectResponse
+ */
+export type InviteToProjectResponse = Message<"bff.v1.InviteToProjectResponse"> & {
+  /**
+   * @generated from field: bff.v1.Invitation invitation = 1;
+   */
+  invitation?: Invitation;
+
+  /**
+   * email_sent is false when the mail server could not be reached. The
+   * invitation stands; invite again to resend it.
+   *
+   * @generated from field: bool email_sent = 2;
+   */
+  emailSent: boolean;
+};
+
+/**
+ * Describes the message bff.v1.InviteToProjectResponse.
+ * Use `create(InviteToProjectResponseSchema)` to create a new message.
+ */
+export const InviteToProjectResponseSchema: GenMessage<InviteToProjectResponse> = /*@__PURE__*/
+  messageDesc(file_bff_v1_invitations, 2);
+
+/**
+ * ListInvitations returns the project's pending invitations, newest first,
+ * including ones that have expired without an answer.
+ *
+ * @generated from message bff.v1.ListInvitationsRequest
+ */
+export type ListInvitationsRequest = Message<"bff.v1.ListInvitationsRequest"> & {
+  /**
+   * @generated from field: string project_id = 1;
+   */
+  projectId: string;
+};
+
+/**
+ * Describes the message bff.v1.ListInvitationsRequest.
+ * Use `create(ListInvitationsRequestSchema)` to create a new message.
+ */
+export const ListInvitationsRequestSchema: GenMessage<ListInvitationsRequest> = /*@__PURE__*/
+  messageDesc(file_bff_v1_invitations, 3);
+
+/**
+ * @generated from message bff.v1.ListInvitationsResponse
+ */
+export type ListInvitationsResponse = Message<"bff.v1.ListInvitationsResponse"> & {
+  /**
+   * @generated from field: repeated bff.v1.Invitation invitations = 1;
+   */
+  invitations: Invitation[];
+};
+
+/**
+ * Describes the message bff.v1.ListInvitationsResponse.
+ * Use `create(ListInvitationsResponseSchema)` to create a new message.
+ */
+export const ListInvitationsResponseSchema: GenMessage<ListInvitationsResponse> = /*@__PURE__*/
+  messageDesc(file_bff_v1_invitations, 4);
+
+/**
+ * @generated from message bff.v1.RevokeInvitationRequest
+ */
+export type RevokeInvitationRequest = Message<"bff.v1.RevokeInvitationRequest"> & {
+  /**
+   * @generated from field: string project_id = 1;
+   */
+  projectId: string;
+
+  /**
+   * @generated from field: string id = 2;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message bff.v1.RevokeInvitationRequest.
+ * Use `create(RevokeInvitationRequestSchema)` to create a new message.
+ */
+export const RevokeInvitationRequestSchema: GenMessage<RevokeInvitationRequest> = /*@__PURE__*/
+  messageDesc(file_bff_v1_invitations, 5);
+
+/**
+ * @generated from message bff.v1.RevokeInvitationResponse
+ */
+export type RevokeInvitationResponse = Message<"bff.v1.RevokeInvitationResponse"> & {
+  /**
+   * @generated from field: bff.v1.Invitation invitation = 1;
+   */
+  invitation?: Invitation;
+};
+
+/**
+ * Describes the message bff.v1.RevokeInvitationResponse.
+ * Use `create(RevokeInvitationResponseSchema)` to create a new message.
+ */
+export const RevokeInvitationResponseSchema: GenMessage<RevokeInvitationResponse> = /*@__PURE__*/
+  messageDesc(file_bff_v1_invitations, 6);
+
+/**
+ * token is the one from the invitation link. The caller must be signed in with
+ * the invited email address.
+ *
+ * @generated from message bff.v1.AcceptInvitationRequest
+ */
+export type AcceptInvitationRequest = Message<"bff.v1.AcceptInvitationRequest"> & {
+  /**
+   * @generated from field: string token = 1;
+   */
+  token: string;
+};
+
+/**
+ * Describes the message bff.v1.AcceptInvitationRequest.
+ * Use `create(AcceptInvitationRequestSchema)` to create a new message.
+ */
+export const AcceptInvitationRequestSchema: GenMessage<AcceptInvitationRequest> = /*@__PURE__*/
+  messageDesc(file_bff_v1_invitations, 7);
+
+/**
+ * @generated from message bff.v1.AcceptInvitationResponse
+ */
+export type AcceptInvitationResponse = Message<"bff.v1.AcceptInvitationResponse"> & {
+  /**
+   * member is the caller's membership. Accepting does not lower the role of
+   * someone who is already a member.
+   *
+   * @generated from field: bff.v1.ProjectMember member = 1;
+   */
+  member?: ProjectMember;
+};
+
+/**
+ * Describes the message bff.v1.AcceptInvitationResponse.
+ * Use `create(AcceptInvitationResponseSchema)` to create a new message.
+ */
+export const AcceptInvitationResponseSchema: GenMessage<AcceptInvitationResponse> = /*@__PURE__*/
+  messageDesc(file_bff_v1_invitations, 8);
+
+/**
+ * @generated from message bff.v1.DeclineInvitationRequest
+ */
+export type DeclineInvitationRequest = Message<"bff.v1.DeclineInvitationRequest"> & {
+  /**
+   * @generated from field: string token = 1;
+   */
+  token: string;
+};
+
+/**
+ * Describes the message bff.v1.DeclineInvitationRequest.
+ * Use `create(DeclineInvitationRequestSchema)` to create a new message.
+ */
+export const DeclineInvitationRequestSchema: GenMessage<DeclineInvitationRequest> = /*@__PURE__*/
+  messageDesc(file_bff_v1_invitations, 9);
+
+/**
+ * @generated from message bff.v1.DeclineInvitationResponse
+ */
+export type DeclineInvitationResponse = Message<"bff.v1.DeclineInvitationResponse"> & {
+};
+
+/**
+ * Describes the message bff.v1.DeclineInvitationResponse.
+ * Use `create(DeclineInvitationResponseSchema)` to create a new message.
+ */
+export const DeclineInvitationResponseSchema: GenMessage<DeclineInvitationResponse> = /*@__PURE__*/
+  messageDesc(file_bff_v1_invitations, 10);
+
+/**
+ * @generated from enum bff.v1.InvitationStatus
+ */
+export enum InvitationStatus {
+  /**
+   * @generated from enum value: INVITATION_STATUS_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: INVITATION_STATUS_PENDING = 1;
+   */
+  PENDING = 1,
+
+  /**
+   * @generated from enum value: INVITATION_STATUS_ACCEPTED = 2;
+   */
+  ACCEPTED = 2,
+
+  /**
+   * @generated from enum value: INVITATION_STATUS_DECLINED = 3;
+   */
+  DECLINED = 3,
+
+  /**
+   * @generated from enum value: INVITATION_STATUS_REVOKED = 4;
+   */
+  REVOKED = 4,
+}
+
+/**
+ * Describes the enum bff.v1.InvitationStatus.
+ */
+export const InvitationStatusSchema: GenEnum<InvitationStatus> = /*@__PURE__*/
+  enumDesc(file_bff_v1_invitations, 0);
+
+/**
+ * InvitationService invites people to projects by email. Project admins
+ * invite, list and revoke; invitees accept or decline.
+ *
+ * @generated from service bff.v1.InvitationService
+ */
+export const InvitationService: GenService<{
+  /**
+   * @generated from rpc bff.v1.InvitationService.InviteToProject
+   */
+  inviteToProject: {
+    methodKind: "unary";
+    input: typeof InviteToProjectRequestSchema;
+    output: typeof InviteToProjectResponseSchema;
+  },
+  /**
+   * @generated from rpc bff.v1.InvitationService.ListInvitations
+   */
+  listInvitations: {
+    methodKind: "unary";
+    input: typeof ListInvitationsRequestSchema;
+    output: typeof ListInvitationsResponseSchema;
+  },
+  /**
+   * @generated from rpc bff.v1.InvitationService.RevokeInvitation
+   */
+  revokeInvitation: {
+    methodKind: "unary";
+    input: typeof RevokeInvitationRequestSchema;
+    output: typeof RevokeInvitationResponseSchema;
+  },
+  /**
+   * @generated from rpc bff.v1.InvitationService.AcceptInvitation
+   */
+  acceptInvitation: {
+    methodKind: "unary";
+    input: typeof AcceptInvitationRequestSchema;
+    output: typeof AcceptInvitationResponseSchema;
+  },
+  /**
+   * @generated from rpc bff.v1.InvitationService.DeclineInvitation
+   */
+  declineInvitation: {
+    methodKind: "unary";
+    input: typeof DeclineInvitationRequestSchema;
+    output: typeof DeclineInvitationResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_bff_v1_invitations, 0);
+
@@ -0,0 +1,269 @@
+// @generated by protoc-gen-es v2.11.0 with parameter "target=ts"
+// @generated from file bff/v1/project_members.proto (package bff.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import { file_buf_validate_validate } from "../../buf/validate/validate_pb";
+import type { Timestamp } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
+import type { UserRole } from "./users_pb";
+import { file_bff_v1_users } from "./users_pb";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file bff/v1/project_members.proto.
+ */
+export const file_bff_v1_project_members: GenFile = /*@__PURE__*/
+  fileDesc("ChxiZmYvdjEvcHJvamVjdF9tZW1iZXJzLnByb3RvEgZiZmYudjEitgEKDVByb2plY3RNZW1iZXISCgoCaWQYASABKAkSEgoKcHJvamVjdF9pZBgCIAEoCRIPCgd1c2VyX2lkGAMgASgJEhEKCXVzZXJfbmFtZRgEIAEoCRISCgp1c2VyX2VtYWlsGAUgASgJEh4KBHJvbGUYBiABKA4yEC5iZmYudjEuVXNlclJvbGUSLQoJam9pbmVkX2F0GAcgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCI5ChlMaXN0UHJvamVjdE1lbWJlcnNSZXF1ZXN0EhwKCnByb2plY3RfaWQYASABKAlCCLpIBXIDsAEBIkQKGkxpc3RQcm9qZWN0TWVtYmVyc1Jlc3BvbnNlEiYKB21lbWJlcnMYASADKAsyFS5iZmYudjEuUHJvamVjdE1lbWJlciJ8ChdBZGRQcm9qZWN0TWVtYmVyUmVxdWVzdBIcCgpwcm9qZWN0X2lkGAEgASgJQgi6SAVyA7ABARIZCgd1c2VyX2lkGAIgASgJQgi6SAVyA7ABARIoCgRyb2xlGAMgASgOMhAuYmZmLnYxLlVzZXJSb2xlQgi6SAWCAQIQASJBChhBZGRQcm9qZWN0TWVtYmVyUmVzcG9uc2USJQoGbWVtYmVyGAEgASgLMhUuYmZmLnYxLlByb2plY3RNZW1iZXIihQEKHlVwZGF0ZVByb2plY3RNZW1iZXJSb2xlUmVxdWVzdBIcCgpwcm9qZWN0X2lkGAEgASgJQgi6SAVyA7ABARIZCgd1c2VyX2lkGAIgASgJQgi6SAVyA7ABARIqCgRyb2xlGAMgASgOMhAuYmZmLnYxLlVzZXJSb2xlQgq6SAeCAQQQASAAIkgKH1VwZGF0ZVByb2plY3RNZW1iZXJSb2xlUmVzcG9uc2USJQoGbWVtYmVyGAEgASgLMhUuYmZmLnYxLlByb2plY3RNZW1iZXIiVQoaUmVtb3ZlUHJvamVjdE1lbWJlclJlcXVlc3QSHAoKcHJvamVjdF9pZBgBIAEoCUIIukgFcgOwAQESGQoHdXNlcl9pZBgCIAEoCUIIukgFcgOwAQEiHQobUmVtb3ZlUHJvamVjdE1lbWJlclJlc3BvbnNlMpYDChRQcm9qZWN0TWVtYmVyU2VydmljZRJbChJMaXN0UHJvamVjdE1lbWJlcnMSIS5iZmYudjEuTGlzdFByb2plY3RNZW1iZXJzUmVxdWVzdBoiLmJmZi52MS5MaXN0UHJvamVjdE1lbWJlcnNSZXNwb25zZRJVChBBZGRQcm9qZWN0TWVtYmVyEh8uYmZmLnYxLkFkZFByb2plY3RNZW1iZXJSZXF1ZXN0GiAuYmZmLnYxLkFkZFByb2plY3RNZW1iZXJSZXNwb25zZRJqChdVcGRhdGVQcm9qZWN0TWVtYmVyUm9sZRImLmJmZi52MS5VcGRhdGVQcm9qZWN0TWVtYmVyUm9sZVJlcXVlc3QaJy5iZmYudjEuVXBkYXRlUHJvamVjdE1lbWJlclJvbGVSZXNwb25zZRJeChNSZW1vdmVQcm9qZWN0TWVtYmVyEiIuYmZmLnYxLlJlbW92ZVByb2plY3RNZW1iZXJSZXF1ZXN0GiMuYmZmLnYxLlJlbW92ZVByb2plY3RNZW1iZXJSZXNwb25zZUJCWkBnaXRodWIuY29tL0FwZWlyb25Gb3VuZGF0aW9uL2F4bGUvY29udHJhY3RzL2dvL2JmZi92MTtnZW5fYmZmX3YxYgZwcm90bzM", [file_buf_validate_validate, file_google_protobuf_timestamp, file_bff_v1_users]);
+
+/**
+ * ProjectMember grants a user a role within a single project.
+ *
+ * @generated from message bff.v1.ProjectMember
+ */
+export type ProjectMember = Message<"bff.v1.ProjectMember"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string project_id = 2;
+   */
+  projectId: string;
+
+  /**
+   * @generated from field: string user_id = 3;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: string user_name = 4;
+   */
+  userName: string;
+
+  /**
+   * @generated from field: string user_email = 5;
+   */
+  userEmail: string;
+
+  /**
+   * @generated from field: bff.v1.UserRole role = 6;
+   */
+  role: UserRole;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp joined_at = 7;
+   */
+  joinedAt?: Timestamp;
+};
+
+/**
+ * Describes the message bff.v1.ProjectMember.
+ * Use `create(ProjectMemberSchema)` to create a new message.
+ */
+export const ProjectMemberSchema: GenMessage<ProjectMember> = /*@__PURE__*/
+  messageDesc(file_bff_v1_project_members, 0);
+
+/**
+ * @generated from message bff.v1.ListProjectMembersRequest
+ */
+export type ListProjectMembersRequest = Message<"bff.v1.ListProjectMembersRequest"> & {
+  /**
+   * @generated from field: string project_id = 1;
+   */
+  projectId: string;
+};
+
+/**
+ * Describes the message bff.v1.ListProjectMembersRequest.
+ * Use `create(ListProjectMembersRequestSchema)` to create a new message.
+ */
+export const ListProjectMembersRequestSchema: GenMessage<ListProjectMembersRequest> = /*@__PURE__*/
+  messageDesc(file_bff_v1_project_members, 1);
+
+/**
+ * @generated from message bff.v1.ListProjectMembersResponse
+ */
+export type ListProjectMembersResponse = Message<"bff.v1.ListProjectMembersResponse"> & {
+  /**
+   * @generated from field: repeated bff.v1.ProjectMember members = 1;
+   */
+  members: ProjectMember[];
+};
+
+/**
+ * Describes the message bff.v1.ListProjectMembersResponse.
+ * Use `create(ListProjectMembersResponseSchema)` to create a new message.
+ */
+export const ListProjectMembersResponseSchema: GenMessage<ListProjectMembersResponse> = /*@__PURE__*/
+  messageDesc(file_bff_v1_project_members, 2);
+
+/**
+ * @generated from message bff.v1.AddProjectMemberRequest
+ */
+export type AddProjectMemberRequest = Message<"bff.v1.AddProjectMemberRequest"> & {
+  /**
+   * @generated from field: string project_id = 1;
+   */
+  projectId: string;
+
+  /**
+   * @generated from field: string user_id = 2;
+   */
+  userId: string;
+
+  /**
+   * role defaults to USER_ROLE_MEMBER when unspecified.
+   *
+   * @generated from field: bff.v1.UserRole role = 3;
+   */
+  role: UserRole;
+};
+
+/**
+ * Describes the message bff.v1.AddProjectMemberRequest.
+ * Use `create(AddProjectMemberRequestSchema)` to create a new message.
+ */
+export const AddProjectMemberRequestSchema: GenMessage<AddProjectMemberRequest> = /*@__PURE__*/
+  messageDesc(file_bff_v1_project_members, 3);
+
+/**
+ * @generated from message bff.v1.AddProjectMemberResponse
+ */
+export type AddProjectMemberResponse = Message<"bff.v1.AddProjectMemberResponse"> & {
+  /**
+   * @generated from field: bff.v1.ProjectMember member = 1;
+   */
+  member?: ProjectMember;
+};
+
+/**
+ * Describes the message bff.v1.AddProjectMemberResponse.
+ * Use `create(AddProjectMemberResponseSchema)` to create a new message.
+ */
+export const AddProjectMemberResponseSchema: GenMessage<AddProjectMemberResponse> = /*@__PURE__*/
+  messageDesc(file_bff_v1_project_members, 4);
+
+/**
+ * @generated from message bff.v1.UpdateProjectMemberRoleRequest
+ */
+export type UpdateProjectMemberRoleRequest = Message<"bff.v1.UpdateProjectMemberRoleRequest"> & {
+  /**
+   * @generated from field: string project_id = 1;
+   */
+  projectId: string;
+
+  /**
+   * @generated from field: string user_id = 2;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: bff.v1.UserRole role = 3;
+   */
+  role: UserRole;
+};
+
+/**
+ * Describes the message bff.v1.UpdateProjectMemberRoleRequest.
+ * Use `create(UpdateProjectMemberRoleRequestSchema)` to create a new message.
+ */
+export const UpdateProjectMemberRoleRequestSchema: GenMessage<UpdateProjectMemberRoleRequest> = /*@__PURE__*/
+  messageDesc(file_bff_v1_project_members, 5);
+
+/**
+ * @generated from message bff.v1.UpdateProjectMemberRoleResponse
+ */
+export type UpdateProjectMemberRoleResponse = Message<"bff.v1.UpdateProjectMemberRoleResponse"> & {
+  /**
+   * @generated from field: bff.v1.ProjectMember member = 1;
+   */
+  member?: ProjectMember;
+};
+
+/**
+ * Describes the message bff.v1.UpdateProjectMemberRoleResponse.
+ * Use `create(UpdateProjectMemberRoleResponseSchema)` to create a new message.
+ */
+export const UpdateProjectMemberRoleResponseSchema: GenMessage<UpdateProjectMemberRoleResponse> = /*@__PURE__*/
+  messageDesc(file_bff_v1_project_members, 6);
+
+/**
+ * @generated from message bff.v1.RemoveProjectMemberRequest
+ */
+export type RemoveProjectMemberRequest = Message<"bff.v1.RemoveProjectMemberRequest"> & {
+  /**
+   * @generated from field: string project_id = 1;
+   */
+  projectId: string;
+
+  /**
+   * @generated from field: string user_id = 2;
+   */
+  userId: string;
+};
+
+/**
+ * Describes the message bff.v1.RemoveProjectMemberRequest.
+ * Use `create(RemoveProjectMemberRequestSchema)` to create a new message.
+ */
+export const RemoveProjectMemberRequestSchema: GenMessage<RemoveProjectMemberRequest> = /*@__PURE__*/
+  messageDesc(file_bff_v1_project_members, 7);
+
+/**
+ * @generated from message bff.v1.RemoveProjectMemberResponse
+ */
+export type RemoveProjectMemberResponse = Message<"bff.v1.RemoveProjectMemberResponse"> & {
+};
+
+/**
+ * Describes the message bff.v1.RemoveProjectMemberResponse.
+ * Use `create(RemoveProjectMemberResponseSchema)` to create a new message.
+ */
+export const RemoveProjectMemberResponseSchema: GenMessage<RemoveProjectMemberResponse> = /*@__PURE__*/
+  messageDesc(file_bff_v1_project_members, 8);
+
+/**
+ * ProjectMemberService manages who has access to a project and with which role.
+ * A project always keeps at least one admin once it has one.
+ *
+ * @generated from service bff.v1.ProjectMemberService
+ */
+export const ProjectMemberService: GenService<{
+  /**
+   * @generated from rpc bff.v1.ProjectMemberService.ListProjectMembers
+   */
+  listProjectMembers: {
+    methodKind: "unary";
+    input: typeof ListProjectMembersRequestSchema;
+    output: typeof ListProjectMembersResponseSchema;
+  },
+  /**
+   * @generated from rpc bff.v1.ProjectMemberService.AddProjectMember
+   */
+  addProjectMember: {
+    methodKind: "unary";
+    input: typeof AddProjectMemberRequestSchema;
+    output: typeof AddProjectMemberResponseSchema;
+  },
+  /**
+   * @generated from rpc bff.v1.ProjectMemberService.UpdateProjectMemberRole
+   */
+  updateProjectMemberRole: {
+    methodKind: "unary";
+    input: typeof UpdateProjectMemberRoleRequestSchema;
+    output: typeof UpdateProjectMemberRoleResponseSchema;
+  },
+  /**
+   * @generated from rpc bff.v1.ProjectMemberService.RemoveProjectMember
+   */
+  removeProjectMember: {
+    methodKind: "unary";
+    input: typeof RemoveProjectMemberRequestSchema;
+    output: typeof RemoveProjectMemberResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_bff_v1_project_members, 0);
+
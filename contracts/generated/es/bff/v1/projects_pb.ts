@@ -4,15 +4,16 @@
 
 import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
 import { enumDesc, fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
-import type { Timestamp } from "@bufbuild/protobuf/wkt";
-import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
-import type { Message } from "@bufbuild/protobuf";
+import { file_buf_validate_validate } from "../../buf/validate/validate_pb";
+import type { Duration, FieldMask, Timestamp } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_duration, file_google_protobuf_field_mask, file_google_protobuf_struct, file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
+import type { JsonObject, Message } from "@bufbuild/protobuf";
 
 /**
  * Describes the file bff/v1/projects.proto.
  */
 export const file_bff_v1_projects: GenFile = /*@__PURE__*/
-  fileDesc("ChViZmYvdjEvcHJvamVjdHMucHJvdG8SBmJmZi52MSK/AQoHUHJvamVjdBIKCgJpZBgBIAEoCRIMCgRuYW1lGAIgASgJEhMKC2Rlc2NyaXB0aW9uGAMgASgJEiUKBnN0YXR1cxgEIAEoDjIVLmJmZi52MS5Qcm9qZWN0U3RhdHVzEi4KCmNyZWF0ZWRfYXQYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KCnVwZGF0ZWRfYXQYBiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIjYKE0xpc3RQcm9qZWN0c1JlcXVlc3QSDAoEcGFnZRgBIAEoBRIRCglwYWdlX3NpemUYAiABKAUiSAoUTGlzdFByb2plY3RzUmVzcG9uc2USIQoIcHJvamVjdHMYASADKAsyDy5iZmYudjEuUHJvamVjdBINCgV0b3RhbBgCIAEoBSIfChFHZXRQcm9qZWN0UmVxdWVzdBIKCgJpZBgBIAEoCSI2ChJHZXRQcm9qZWN0UmVzcG9uc2USIAoHcHJvamVjdBgBIAEoCzIPLmJmZi52MS5Qcm9qZWN0IjkKFENyZWF0ZVByb2plY3RSZXF1ZXN0EgwKBG5hbWUYASABKAkSEwoLZGVzY3JpcHRpb24YAiABKAkiOQoVQ3JlYXRlUHJvamVjdFJlc3BvbnNlEiAKB3Byb2plY3QYASABKAsyDy5iZmYudjEuUHJvamVjdCJsChRVcGRhdGVQcm9qZWN0UmVxdWVzdBIKCgJpZBgBIAEoCRIMCgRuYW1lGAIgASgJEhMKC2Rlc2NyaXB0aW9uGAMgASgJEiUKBnN0YXR1cxgEIAEoDjIVLmJmZi52MS5Qcm9qZWN0U3RhdHVzIjkKFVVwZGF0ZVByb2plY3RSZXNwb25zZRIgCgdwcm9qZWN0GAEgASgLMg8uYmZmLnYxLlByb2plY3QiIgoURGVsZXRlUHJvamVjdFJlcXVlc3QSCgoCaWQYASABKAkiFwoVRGVsZXRlUHJvamVjdFJlc3BvbnNlKmcKDVByb2plY3RTdGF0dXMSHgoaUFJPSkVDVF9TVEFUVVNfVU5TUEVDSUZJRUQQABIZChVQUk9KRUNUX1NUQVRVU19BQ1RJVkUQARIbChdQUk9KRUNUX1NUQVRVU19BUkNISVZFRBACMooDCg5Qcm9qZWN0U2VydmljZRJJCgxMaXN0UHJvamVjdHMSGy5iZmYudjEuTGlzdFByb2plY3RzUmVxdWVzdBocLmJmZi52MS5MaXN0UHJvamVjdHNSZXNwb25zZRJDCgpHZXRQcm9qZWN0EhkuYmZmLnYxLkdldFByb2plY3RSZXF1ZXN0GhouYmZmLnYxLkdldFByb2plY3RSZXNwb25zZRJMCg1DcmVhdGVQcm9qZWN0EhwuYmZmLnYxLkNyZWF0ZVByb2plY3RSZXF1ZXN0Gh0uYmZmLnYxLkNyZWF0ZVByb2plY3RSZXNwb25zZRJMCg1VcGRhdGVQcm9qZWN0EhwuYmZmLnYxLlVwZGF0ZVByb2plY3RSZXF1ZXN0Gh0uYmZmLnYxLlVwZGF0ZVByb2plY3RSZXNwb25zZRJMCg1EZWxldGVQcm9qZWN0EhwuYmZmLnYxLkRlbGV0ZVByb2plY3RSZXF1ZXN0Gh0uYmZmLnYxLkRlbGV0ZVByb2plY3RSZXNwb25zZUJCWkBnaXRodWIuY29tL0FwZWlyb25Gb3VuZGF0aW9uL2F4bGUvY29udHJhY3RzL2dvL2JmZi92MTtnZW5fYmZmX3YxYgZwcm90bzM", [file_google_protobuf_timestamp]);
+  fileDesc("ChViZmYvdjEvcHJvamVjdHMucHJvdG8SBmJmZi52MSL9AQoHUHJvamVjdBIKCgJpZBgBIAEoCRIMCgRuYW1lGAIgASgJEhMKC2Rlc2NyaXB0aW9uGAMgASgJEiUKBnN0YXR1cxgEIAEoDjIVLmJmZi52MS5Qcm9qZWN0U3RhdHVzEi4KCmNyZWF0ZWRfYXQYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KCnVwZGF0ZWRfYXQYBiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEgwKBGV0YWcYByABKAkSLgoKZGVsZXRlZF9hdBgIIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAitQIKE0xpc3RQcm9qZWN0c1JlcXVlc3QSFwoEcGFnZRgBIAEoBUIJGAG6SAQaAigAEhoKCXBhZ2Vfc2l6ZRgCIAEoBUIHukgEGgIoABISCgpwYWdlX3Rva2VuGAMgASgJEhIKCnNraXBfdG90YWwYBCABKAgSLwoGc3RhdHVzGAUgASgOMhUuYmZmLnYxLlByb2plY3RTdGF0dXNCCLpIBYIBAhABEh8KDW5hbWVfY29udGFpbnMYBiABKAlCCLpIBXIDGMgBEiMKDm1lbWJlcl91c2VyX2lkGAcgASgJQgu6SAjYAQFyA7ABARIxCg11cGRhdGVkX3NpbmNlGAggASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIXCgVxdWVyeRgJIAEoCUIIukgFcgMY9AMiQwoQUHJvamVjdEhpZ2hsaWdodBIMCgRuYW1lGAEgASgJEhMKC2Rlc2NyaXB0aW9uGAIgASgJEgwKBHJhbmsYAyABKAIi8AEKFExpc3RQcm9qZWN0c1Jlc3BvbnNlEiEKCHByb2plY3RzGAEgAygLMg8uYmZmLnYxLlByb2plY3QSDQoFdG90YWwYAiABKAUSFwoPbmV4dF9wYWdlX3Rva2VuGAMgASgJEkAKCmhpZ2hsaWdodHMYBCADKAsyLC5iZmYudjEuTGlzdFByb2plY3RzUmVzcG9uc2UuSGlnaGxpZ2h0c0VudHJ5GksKD0hpZ2hsaWdodHNFbnRyeRILCgNrZXkYASABKAkSJwoFdmFsdWUYAiABKAsyGC5iZmYudjEuUHJvamVjdEhpZ2hsaWdodDoCOAEiKQoRR2V0UHJvamVjdFJlcXVlc3QSFAoCaWQYASABKAlCCLpIBXIDsAEBIjYKEkdldFByb2plY3RSZXNwb25zZRIgCgdwcm9qZWN0GAEgASgLMg8uYmZmLnYxLlByb2plY3QiUQoUQ3JlYXRlUHJvamVjdFJlcXVlc3QSGgoEbmFtZRgBIAEoCUIMukgJcgcYyAEyAlxTEh0KC2Rlc2NyaXB0aW9uGAIgASgJQgi6SAVyAxigHyI5ChVDcmVhdGVQcm9qZWN0UmVzcG9uc2USIAoHcHJvamVjdBgBIAEoCzIPLmJmZi52MS5Qcm9qZWN0ItwBChRVcGRhdGVQcm9qZWN0UmVxdWVzdBIUCgJpZBgBIAEoCUIIukgFcgOwAQESFgoEbmFtZRgCIAEoCUIIukgFcgMYyAESHQoLZGVzY3JpcHRpb24YAyABKAlCCLpIBXIDGKAfEi8KBnN0YXR1cxgEIAEoDjIVLmJmZi52MS5Qcm9qZWN0U3RhdHVzQgi6SAWCAQIQARIvCgt1cGRhdGVfbWFzaxgFIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5GaWVsZE1hc2sSFQoNZXhwZWN0ZWRfZXRhZxgGIAEoCSI5ChVVcGRhdGVQcm9qZWN0UmVzcG9uc2USIAoHcHJvamVjdBgBIAEoCzIPLmJmZi52MS5Qcm9qZWN0IkMKFERlbGV0ZVByb2plY3RSZXF1ZXN0EhQKAmlkGAEgASgJQgi6SAVyA7ABARIVCg1leHBlY3RlZF9ldGFnGAIgASgJIhcKFURlbGV0ZVByb2plY3RSZXNwb25zZSJMChpMaXN0VHJhc2hlZFByb2plY3RzUmVxdWVzdBIaCglwYWdlX3NpemUYASABKAVCB7pIBBoCKAASEgoKcGFnZV90b2tlbhgCIAEoCSKHAQobTGlzdFRyYXNoZWRQcm9qZWN0c1Jlc3BvbnNlEiEKCHByb2plY3RzGAEgAygLMg8uYmZmLnYxLlByb2plY3QSFwoPbmV4dF9wYWdlX3Rva2VuGAIgASgJEiwKCXJldGVudGlvbhgDIAEoCzIZLmdvb2dsZS5wcm90b2J1Zi5EdXJhdGlvbiItChVSZXN0b3JlUHJvamVjdFJlcXVlc3QSFAoCaWQYASABKAlCCLpIBXIDsAEBIjoKFlJlc3RvcmVQcm9qZWN0UmVzcG9uc2USIAoHcHJvamVjdBgBIAEoCzIPLmJmZi52MS5Qcm9qZWN0IjkKGUdldFByb2plY3RTZXR0aW5nc1JlcXVlc3QSHAoKcHJvamVjdF9pZBgBIAEoCUIIukgFcgOwAQEiVQoaR2V0UHJvamVjdFNldHRpbmdzUmVzcG9uc2USKQoIc2V0dGluZ3MYASABKAsyFy5nb29nbGUucHJvdG9idWYuU3RydWN0EgwKBGV0YWcYAiABKAkigwEKHFVwZGF0ZVByb2plY3RTZXR0aW5nc1JlcXVlc3QSHAoKcHJvamVjdF9pZBgBIAEoCUIIukgFcgOwAQESLgoFcGF0Y2gYAiABKAsyFy5nb29nbGUucHJvdG9idWYuU3RydWN0Qga6SAPIAQESFQoNZXhwZWN0ZWRfZXRhZxgDIAEoCSJYCh1VcGRhdGVQcm9qZWN0U2V0dGluZ3NSZXNwb25zZRIpCghzZXR0aW5ncxgBIAEoCzIXLmdvb2dsZS5wcm90b2J1Zi5TdHJ1Y3QSDAoEZXRhZxgCIAEoCSpnCg1Qcm9qZWN0U3RhdHVzEh4KGlBST0pFQ1RfU1RBVFVTX1VOU1BFQ0lGSUVEEAASGQoVUFJPSkVDVF9TVEFUVVNfQUNUSVZFEAESGwoXUFJPSkVDVF9TVEFUVVNfQVJDSElWRUQQAjL+BQoOUHJvamVjdFNlcnZpY2USSQoMTGlzdFByb2plY3RzEhsuYmZmLnYxLkxpc3RQcm9qZWN0c1JlcXVlc3QaHC5iZmYudjEuTGlzdFByb2plY3RzUmVzcG9uc2USQwoKR2V0UHJvamVjdBIZLmJmZi52MS5HZXRQcm9qZWN0UmVxdWVzdBoaLmJmZi52MS5HZXRQcm9qZWN0UmVzcG9uc2USTAoNQ3JlYXRlUHJvamVjdBIcLmJmZi52MS5DcmVhdGVQcm9qZWN0UmVxdWVzdBodLmJmZi52MS5DcmVhdGVQcm9qZWN0UmVzcG9uc2USTAoNVXBkYXRlUHJvamVjdBIcLmJmZi52MS5VcGRhdGVQcm9qZWN0UmVxdWVzdBodLmJmZi52MS5VcGRhdGVQcm9qZWN0UmVzcG9uc2USTAoNRGVsZXRlUHJvamVjdBIcLmJmZi52MS5EZWxldGVQcm9qZWN0UmVxdWVzdBodLmJmZi52MS5EZWxldGVQcm9qZWN0UmVzcG9uc2USXgoTTGlzdFRyYXNoZWRQcm9qZWN0cxIiLmJmZi52MS5MaXN0VHJhc2hlZFByb2plY3RzUmVxdWVzdBojLmJmZi52MS5MaXN0VHJhc2hlZFByb2plY3RzUmVzcG9uc2USTwoOUmVzdG9yZVByb2plY3QSHS5iZmYudjEuUmVzdG9yZVByb2plY3RSZXF1ZXN0Gh4uYmZmLnYxLlJlc3RvcmVQcm9qZWN0UmVzcG9uc2USWwoSR2V0UHJvamVjdFNldHRpbmdzEiEuYmZmLnYxLkdldFByb2plY3RTZXR0aW5nc1JlcXVlc3QaIi5iZmYudjEuR2V0UHJvamVjdFNldHRpbmdzUmVzcG9uc2USZAoVVXBkYXRlUHJvamVjdFNldHRpbmdzEiQuYmZmLnYxLlVwZGF0ZVByb2plY3RTZXR0aW5nc1JlcXVlc3QaJS5iZmYudjEuVXBkYXRlUHJvamVjdFNldHRpbmdzUmVzcG9uc2VCQlpAZ2l0aHViLmNvbS9BcGVpcm9uRm91bmRhdGlvbi9heGxlL2NvbnRyYWN0cy9nby9iZmYvdjE7Z2VuX2JmZl92MWIGcHJvdG8z", [file_buf_validate_validate, file_google_protobuf_duration, file_google_protobuf_field_mask, file_google_protobuf_struct, file_google_protobuf_timestamp]);
 
 /**
  * @generated from message bff.v1.Project
@@ -47,6 +48,21 @@ export type Project = Message<"bff.v1.Project"> & {
    * @generated from field: google.protobuf.Timestamp updated_at = 6;
    */
   updatedAt?: Timestamp;
+
+  /**
+   * etag changes on every write; pass it back as expected_etag to detect
+   * concurrent edits.
+   *
+   * @generated from field: string etag = 7;
+   */
+  etag: string;
+
+  /**
+   * deleted_at is set while the project is in the trash.
+   *
+   * @generated from field: google.protobuf.Timestamp deleted_at = 8;
+   */
+  deletedAt?: Timestamp;
 };
 
 /**
@@ -57,18 +73,70 @@ export const ProjectSchema: GenMessage<Project> = /*@__PURE__*/
   messageDesc(file_bff_v1_projects, 0);
 
 /**
+ * Pagination is keyset-based: pass the previous next_page_token as page_token.
+ * The 1-based page number is still honoured for older clients when page_token is empty.
+ *
  * @generated from message bff.v1.ListProjectsRequest
  */
 export type ListProjectsRequest = Message<"bff.v1.ListProjectsRequest"> & {
   /**
-   * @generated from field: int32 page = 1;
+   * @generated from field: int32 page = 1 [deprecated = true];
+   * @deprecated
    */
   page: number;
 
   /**
+   * page_size is clamped to 100; zero selects the default of 20.
+   *
    * @generated from field: int32 page_size = 2;
    */
   pageSize: number;
+
+  /**
+   * @generated from field: string page_token = 3;
+   */
+  pageToken: string;
+
+  /**
+   * skip_total omits the total count, which saves a COUNT(*) per page.
+   *
+   * @generated from field: bool skip_total = 4;
+   */
+  skipTotal: boolean;
+
+  /**
+   * Filters are ANDed together; zero values are ignored.
+   *
+   * @generated from field: bff.v1.ProjectStatus status = 5;
+   */
+  status: ProjectStatus;
+
+  /**
+   * name_contains matches a case-insensitive substring of the project name.
+   *
+   * @generated from field: string name_contains = 6;
+   */
+  nameContains: string;
+
+  /**
+   * member_user_id limits results to projects the user belongs to ("my projects").
+   *
+   * @generated from field: string member_user_id = 7;
+   */
+  memberUserId: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp updated_since = 8;
+   */
+  updatedSince?: Timestamp;
+
+  /**
+   * query runs a full-text search over name and description. Results are then
+   * ordered by relevance, and page tokens are only valid for the same query.
+   *
+   * @generated from field: string query = 9;
+   */
+  query: string;
 };
 
 /**
@@ -78,6 +146,36 @@ export type ListProjectsRequest = Message<"bff.v1.ListProjectsRequest"> & {
 export const ListProjectsRequestSchema: GenMessage<ListProjectsRequest> = /*@__PURE__*/
   messageDesc(file_bff_v1_projects, 1);
 
+/**
+ * ProjectHighlight marks where a search query matched a project.
+ * Matches are wrapped in <mark></mark>; the rest of the text is HTML-escaped.
+ *
+ * @generated from message bff.v1.ProjectHighlight
+ */
+export type ProjectHighlight = Message<"bff.v1.ProjectHighlight"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string description = 2;
+   */
+  description: string;
+
+  /**
+   * @generated from field: float rank = 3;
+   */
+  rank: number;
+};
+
+/**
+ * Describes the message bff.v1.ProjectHighlight.
+ * Use `create(ProjectHighlightSchema)` to create a new message.
+ */
+export const ProjectHighlightSchema: GenMessage<ProjectHighlight> = /*@__PURE__*/
+  messageDesc(file_bff_v1_projects, 2);
+
 /**
  * @generated from message bff.v1.ListProjectsResponse
  */
@@ -88,9 +186,25 @@ export type ListProjectsResponse = Message<"bff.v1.ListProjectsResponse"> & {
   projects: Project[];
 
   /**
+   * total is 0 when skip_total was requested.
+   *
    * @generated from field: int32 total = 2;
    */
   total: number;
+
+  /**
+   * next_page_token is empty on the last page.
+   *
+   * @generated from field: string next_page_token = 3;
+   */
+  nextPageToken: string;
+
+  /**
+   * highlights is keyed by project id and only set when query was given.
+   *
+   * @generated from field: map<string, bff.v1.ProjectHighlight> highlights = 4;
+   */
+  highlights: { [key: string]: ProjectHighlight };
 };
 
 /**
@@ -98,7 +212,7 @@ export type ListProjectsResponse = Message<"bff.v1.ListProjectsResponse"> & {
  * Use `create(ListProjectsResponseSchema)` to create a new message.
  */
 export const ListProjectsResponseSchema: GenMessage<ListProjectsResponse> = /*@__PURE__*/
-  messageDesc(file_bff_v1_projects, 2);
+  messageDesc(file_bff_v1_projects, 3);
 
 /**
  * @generated from message bff.v1.GetProjectRequest
@@ -115,7 +229,7 @@ export type GetProjectRequest = Message<"bff.v1.GetProjectRequest"> & {
  * Use `create(GetProjectRequestSchema)` to create a new message.
  */
 export const GetProjectRequestSchema: GenMessage<GetProjectRequest> = /*@__PURE__*/
-  messageDesc(file_bff_v1_projects, 3);
+  messageDesc(file_bff_v1_projects, 4);
 
 /**
  * @generated from message bff.v1.GetProjectResponse
@@ -132,13 +246,15 @@ export type GetProjectResponse = Message<"bff.v1.GetProjectResponse"> & {
  * Use `create(GetProjectResponseSchema)` to create a new message.
  */
 export const GetProjectResponseSchema: GenMessage<GetProjectResponse> = /*@__PURE__*/
-  messageDesc(file_bff_v1_projects, 4);
+  messageDesc(file_bff_v1_projects, 5);
 
 /**
  * @generated from message bff.v1.CreateProjectRequest
  */
 export type CreateProjectRequest = Message<"bff.v1.CreateProjectRequest"> & {
   /**
+   * name must contain at least one non-space character.
+   *
    * @generated from field: string name = 1;
    */
   name: string;
@@ -154,7 +270,7 @@ export type CreateProjectRequest = Message<"bff.v1.CreateProjectRequest"> & {
  * Use `create(CreateProjectRequestSchema)` to create a new message.
  */
 export const CreateProjectRequestSchema: GenMessage<CreateProjectRequest> = /*@__PURE__*/
-  messageDesc(file_bff_v1_projects, 5);
+  messageDesc(file_bff_v1_projects, 6);
 
 /**
  * @generated from message bff.v1.CreateProjectResponse
@@ -171,9 +287,13 @@ export type CreateProjectResponse = Message<"bff.v1.CreateProjectResponse"> & {
  * Use `create(CreateProjectResponseSchema)` to create a new message.
  */
 export const CreateProjectResponseSchema: GenMessage<CreateProjectResponse> = /*@__PURE__*/
-  messageDesc(file_bff_v1_projects, 6);
+  messageDesc(file_bff_v1_projects, 7);
 
 /**
+ * update_mask lists the fields to write, using proto field names. Masked fields
+ * are set even when empty; "*" masks every updatable field. Without a mask,
+ * only non-empty fields are applied.
+ *
  * @generated from message bff.v1.UpdateProjectRequest
  */
 export type UpdateProjectRequest = Message<"bff.v1.UpdateProjectRequest"> & {
@@ -196,6 +316,19 @@ export type UpdateProjectRequest = Message<"bff.v1.UpdateProjectRequest"> & {
    * @generated from field: bff.v1.ProjectStatus status = 4;
    */
   status: ProjectStatus;
+
+  /**
+   * @generated from field: google.protobuf.FieldMask update_mask = 5;
+   */
+  updateMask?: FieldMask;
+
+  /**
+   * expected_etag, when set, makes the update fail with ABORTED if the project
+   * has changed since that etag was read.
+   *
+   * @generated from field: string expected_etag = 6;
+   */
+  expectedEtag: string;
 };
 
 /**
@@ -203,7 +336,7 @@ export type UpdateProjectRequest = Message<"bff.v1.UpdateProjectRequest"> & {
  * Use `create(UpdateProjectRequestSchema)` to create a new message.
  */
 export const UpdateProjectRequestSchema: GenMessage<UpdateProjectRequest> = /*@__PURE__*/
-  messageDesc(file_bff_v1_projects, 7);
+  messageDesc(file_bff_v1_projects, 8);
 
 /**
  * @generated from message bff.v1.UpdateProjectResponse
@@ -220,9 +353,13 @@ export type UpdateProjectResponse = Message<"bff.v1.UpdateProjectResponse"> & {
  * Use `create(UpdateProjectResponseSchema)` to create a new message.
  */
 export const UpdateProjectResponseSchema: GenMessage<UpdateProjectResponse> = /*@__PURE__*/
-  messageDesc(file_bff_v1_projects, 8);
+  messageDesc(file_bff_v1_projects, 9);
 
 /**
+ * DeleteProject moves the project to the trash. It disappears from every other
+ * RPC but can be restored until the trash retention period has passed, after
+ * which it is deleted for good along with its members.
+ *
  * @generated from message bff.v1.DeleteProjectRequest
  */
 export type DeleteProjectRequest = Message<"bff.v1.DeleteProjectRequest"> & {
@@ -230,6 +367,14 @@ export type DeleteProjectRequest = Message<"bff.v1.DeleteProjectRequest"> & {
    * @generated from field: string id = 1;
    */
   id: string;
+
+  /**
+   * expected_etag, when set, makes the delete fail with ABORTED if the project
+   * has changed since that etag was read.
+   *
+   * @generated from field: string expected_etag = 2;
+   */
+  expectedEtag: string;
 };
 
 /**
@@ -237,7 +382,7 @@ export type DeleteProjectRequest = Message<"bff.v1.DeleteProjectRequest"> & {
  * Use `create(DeleteProjectRequestSchema)` to create a new message.
  */
 export const DeleteProjectRequestSchema: GenMessage<DeleteProjectRequest> = /*@__PURE__*/
-  messageDesc(file_bff_v1_projects, 9);
+  messageDesc(file_bff_v1_projects, 10);
 
 /**
  * @generated from message bff.v1.DeleteProjectResponse
@@ -250,7 +395,194 @@ export type DeleteProjectResponse = Message<"bff.v1.DeleteProjectResponse"> & {
  * Use `create(DeleteProjectResponseSchema)` to create a new message.
  */
 export const DeleteProjectResponseSchema: GenMessage<DeleteProjectResponse> = /*@__PURE__*/
-  messageDesc(file_bff_v1_projects, 10);
+  messageDesc(file_bff_v1_projects, 11);
+
+/**
+ * ListTrashedProjects returns trashed projects, most recently deleted first.
+ * Global admins see all of them; everyone else sees the projects they
+ * administer, which are the ones they can restore.
+ *
+ * @generated from message bff.v1.ListTrashedProjectsRequest
+ */
+export type ListTrashedProjectsRequest = Message<"bff.v1.ListTrashedProjectsRequest"> & {
+  /**
+   * page_size is clamped to 100; zero selects the default of 20.
+   *
+   * @generated from field: int32 page_size = 1;
+   */
+  pageSize: number;
+
+  /**
+   * @generated from field: string page_token = 2;
+   */
+  pageToken: string;
+};
+
+/**
+ * Describes the message bff.v1.ListTrashedProjectsRequest.
+ * Use `create(ListTrashedProjectsRequestSchema)` to create a new message.
+ */
+export const ListTrashedProjectsRequestSchema: GenMessage<ListTrashedProjectsRequest> = /*@__PURE__*/
+  messageDesc(file_bff_v1_projects, 12);
+
+/**
+ * @generated from message bff.v1.ListTrashedProjectsResponse
+ */
+export type ListTrashedProjectsResponse = Message<"bff.v1.ListTrashedProjectsResponse"> & {
+  /**
+   * @generated from field: repeated bff.v1.Project projects = 1;
+   */
+  projects: Project[];
+
+  /**
+   * next_page_token is empty on the last page.
+   *
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken: string;
+
+  /**
+   * retention is how long a project stays in the trash: it is purged at
+   * deleted_at + retention.
+   *
+   * @generated from field: google.protobuf.Duration retention = 3;
+   */
+  retention?: Duration;
+};
+
+/**
+ * Describes the message bff.v1.ListTrashedProjectsResponse.
+ * Use `create(ListTrashedProjectsResponseSchema)` to create a new message.
+ */
+export const ListTrashedProjectsResponseSchema: GenMessage<ListTrashedProjectsResponse> = /*@__PURE__*/
+  messageDesc(file_bff_v1_projects, 13);
+
+/**
+ * @generated from message bff.v1.RestoreProjectRequest
+ */
+export type RestoreProjectRequest = Message<"bff.v1.RestoreProjectRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message bff.v1.RestoreProjectRequest.
+ * Use `create(RestoreProjectRequestSchema)` to create a new message.
+ */
+export const RestoreProjectRequestSchema: GenMessage<RestoreProjectRequest> = /*@__PURE__*/
+  messageDesc(file_bff_v1_projects, 14);
+
+/**
+ * @generated from message bff.v1.RestoreProjectResponse
+ */
+export type RestoreProjectResponse = Message<"bff.v1.RestoreProjectResponse"> & {
+  /**
+   * @generated from field: bff.v1.Project project = 1;
+   */
+  project?: Project;
+};
+
+/**
+ * Describes the message bff.v1.RestoreProjectResponse.
+ * Use `create(RestoreProjectResponseSchema)` to create a new message.
+ */
+export const RestoreProjectResponseSchema: GenMessage<RestoreProjectResponse> = /*@__PURE__*/
+  messageDesc(file_bff_v1_projects, 15);
+
+/**
+ * @generated from message bff.v1.GetProjectSettingsRequest
+ */
+export type GetProjectSettingsRequest = Message<"bff.v1.GetProjectSettingsRequest"> & {
+  /**
+   * @generated from field: string project_id = 1;
+   */
+  projectId: string;
+};
+
+/**
+ * Describes the message bff.v1.GetProjectSettingsRequest.
+ * Use `create(GetProjectSettingsRequestSchema)` to create a new message.
+ */
+export const GetProjectSettingsRequestSchema: GenMessage<GetProjectSettingsRequest> = /*@__PURE__*/
+  messageDesc(file_bff_v1_projects, 16);
+
+/**
+ * @generated from message bff.v1.GetProjectSettingsResponse
+ */
+export type GetProjectSettingsResponse = Message<"bff.v1.GetProjectSettingsResponse"> & {
+  /**
+   * @generated from field: google.protobuf.Struct settings = 1;
+   */
+  settings?: JsonObject;
+
+  /**
+   * etag is the project etag; settings writes change it too.
+   *
+   * @generated from field: string etag = 2;
+   */
+  etag: string;
+};
+
+/**
+ * Describes the message bff.v1.GetProjectSettingsResponse.
+ * Use `create(GetProjectSettingsResponseSchema)` to create a new message.
+ */
+export const GetProjectSettingsResponseSchema: GenMessage<GetProjectSettingsResponse> = /*@__PURE__*/
+  messageDesc(file_bff_v1_projects, 17);
+
+/**
+ * patch is applied with JSON merge-patch semantics (RFC 7386): keys set to
+ * null are removed and all other keys are replaced.
+ *
+ * @generated from message bff.v1.UpdateProjectSettingsRequest
+ */
+export type UpdateProjectSettingsRequest = Message<"bff.v1.UpdateProjectSettingsRequest"> & {
+  /**
+   * @generated from field: string project_id = 1;
+   */
+  projectId: string;
+
+  /**
+   * @generated from field: google.protobuf.Struct patch = 2;
+   */
+  patch?: JsonObject;
+
+  /**
+   * @generated from field: string expected_etag = 3;
+   */
+  expectedEtag: string;
+};
+
+/**
+ * Describes the message bff.v1.UpdateProjectSettingsRequest.
+ * Use `create(UpdateProjectSettingsRequestSchema)` to create a new message.
+ */
+export const UpdateProjectSettingsRequestSchema: GenMessage<UpdateProjectSettingsRequest> = /*@__PURE__*/
+  messageDesc(file_bff_v1_projects, 18);
+
+/**
+ * @generated from message bff.v1.UpdateProjectSettingsResponse
+ */
+export type UpdateProjectSettingsResponse = Message<"bff.v1.UpdateProjectSettingsResponse"> & {
+  /**
+   * @generated from field: google.protobuf.Struct settings = 1;
+   */
+  settings?: JsonObject;
+
+  /**
+   * @generated from field: string etag = 2;
+   */
+  etag: string;
+};
+
+/**
+ * Describes the message bff.v1.UpdateProjectSettingsResponse.
+ * Use `create(UpdateProjectSettingsResponseSchema)` to create a new message.
+ */
+export const UpdateProjectSettingsResponseSchema: GenMessage<UpdateProjectSettingsResponse> = /*@__PURE__*/
+  messageDesc(file_bff_v1_projects, 19);
 
 /**
  * ProjectStatus represents lifecycle state of a project.
@@ -324,6 +656,38 @@ export const ProjectService: GenService<{
     input: typeof DeleteProjectRequestSchema;
     output: typeof DeleteProjectResponseSchema;
   },
+  /**
+   * @generated from rpc bff.v1.ProjectService.ListTrashedProjects
+   */
+  listTrashedProjects: {
+    methodKind: "unary";
+    input: typeof ListTrashedProjectsRequestSchema;
+    output: typeof ListTrashedProjectsResponseSchema;
+  },
+  /**
+   * @generated from rpc bff.v1.ProjectService.RestoreProject
+   */
+  restoreProject: {
+    methodKind: "unary";
+    input: typeof RestoreProjectRequestSchema;
+    output: typeof RestoreProjectResponseSchema;
+  },
+  /**
+   * @generated from rpc bff.v1.ProjectService.GetProjectSettings
+   */
+  getProjectSettings: {
+    methodKind: "unary";
+    input: typeof GetProjectSettingsRequestSchema;
+    output: typeof GetProjectSettingsResponseSchema;
+  },
+  /**
+   * @generated from rpc bff.v1.ProjectService.UpdateProjectSettings
+   */
+  updateProjectSettings: {
+    methodKind: "unary";
+    input: typeof UpdateProjectSettingsRequestSchema;
+    output: typeof UpdateProjectSettingsResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_bff_v1_projects, 0);
 
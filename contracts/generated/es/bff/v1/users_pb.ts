@@ -4,15 +4,16 @@
 
 import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
 import { enumDesc, fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
-import type { Timestamp } from "@bufbuild/protobuf/wkt";
-import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
-import type { Message } from "@bufbuild/protobuf";
+import { file_buf_validate_validate } from "../../buf/validate/validate_pb";
+import type { FieldMask, Timestamp } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_field_mask, file_google_protobuf_struct, file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
+import type { JsonObject, Message } from "@bufbuild/protobuf";
 
 /**
  * Describes the file bff/v1/users.proto.
  */
 export const file_bff_v1_users: GenFile = /*@__PURE__*/
-  fileDesc("ChJiZmYvdjEvdXNlcnMucHJvdG8SBmJmZi52MSK/AQoEVXNlchIKCgJpZBgBIAEoCRIMCgRuYW1lGAIgASgJEg0KBWVtYWlsGAMgASgJEh4KBHJvbGUYBCABKA4yEC5iZmYudjEuVXNlclJvbGUSDgoGbG9jYWxlGAUgASgJEi4KCmNyZWF0ZWRfYXQYBiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KCnVwZGF0ZWRfYXQYByABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIjMKEExpc3RVc2Vyc1JlcXVlc3QSDAoEcGFnZRgBIAEoBRIRCglwYWdlX3NpemUYAiABKAUiPwoRTGlzdFVzZXJzUmVzcG9uc2USGwoFdXNlcnMYASADKAsyDC5iZmYudjEuVXNlchINCgV0b3RhbBgCIAEoBSIcCg5HZXRVc2VyUmVxdWVzdBIKCgJpZBgBIAEoCSItCg9HZXRVc2VyUmVzcG9uc2USGgoEdXNlchgBIAEoCzIMLmJmZi52MS5Vc2VyIg4KDEdldE1lUmVxdWVzdCIrCg1HZXRNZVJlc3BvbnNlEhoKBHVzZXIYASABKAsyDC5iZmYudjEuVXNlciI9ChFVcGRhdGVVc2VyUmVxdWVzdBIKCgJpZBgBIAEoCRIMCgRuYW1lGAIgASgJEg4KBmxvY2FsZRgDIAEoCSIwChJVcGRhdGVVc2VyUmVzcG9uc2USGgoEdXNlchgBIAEoCzIMLmJmZi52MS5Vc2VyKmYKCFVzZXJSb2xlEhkKFVVTRVJfUk9MRV9VTlNQRUNJRklFRBAAEhMKD1VTRVJfUk9MRV9BRE1JThABEhQKEFVTRVJfUk9MRV9NRU1CRVIQAhIUChBVU0VSX1JPTEVfVklFV0VSEAMyhgIKC1VzZXJTZXJ2aWNlEkAKCUxpc3RVc2VycxIYLmJmZi52MS5MaXN0VXNlcnNSZXF1ZXN0GhkuYmZmLnYxLkxpc3RVc2Vyc1Jlc3BvbnNlEjoKB0dldFVzZXISFi5iZmYudjEuR2V0VXNlclJlcXVlc3QaFy5iZmYudjEuR2V0VXNlclJlc3BvbnNlEjQKBUdldE1lEhQuYmZmLnYxLkdldE1lUmVxdWVzdBoVLmJmZi52MS5HZXRNZVJlc3BvbnNlEkMKClVwZGF0ZVVzZXISGS5iZmYudjEuVXBkYXRlVXNlclJlcXVlc3QaGi5iZmYudjEuVXBkYXRlVXNlclJlc3BvbnNlQkJaQGdpdGh1Yi5jb20vQXBlaXJvbkZvdW5kYXRpb24vYXhsZS9jb250cmFjdHMvZ28vYmZmL3YxO2dlbl9iZmZfdjFiBnByb3RvMw", [file_google_protobuf_timestamp]);
+  fileDesc("ChJiZmYvdjEvdXNlcnMucHJvdG8SBmJmZi52MSLxAQoEVXNlchIKCgJpZBgBIAEoCRIMCgRuYW1lGAIgASgJEg0KBWVtYWlsGAMgASgJEh4KBHJvbGUYBCABKA4yEC5iZmYudjEuVXNlclJvbGUSDgoGbG9jYWxlGAUgASgJEi4KCmNyZWF0ZWRfYXQYBiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KCnVwZGF0ZWRfYXQYByABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEgwKBGV0YWcYCCABKAkSIgoGc3RhdHVzGAkgASgOMhIuYmZmLnYxLlVzZXJTdGF0dXMibwoQTGlzdFVzZXJzUmVxdWVzdBIXCgRwYWdlGAEgASgFQgkYAbpIBBoCKAASGgoJcGFnZV9zaXplGAIgASgFQge6SAQaAigAEhIKCnBhZ2VfdG9rZW4YAyABKAkSEgoKc2tpcF90b3RhbBgEIAEoCCJYChFMaXN0VXNlcnNSZXNwb25zZRIbCgV1c2VycxgBIAMoCzIMLmJmZi52MS5Vc2VyEg0KBXRvdGFsGAIgASgFEhcKD25leHRfcGFnZV90b2tlbhgDIAEoCSImCg5HZXRVc2VyUmVxdWVzdBIUCgJpZBgBIAEoCUIIukgFcgOwAQEiLQoPR2V0VXNlclJlc3BvbnNlEhoKBHVzZXIYASABKAsyDC5iZmYudjEuVXNlciIOCgxHZXRNZVJlcXVlc3QiKwoNR2V0TWVSZXNwb25zZRIaCgR1c2VyGAEgASgLMgwuYmZmLnYxLlVzZXIiFAoSR2V0TXlBY2Nlc3NSZXF1ZXN0IlsKE0dldE15QWNjZXNzUmVzcG9uc2USDwoHdXNlcl9pZBgBIAEoCRIeCgRyb2xlGAIgASgOMhAuYmZmLnYxLlVzZXJSb2xlEhMKC3Byb2plY3RfaWRzGAMgAygJIqIBChFVcGRhdGVVc2VyUmVxdWVzdBIUCgJpZBgBIAEoCUIIukgFcgOwAQESFgoEbmFtZRgCIAEoCUIIukgFcgMYyAESFwoGbG9jYWxlGAMgASgJQge6SARyAhgjEi8KC3VwZGF0ZV9tYXNrGAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLkZpZWxkTWFzaxIVCg1leHBlY3RlZF9ldGFnGAUgASgJIjAKElVwZGF0ZVVzZXJSZXNwb25zZRIaCgR1c2VyGAEgASgLMgwuYmZmLnYxLlVzZXIiGQoXR2V0TXlQcmVmZXJlbmNlc1JlcXVlc3QiVgoYR2V0TXlQcmVmZXJlbmNlc1Jlc3BvbnNlEiwKC3ByZWZlcmVuY2VzGAEgASgLMhcuZ29vZ2xlLnByb3RvYnVmLlN0cnVjdBIMCgRldGFnGAIgASgJImMKGlVwZGF0ZU15UHJlZmVyZW5jZXNSZXF1ZXN0Ei4KBXBhdGNoGAEgASgLMhcuZ29vZ2xlLnByb3RvYnVmLlN0cnVjdEIGukgDyAEBEhUKDWV4cGVjdGVkX2V0YWcYAiABKAkiWQobVXBkYXRlTXlQcmVmZXJlbmNlc1Jlc3BvbnNlEiwKC3ByZWZlcmVuY2VzGAEgASgLMhcuZ29vZ2xlLnByb3RvYnVmLlN0cnVjdBIMCgRldGFnGAIgASgJIooBChFDcmVhdGVVc2VyUmVxdWVzdBIaCgRuYW1lGAEgASgJQgy6SAlyBxjIATICXFMSFgoFZW1haWwYAiABKAlCB7pIBHICYAESKAoEcm9sZRgDIAEoDjIQLmJmZi52MS5Vc2VyUm9sZUIIukgFggECEAESFwoGbG9jYWxlGAQgASgJQge6SARyAhgjIjAKEkNyZWF0ZVVzZXJSZXNwb25zZRIaCgR1c2VyGAEgASgLMgwuYmZmLnYxLlVzZXIiWQoVVXBkYXRlVXNlclJvbGVSZXF1ZXN0EhQKAmlkGAEgASgJQgi6SAVyA7ABARIqCgRyb2xlGAIgASgOMhAuYmZmLnYxLlVzZXJSb2xlQgq6SAeCAQQQASAAIjQKFlVwZGF0ZVVzZXJSb2xlUmVzcG9uc2USGgoEdXNlchgBIAEoCzIMLmJmZi52MS5Vc2VyIi0KFURlYWN0aXZhdGVVc2VyUmVxdWVzdBIUCgJpZBgBIAEoCUIIukgFcgOwAQEiNAoWRGVhY3RpdmF0ZVVzZXJSZXNwb25zZRIaCgR1c2VyGAEgASgLMgwuYmZmLnYxLlVzZXIiLQoVUmVhY3RpdmF0ZVVzZXJSZXF1ZXN0EhQKAmlkGAEgASgJQgi6SAVyA7ABASI0ChZSZWFjdGl2YXRlVXNlclJlc3BvbnNlEhoKBHVzZXIYASABKAsyDC5iZmYudjEuVXNlciIpChFEZWxldGVVc2VyUmVxdWVzdBIUCgJpZBgBIAEoCUIIukgFcgOwAQEiFAoSRGVsZXRlVXNlclJlc3BvbnNlKmYKCFVzZXJSb2xlEhkKFVVTRVJfUk9MRV9VTlNQRUNJRklFRBAAEhMKD1VTRVJfUk9MRV9BRE1JThABEhQKEFVTRVJfUk9MRV9NRU1CRVIQAhIUChBVU0VSX1JPTEVfVklFV0VSEAMqXgoKVXNlclN0YXR1cxIbChdVU0VSX1NUQVRVU19VTlNQRUNJRklFRBAAEhYKElVTRVJfU1RBVFVTX0FDVElWRRABEhsKF1VTRVJfU1RBVFVTX0RFQUNUSVZBVEVEEAIyggcKC1VzZXJTZXJ2aWNlEkAKCUxpc3RVc2VycxIYLmJmZi52MS5MaXN0VXNlcnNSZXF1ZXN0GhkuYmZmLnYxLkxpc3RVc2Vyc1Jlc3BvbnNlEjoKB0dldFVzZXISFi5iZmYudjEuR2V0VXNlclJlcXVlc3QaFy5iZmYudjEuR2V0VXNlclJlc3BvbnNlEjQKBUdldE1lEhQuYmZmLnYxLkdldE1lUmVxdWVzdBoVLmJmZi52MS5HZXRNZVJlc3BvbnNlEkYKC0dldE15QWNjZXNzEhouYmZmLnYxLkdldE15QWNjZXNzUmVxdWVzdBobLmJmZi52MS5HZXRNeUFjY2Vzc1Jlc3BvbnNlEkMKClVwZGF0ZVVzZXISGS5iZmYudjEuVXBkYXRlVXNlclJlcXVlc3QaGi5iZmYudjEuVXBkYXRlVXNlclJlc3BvbnNlElUKEEdldE15UHJlZmVyZW5jZXMSHy5iZmYudjEuR2V0TXlQcmVmZXJlbmNlc1JlcXVlc3QaIC5iZmYudjEuR2V0TXlQcmVmZXJlbmNlc1Jlc3BvbnNlEl4KE1VwZGF0ZU15UHJlZmVyZW5jZXMSIi5iZmYudjEuVXBkYXRlTXlQcmVmZXJlbmNlc1JlcXVlc3QaIy5iZmYudjEuVXBkYXRlTXlQcmVmZXJlbmNlc1Jlc3BvbnNlEkMKCkNyZWF0ZVVzZXISGS5iZmYudjEuQ3JlYXRlVXNlclJlcXVlc3QaGi5iZmYudjEuQ3JlYXRlVXNlclJlc3BvbnNlEk8KDlVwZGF0ZVVzZXJSb2xlEh0uYmZmLnYxLlVwZGF0ZVVzZXJSb2xlUmVxdWVzdBoeLmJmZi52MS5VcGRhdGVVc2VyUm9sZVJlc3BvbnNlEk8KDkRlYWN0aXZhdGVVc2VyEh0uYmZmLnYxLkRlYWN0aXZhdGVVc2VyUmVxdWVzdBoeLmJmZi52MS5EZWFjdGl2YXRlVXNlclJlc3BvbnNlEk8KDlJlYWN0aXZhdGVVc2VyEh0uYmZmLnYxLlJlYWN0aXZhdGVVc2VyUmVxdWVzdBoeLmJmZi52MS5SZWFjdGl2YXRlVXNlclJlc3BvbnNlEkMKCkRlbGV0ZVVzZXISGS5iZmYudjEuRGVsZXRlVXNlclJlcXVlc3QaGi5iZmYudjEuRGVsZXRlVXNlclJlc3BvbnNlQkJaQGdpdGh1Yi5jb20vQXBlaXJvbkZvdW5kYXRpb24vYXhsZS9jb250cmFjdHMvZ28vYmZmL3YxO2dlbl9iZmZfdjFiBnByb3RvMw", [file_buf_validate_validate, file_google_protobuf_field_mask, file_google_protobuf_struct, file_google_protobuf_timestamp]);
 
 /**
  * @generated from message bff.v1.User
@@ -52,6 +53,19 @@ export type User = Message<"bff.v1.User"> & {
    * @generated from field: google.protobuf.Timestamp updated_at = 7;
    */
   updatedAt?: Timestamp;
+
+  /**
+   * etag changes on every write; pass it back as expected_etag to detect
+   * concurrent edits.
+   *
+   * @generated from field: string etag = 8;
+   */
+  etag: string;
+
+  /**
+   * @generated from field: bff.v1.UserStatus status = 9;
+   */
+  status: UserStatus;
 };
 
 /**
@@ -62,18 +76,36 @@ export const UserSchema: GenMessage<User> = /*@__PURE__*/
   messageDesc(file_bff_v1_users, 0);
 
 /**
+ * Pagination is keyset-based: pass the previous next_page_token as page_token.
+ * The 1-based page number is still honoured for older clients when page_token is empty.
+ *
  * @generated from message bff.v1.ListUsersRequest
  */
 export type ListUsersRequest = Message<"bff.v1.ListUsersRequest"> & {
   /**
-   * @generated from field: int32 page = 1;
+   * @generated from field: int32 page = 1 [deprecated = true];
+   * @deprecated
    */
   page: number;
 
   /**
+   * page_size is clamped to 100; zero selects the default of 20.
+   *
    * @generated from field: int32 page_size = 2;
    */
   pageSize: number;
+
+  /**
+   * @generated from field: string page_token = 3;
+   */
+  pageToken: string;
+
+  /**
+   * skip_total omits the total count, which saves a COUNT(*) per page.
+   *
+   * @generated from field: bool skip_total = 4;
+   */
+  skipTotal: boolean;
 };
 
 /**
@@ -93,9 +125,18 @@ export type ListUsersResponse = Message<"bff.v1.ListUsersResponse"> & {
   users: User[];
 
   /**
+   * total is 0 when skip_total was requested.
+   *
    * @generated from field: int32 total = 2;
    */
   total: number;
+
+  /**
+   * next_page_token is empty on the last page.
+   *
+   * @generated from field: string next_page_token = 3;
+   */
+  nextPageToken: string;
 };
 
 /**
@@ -170,6 +211,58 @@ export const GetMeResponseSchema: GenMessage<GetMeResponse> = /*@__PURE__*/
   messageDesc(file_bff_v1_users, 6);
 
 /**
+ * GetMyAccess tells services in front of the BFF what the caller may see. The
+ * Gateway calls it with a subscriber's token to authorize event streams.
+ *
+ * @generated from message bff.v1.GetMyAccessRequest
+ */
+export type GetMyAccessRequest = Message<"bff.v1.GetMyAccessRequest"> & {
+};
+
+/**
+ * Describes the message bff.v1.GetMyAccessRequest.
+ * Use `create(GetMyAccessRequestSchema)` to create a new message.
+ */
+export const GetMyAccessRequestSchema: GenMessage<GetMyAccessRequest> = /*@__PURE__*/
+  messageDesc(file_bff_v1_users, 7);
+
+/**
+ * @generated from message bff.v1.GetMyAccessResponse
+ */
+export type GetMyAccessResponse = Message<"bff.v1.GetMyAccessResponse"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * role is the caller's account role; admins can see every project.
+   *
+   * @generated from field: bff.v1.UserRole role = 2;
+   */
+  role: UserRole;
+
+  /**
+   * project_ids lists the projects the caller is a member of, trashed ones
+   * excluded.
+   *
+   * @generated from field: repeated string project_ids = 3;
+   */
+  projectIds: string[];
+};
+
+/**
+ * Describes the message bff.v1.GetMyAccessResponse.
+ * Use `create(GetMyAccessResponseSchema)` to create a new message.
+ */
+export const GetMyAccessResponseSchema: GenMessage<GetMyAccessResponse> = /*@__PURE__*/
+  messageDesc(file_bff_v1_users, 8);
+
+/**
+ * update_mask lists the fields to write, using proto field names. Masked fields
+ * are set even when empty; "*" masks every updatable field. Without a mask,
+ * only non-empty fields are applied.
+ *
  * @generated from message bff.v1.UpdateUserRequest
  */
 export type UpdateUserRequest = Message<"bff.v1.UpdateUserRequest"> & {
@@ -184,9 +277,24 @@ export type UpdateUserRequest = Message<"bff.v1.UpdateUserRequest"> & {
   name: string;
 
   /**
+   * locale is a BCP 47 language tag such as "en" or "pt-BR".
+   *
    * @generated from field: string locale = 3;
    */
   locale: string;
+
+  /**
+   * @generated from field: google.protobuf.FieldMask update_mask = 4;
+   */
+  updateMask?: FieldMask;
+
+  /**
+   * expected_etag, when set, makes the update fail with ABORTED if the user
+   * has changed since that etag was read.
+   *
+   * @generated from field: string expected_etag = 5;
+   */
+  expectedEtag: string;
 };
 
 /**
@@ -194,7 +302,7 @@ export type UpdateUserRequest = Message<"bff.v1.UpdateUserRequest"> & {
  * Use `create(UpdateUserRequestSchema)` to create a new message.
  */
 export const UpdateUserRequestSchema: GenMessage<UpdateUserRequest> = /*@__PURE__*/
-  messageDesc(file_bff_v1_users, 7);
+  messageDesc(file_bff_v1_users, 9);
 
 /**
  * @generated from message bff.v1.UpdateUserResponse
@@ -211,7 +319,291 @@ export type UpdateUserResponse = Message<"bff.v1.UpdateUserResponse"> & {
  * Use `create(UpdateUserResponseSchema)` to create a new message.
  */
 export const UpdateUserResponseSchema: GenMessage<UpdateUserResponse> = /*@__PURE__*/
-  messageDesc(file_bff_v1_users, 8);
+  messageDesc(file_bff_v1_users, 10);
+
+/**
+ * @generated from message bff.v1.GetMyPreferencesRequest
+ */
+export type GetMyPreferencesRequest = Message<"bff.v1.GetMyPreferencesRequest"> & {
+};
+
+/**
+ * Describes the message bff.v1.GetMyPreferencesRequest.
+ * Use `create(GetMyPreferencesRequestSchema)` to create a new message.
+ */
+export const GetMyPreferencesRequestSchema: GenMessage<GetMyPreferencesRequest> = /*@__PURE__*/
+  messageDesc(file_bff_v1_users, 11);
+
+/**
+ * @generated from message bff.v1.GetMyPreferencesResponse
+ */
+export type GetMyPreferencesResponse = Message<"bff.v1.GetMyPreferencesResponse"> & {
+  /**
+   * @generated from field: google.protobuf.Struct preferences = 1;
+   */
+  preferences?: JsonObject;
+
+  /**
+   * etag is the user etag; preference writes change it too.
+   *
+   * @generated from field: string etag = 2;
+   */
+  etag: string;
+};
+
+/**
+ * Describes the message bff.v1.GetMyPreferencesResponse.
+ * Use `create(GetMyPreferencesResponseSchema)` to create a new message.
+ */
+export const GetMyPreferencesResponseSchema: GenMessage<GetMyPreferencesResponse> = /*@__PURE__*/
+  messageDesc(file_bff_v1_users, 12);
+
+/**
+ * patch is applied with JSON merge-patch semantics (RFC 7386): keys set to
+ * null are removed and all other keys are replaced.
+ *
+ * @generated from message bff.v1.UpdateMyPreferencesRequest
+ */
+export type UpdateMyPreferencesRequest = Message<"bff.v1.UpdateMyPreferencesRequest"> & {
+  /**
+   * @generated from field: google.protobuf.Struct patch = 1;
+   */
+  patch?: JsonObject;
+
+  /**
+   * @generated from field: string expected_etag = 2;
+   */
+  expectedEtag: string;
+};
+
+/**
+ * Describes the message bff.v1.UpdateMyPreferencesRequest.
+ * Use `create(UpdateMyPreferencesRequestSchema)` to create a new message.
+ */
+export const UpdateMyPreferencesRequestSchema: GenMessage<UpdateMyPreferencesRequest> = /*@__PURE__*/
+  messageDesc(file_bff_v1_users, 13);
+
+/**
+ * @generated from message bff.v1.UpdateMyPreferencesResponse
+ */
+export type UpdateMyPreferencesResponse = Message<"bff.v1.UpdateMyPreferencesResponse"> & {
+  /**
+   * @generated from field: google.protobuf.Struct preferences = 1;
+   */
+  preferences?: JsonObject;
+
+  /**
+   * @generated from field: string etag = 2;
+   */
+  etag: string;
+};
+
+/**
+ * Describes the message bff.v1.UpdateMyPreferencesResponse.
+ * Use `create(UpdateMyPreferencesResponseSchema)` to create a new message.
+ */
+export const UpdateMyPreferencesResponseSchema: GenMessage<UpdateMyPreferencesResponse> = /*@__PURE__*/
+  messageDesc(file_bff_v1_users, 14);
+
+/**
+ * CreateUser adds a user ahead of their first sign-in, e.g. to grant a role
+ * up front. The user is matched to their identity provider account by email.
+ *
+ * @generated from message bff.v1.CreateUserRequest
+ */
+export type CreateUserRequest = Message<"bff.v1.CreateUserRequest"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string email = 2;
+   */
+  email: string;
+
+  /**
+   * role defaults to USER_ROLE_MEMBER.
+   *
+   * @generated from field: bff.v1.UserRole role = 3;
+   */
+  role: UserRole;
+
+  /**
+   * locale defaults to "en".
+   *
+   * @generated from field: string locale = 4;
+   */
+  locale: string;
+};
+
+/**
+ * Describes the message bff.v1.CreateUserRequest.
+ * Use `create(CreateUserRequestSchema)` to create a new message.
+ */
+export const CreateUserRequestSchema: GenMessage<CreateUserRequest> = /*@__PURE__*/
+  messageDesc(file_bff_v1_users, 15);
+
+/**
+ * @generated from message bff.v1.CreateUserResponse
+ */
+export type CreateUserResponse = Message<"bff.v1.CreateUserResponse"> & {
+  /**
+   * @generated from field: bff.v1.User user = 1;
+   */
+  user?: User;
+};
+
+/**
+ * Describes the message bff.v1.CreateUserResponse.
+ * Use `create(CreateUserResponseSchema)` to create a new message.
+ */
+export const CreateUserResponseSchema: GenMessage<CreateUserResponse> = /*@__PURE__*/
+  messageDesc(file_bff_v1_users, 16);
+
+/**
+ * @generated from message bff.v1.UpdateUserRoleRequest
+ */
+export type UpdateUserRoleRequest = Message<"bff.v1.UpdateUserRoleRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: bff.v1.UserRole role = 2;
+   */
+  role: UserRole;
+};
+
+/**
+ * Describes the message bff.v1.UpdateUserRoleRequest.
+ * Use `create(UpdateUserRoleRequestSchema)` to create a new message.
+ */
+export const UpdateUserRoleRequestSchema: GenMessage<UpdateUserRoleRequest> = /*@__PURE__*/
+  messageDesc(file_bff_v1_users, 17);
+
+/**
+ * @generated from message bff.v1.UpdateUserRoleResponse
+ */
+export type UpdateUserRoleResponse = Message<"bff.v1.UpdateUserRoleResponse"> & {
+  /**
+   * @generated from field: bff.v1.User user = 1;
+   */
+  user?: User;
+};
+
+/**
+ * Describes the message bff.v1.UpdateUserRoleResponse.
+ * Use `create(UpdateUserRoleResponseSchema)` to create a new message.
+ */
+export const UpdateUserRoleResponseSchema: GenMessage<UpdateUserRoleResponse> = /*@__PURE__*/
+  messageDesc(file_bff_v1_users, 18);
+
+/**
+ * DeactivateUser stops a user from signing in. Requests already in flight
+ * finish, and cached access tokens stop working within a minute.
+ *
+ * @generated from message bff.v1.DeactivateUserRequest
+ */
+export type DeactivateUserRequest = Message<"bff.v1.DeactivateUserRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message bff.v1.DeactivateUserRequest.
+ * Use `create(DeactivateUserRequestSchema)` to create a new message.
+ */
+export const DeactivateUserRequestSchema: GenMessage<DeactivateUserRequest> = /*@__PURE__*/
+  messageDesc(file_bff_v1_users, 19);
+
+/**
+ * @generated from message bff.v1.DeactivateUserResponse
+ */
+export type DeactivateUserResponse = Message<"bff.v1.DeactivateUserResponse"> & {
+  /**
+   * @generated from field: bff.v1.User user = 1;
+   */
+  user?: User;
+};
+
+/**
+ * Describes the message bff.v1.DeactivateUserResponse.
+ * Use `create(DeactivateUserResponseSchema)` to create a new message.
+ */
+export const DeactivateUserResponseSchema: GenMessage<DeactivateUserResponse> = /*@__PURE__*/
+  messageDesc(file_bff_v1_users, 20);
+
+/**
+ * @generated from message bff.v1.ReactivateUserRequest
+ */
+export type ReactivateUserRequest = Message<"bff.v1.ReactivateUserRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message bff.v1.ReactivateUserRequest.
+ * Use `create(ReactivateUserRequestSchema)` to create a new message.
+ */
+export const ReactivateUserRequestSchema: GenMessage<ReactivateUserRequest> = /*@__PURE__*/
+  messageDesc(file_bff_v1_users, 21);
+
+/**
+ * @generated from message bff.v1.ReactivateUserResponse
+ */
+export type ReactivateUserResponse = Message<"bff.v1.ReactivateUserResponse"> & {
+  /**
+   * @generated from field: bff.v1.User user = 1;
+   */
+  user?: User;
+};
+
+/**
+ * Describes the message bff.v1.ReactivateUserResponse.
+ * Use `create(ReactivateUserResponseSchema)` to create a new message.
+ */
+export const ReactivateUserResponseSchema: GenMessage<ReactivateUserResponse> = /*@__PURE__*/
+  messageDesc(file_bff_v1_users, 22);
+
+/**
+ * DeleteUser removes a user with their memberships and access tokens. It fails
+ * with FAILED_PRECONDITION while the user is the only admin of a project;
+ * deactivate the user instead to keep their history attached.
+ *
+ * @generated from message bff.v1.DeleteUserRequest
+ */
+export type DeleteUserRequest = Message<"bff.v1.DeleteUserRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message bff.v1.DeleteUserRequest.
+ * Use `create(DeleteUserRequestSchema)` to create a new message.
+ */
+export const DeleteUserRequestSchema: GenMessage<DeleteUserRequest> = /*@__PURE__*/
+  messageDesc(file_bff_v1_users, 23);
+
+/**
+ * @generated from message bff.v1.DeleteUserResponse
+ */
+export type DeleteUserResponse = Message<"bff.v1.DeleteUserResponse"> & {
+};
+
+/**
+ * Describes the message bff.v1.DeleteUserResponse.
+ * Use `create(DeleteUserResponseSchema)` to create a new message.
+ */
+export const DeleteUserResponseSchema: GenMessage<DeleteUserResponse> = /*@__PURE__*/
+  messageDesc(file_bff_v1_users, 24);
 
 /**
  * UserRole represents access level of a user.
@@ -246,6 +638,37 @@ export enum UserRole {
 export const UserRoleSchema: GenEnum<UserRole> = /*@__PURE__*/
   enumDesc(file_bff_v1_users, 0);
 
+/**
+ * UserStatus says whether a user can sign in.
+ *
+ * @generated from enum bff.v1.UserStatus
+ */
+export enum UserStatus {
+  /**
+   * @generated from enum value: USER_STATUS_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: USER_STATUS_ACTIVE = 1;
+   */
+  ACTIVE = 1,
+
+  /**
+   * Deactivated users cannot sign in or use their access tokens, but keep
+   * their memberships and history.
+   *
+   * @generated from enum value: USER_STATUS_DEACTIVATED = 2;
+   */
+  DEACTIVATED = 2,
+}
+
+/**
+ * Describes the enum bff.v1.UserStatus.
+ */
+export const UserStatusSchema: GenEnum<UserStatus> = /*@__PURE__*/
+  enumDesc(file_bff_v1_users, 1);
+
 /**
  * @generated from service bff.v1.UserService
  */
@@ -274,6 +697,14 @@ export const UserService: GenService<{
     input: typeof GetMeRequestSchema;
     output: typeof GetMeResponseSchema;
   },
+  /**
+   * @generated from rpc bff.v1.UserService.GetMyAccess
+   */
+  getMyAccess: {
+    methodKind: "unary";
+    input: typeof GetMyAccessRequestSchema;
+    output: typeof GetMyAccessResponseSchema;
+  },
   /**
    * @generated from rpc bff.v1.UserService.UpdateUser
    */
@@ -282,6 +713,62 @@ export const UserService: GenService<{
     input: typeof UpdateUserRequestSchema;
     output: typeof UpdateUserResponseSchema;
   },
+  /**
+   * @generated from rpc bff.v1.UserService.GetMyPreferences
+   */
+  getMyPreferences: {
+    methodKind: "unary";
+    input: typeof GetMyPreferencesRequestSchema;
+    output: typeof GetMyPreferencesResponseSchema;
+  },
+  /**
+   * @generated from rpc bff.v1.UserService.UpdateMyPreferences
+   */
+  updateMyPreferences: {
+    methodKind: "unary";
+    input: typeof UpdateMyPreferencesRequestSchema;
+    output: typeof UpdateMyPreferencesResponseSchema;
+  },
+  /**
+   * @generated from rpc bff.v1.UserService.CreateUser
+   */
+  createUser: {
+    methodKind: "unary";
+    input: typeof CreateUserRequestSchema;
+    output: typeof CreateUserResponseSchema;
+  },
+  /**
+   * @generated from rpc bff.v1.UserService.UpdateUserRole
+   */
+  updateUserRole: {
+    methodKind: "unary";
+    input: typeof UpdateUserRoleRequestSchema;
+    output: typeof UpdateUserRoleResponseSchema;
+  },
+  /**
+   * @generated from rpc bff.v1.UserService.DeactivateUser
+   */
+  deactivateUser: {
+    methodKind: "unary";
+    input: typeof DeactivateUserRequestSchema;
+    output: typeof DeactivateUserResponseSchema;
+  },
+  /**
+   * @generated from rpc bff.v1.UserService.ReactivateUser
+   */
+  reactivateUser: {
+    methodKind: "unary";
+    input: typeof ReactivateUserRequestSchema;
+    output: typeof ReactivateUserResponseSchema;
+  },
+  /**
+   * @generated from rpc bff.v1.UserService.DeleteUser
+   */
+  deleteUser: {
+    methodKind: "unary";
+    input: typeof DeleteUserRequestSchema;
+    output: typeof DeleteUserResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_bff_v1_users, 0);
 
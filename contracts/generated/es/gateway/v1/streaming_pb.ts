@@ -4,6 +4,7 @@
 
 import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
 import { enumDesc, fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import { file_buf_validate_validate } from "../../buf/validate/validate_pb";
 import type { Timestamp } from "@bufbuild/protobuf/wkt";
 import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
 import type { Message } from "@bufbuild/protobuf";
@@ -12,15 +13,20 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file gateway/v1/streaming.proto.
  */
 export const file_gateway_v1_streaming: GenFile = /*@__PURE__*/
-  fileDesc("ChpnYXRld2F5L3YxL3N0cmVhbWluZy5wcm90bxIKZ2F0ZXdheS52MSKOAQoFRXZlbnQSCgoCaWQYASABKAkSIwoEdHlwZRgCIAEoDjIVLmdhdGV3YXkudjEuRXZlbnRUeXBlEhIKCnByb2plY3RfaWQYAyABKAkSDwoHcGF5bG9hZBgEIAEoDBIvCgtvY2N1cnJlZF9hdBgFIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAiUwoQU3Vic2NyaWJlUmVxdWVzdBITCgtwcm9qZWN0X2lkcxgBIAMoCRIqCgtldmVudF90eXBlcxgCIAMoDjIVLmdhdGV3YXkudjEuRXZlbnRUeXBlKq8BCglFdmVudFR5cGUSGgoWRVZFTlRfVFlQRV9VTlNQRUNJRklFRBAAEhsKF0VWRU5UX1RZUEVfVEFTS19DUkVBVEVEEAESGwoXRVZFTlRfVFlQRV9UQVNLX1VQREFURUQQAhIbChdFVkVOVF9UWVBFX1RBU0tfREVMRVRFRBADEhcKE0VWRU5UX1RZUEVfQUlfQ0hVTksQBBIWChJFVkVOVF9UWVBFX0FJX0RPTkUQBTJSChBTdHJlYW1pbmdTZXJ2aWNlEj4KCVN1YnNjcmliZRIcLmdhdGV3YXkudjEuU3Vic2NyaWJlUmVxdWVzdBoRLmdhdGV3YXkudjEuRXZlbnQwAUJKWkhnaXRodWIuY29tL0FwZWlyb25Gb3VuZGF0aW9uL2F4bGUvY29udHJhY3RzL2dvL2dhdGV3YXkvdjE7Z2VuX2dhdGV3YXlfdjFiBnByb3RvMw", [file_google_protobuf_timestamp]);
+  fileDesc("ChpnYXRld2F5L3YxL3N0cmVhbWluZy5wcm90bxIKZ2F0ZXdheS52MSK7AQoFRXZlbnQSEwoCaWQYASABKAlCB7pIBHICEAESLQoEdHlwZRgCIAEoDjIVLmdhdGV3YXkudjEuRXZlbnRUeXBlQgi6SAWCAQIQARISCgpwcm9qZWN0X2lkGAMgASgJEg8KB3BheWxvYWQYBCABKAwSNwoLb2NjdXJyZWRfYXQYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQga6SAPIAQESEAoIc2VxdWVuY2UYBiABKAQi1QEKEFN1YnNjcmliZVJlcXVlc3QSJgoLcHJvamVjdF9pZHMYASADKAlCEbpIDpIBCxBkGAEiBXIDsAEBEjsKC2V2ZW50X3R5cGVzGAIgAygOMhUuZ2F0ZXdheS52MS5FdmVudFR5cGVCD7pIDJIBCSIHggEEEAEgABIUCgxyZXN1bWVfYWZ0ZXIYAyABKAQSRgoUc2xvd19jb25zdW1lcl9wb2xpY3kYBCABKA4yHi5nYXRld2F5LnYxLlNsb3dDb25zdW1lclBvbGljeUIIukgFggECEAEq+gMKCUV2ZW50VHlwZRIaChZFVkVOVF9UWVBFX1VOU1BFQ0lGSUVEEAASGwoXRVZFTlRfVFlQRV9UQVNLX0NSRUFURUQQARIbChdFVkVOVF9UWVBFX1RBU0tfVVBEQVRFRBACEhsKF0VWRU5UX1RZUEVfVEFTS19ERUxFVEVEEAMSFwoTRVZFTlRfVFlQRV9BSV9DSFVOSxAEEhYKEkVWRU5UX1RZUEVfQUlfRE9ORRAFEh4KGkVWRU5UX1RZUEVfUFJPSkVDVF9DUkVBVEVEEAYSHgoaRVZFTlRfVFlQRV9QUk9KRUNUX1VQREFURUQQBxIeChpFVkVOVF9UWVBFX1BST0pFQ1RfREVMRVRFRBAIEiMKH0VWRU5UX1RZUEVfUFJPSkVDVF9NRU1CRVJfQURERUQQCRIlCiFFVkVOVF9UWVBFX1BST0pFQ1RfTUVNQkVSX1VQREFURUQQChIlCiFFVkVOVF9UWVBFX1BST0pFQ1RfTUVNQkVSX1JFTU9WRUQQCxIbChdFVkVOVF9UWVBFX1VTRVJfVVBEQVRFRBAMEhsKF0VWRU5UX1RZUEVfVVNFUl9DUkVBVEVEEA0SGwoXRVZFTlRfVFlQRV9VU0VSX0RFTEVURUQQDhIfChtFVkVOVF9UWVBFX1BST0pFQ1RfUkVTVE9SRUQQDyqoAQoSU2xvd0NvbnN1bWVyUG9saWN5EiQKIFNMT1dfQ09OU1VNRVJfUE9MSUNZX1VOU1BFQ0lGSUVEEAASIwofU0xPV19DT05TVU1FUl9QT0xJQ1lfRElTQ09OTkVDVBABEiQKIFNMT1dfQ09OU1VNRVJfUE9MSUNZX0RST1BfT0xERVNUEAISIQodU0xPV19DT05TVU1FUl9QT0xJQ1lfQ09BTEVTQ0UQAzJSChBTdHJlYW1pbmdTZXJ2aWNlEj4KCVN1YnNjcmliZRIcLmdhdGV3YXkudjEuU3Vic2NyaWJlUmVxdWVzdBoRLmdhdGV3YXkudjEuRXZlbnQwAUJKWkhnaXRodWIuY29tL0FwZWlyb25Gb3VuZGF0aW9uL2F4bGUvY29udHJhY3RzL2dvL2dhdGV3YXkvdjE7Z2VuX2dhdGV3YXlfdjFiBnByb3RvMw", [file_buf_validate_validate, file_google_protobuf_timestamp]);
 
 /**
- * Event is a single server-push event delivered to the frontend.
+ * Event is a single server-push event delivered to the frontend. The Gateway
+ * checks each event against these rules as it reads it, and sends those that
+ * break them to its dead-letter log instead of to subscribers.
  *
  * @generated from message gateway.v1.Event
  */
 export type Event = Message<"gateway.v1.Event"> & {
   /**
+   * id is unique per event; delivery is at-least-once, so clients should
+   * ignore ids they have already seen.
+   *
    * @generated from field: string id = 1;
    */
   id: string;
@@ -36,6 +42,11 @@ export type Event = Message<"gateway.v1.Event"> & {
   projectId: string;
 
   /**
+   * payload is the serialized entity the event is about: an ai.v1.AITask for
+   * TASK_*, a bff.v1.Project for PROJECT_*, a bff.v1.ProjectMember for
+   * PROJECT_MEMBER_* and a bff.v1.User for USER_*. Delete and remove events
+   * carry only the identifying fields.
+   *
    * @generated from field: bytes payload = 4;
    */
   payload: Uint8Array;
@@ -44,6 +55,15 @@ export type Event = Message<"gateway.v1.Event"> & {
    * @generated from field: google.protobuf.Timestamp occurred_at = 5;
    */
   occurredAt?: Timestamp;
+
+  /**
+   * sequence is the event's position in the Gateway's event log; it grows
+   * with every event. Pass the last one received as
+   * SubscribeRequest.resume_after when reconnecting.
+   *
+   * @generated from field: uint64 sequence = 6;
+   */
+  sequence: bigint;
 };
 
 /**
@@ -72,6 +92,25 @@ export type SubscribeRequest = Message<"gateway.v1.SubscribeRequest"> & {
    * @generated from field: repeated gateway.v1.EventType event_types = 2;
    */
   eventTypes: EventType[];
+
+  /**
+   * resume_after replays the matching events logged after this sequence
+   * before live delivery starts, so a reconnecting client misses none of
+   * them. 0 starts with live events. Events are kept for a limited time
+   * (24h by default); resuming from an expired sequence fails with
+   * OUT_OF_RANGE, and the client should reload its state and subscribe
+   * afresh.
+   *
+   * @generated from field: uint64 resume_after = 3;
+   */
+  resumeAfter: bigint;
+
+  /**
+   * slow_consumer_policy applies once this subscriber falls behind.
+   *
+   * @generated from field: gateway.v1.SlowConsumerPolicy slow_consumer_policy = 4;
+   */
+  slowConsumerPolicy: SlowConsumerPolicy;
 };
 
 /**
@@ -116,6 +155,56 @@ export enum EventType {
    * @generated from enum value: EVENT_TYPE_AI_DONE = 5;
    */
   AI_DONE = 5,
+
+  /**
+   * @generated from enum value: EVENT_TYPE_PROJECT_CREATED = 6;
+   */
+  PROJECT_CREATED = 6,
+
+  /**
+   * @generated from enum value: EVENT_TYPE_PROJECT_UPDATED = 7;
+   */
+  PROJECT_UPDATED = 7,
+
+  /**
+   * @generated from enum value: EVENT_TYPE_PROJECT_DELETED = 8;
+   */
+  PROJECT_DELETED = 8,
+
+  /**
+   * @generated from enum value: EVENT_TYPE_PROJECT_MEMBER_ADDED = 9;
+   */
+  PROJECT_MEMBER_ADDED = 9,
+
+  /**
+   * @generated from enum value: EVENT_TYPE_PROJECT_MEMBER_UPDATED = 10;
+   */
+  PROJECT_MEMBER_UPDATED = 10,
+
+  /**
+   * @generated from enum value: EVENT_TYPE_PROJECT_MEMBER_REMOVED = 11;
+   */
+  PROJECT_MEMBER_REMOVED = 11,
+
+  /**
+   * @generated from enum value: EVENT_TYPE_USER_UPDATED = 12;
+   */
+  USER_UPDATED = 12,
+
+  /**
+   * @generated from enum value: EVENT_TYPE_USER_CREATED = 13;
+   */
+  USER_CREATED = 13,
+
+  /**
+   * @generated from enum value: EVENT_TYPE_USER_DELETED = 14;
+   */
+  USER_DELETED = 14,
+
+  /**
+   * @generated from enum value: EVENT_TYPE_PROJECT_RESTORED = 15;
+   */
+  PROJECT_RESTORED = 15,
 }
 
 /**
@@ -124,6 +213,51 @@ export enum EventType {
 export const EventTypeSchema: GenEnum<EventType> = /*@__PURE__*/
   enumDesc(file_gateway_v1_streaming, 0);
 
+/**
+ * SlowConsumerPolicy decides what the Gateway does once a subscriber falls so
+ * far behind that its buffer is full.
+ *
+ * @generated from enum gateway.v1.SlowConsumerPolicy
+ */
+export enum SlowConsumerPolicy {
+  /**
+   * The Gateway's configured default, DISCONNECT unless set otherwise.
+   *
+   * @generated from enum value: SLOW_CONSUMER_POLICY_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * End the stream with RESOURCE_EXHAUSTED. The error's Axle-Resume-After
+   * metadata holds the sequence to pass as resume_after, so resubscribing
+   * loses nothing.
+   *
+   * @generated from enum value: SLOW_CONSUMER_POLICY_DISCONNECT = 1;
+   */
+  DISCONNECT = 1,
+
+  /**
+   * Discard the oldest buffered event to make room.
+   *
+   * @generated from enum value: SLOW_CONSUMER_POLICY_DROP_OLDEST = 2;
+   */
+  DROP_OLDEST = 2,
+
+  /**
+   * Keep only the latest buffered *_UPDATED event per entity, then
+   * disconnect as DISCONNECT does once the buffer is full regardless.
+   *
+   * @generated from enum value: SLOW_CONSUMER_POLICY_COALESCE = 3;
+   */
+  COALESCE = 3,
+}
+
+/**
+ * Describes the enum gateway.v1.SlowConsumerPolicy.
+ */
+export const SlowConsumerPolicySchema: GenEnum<SlowConsumerPolicy> = /*@__PURE__*/
+  enumDesc(file_gateway_v1_streaming, 1);
+
 /**
  * StreamingService is exposed by the Gateway for real-time event delivery.
  *
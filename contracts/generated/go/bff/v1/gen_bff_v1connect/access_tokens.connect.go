@@ -0,0 +1,179 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: bff/v1/access_tokens.proto
+
+package gen_bff_v1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/ApeironFoundation/axle/contracts/go/bff/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// AccessTokenServiceName is the fully-qualified name of the AccessTokenService service.
+	AccessTokenServiceName = "bff.v1.AccessTokenService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// AccessTokenServiceCreateAccessTokenProcedure is the fully-qualified name of the
+	// AccessTokenService's CreateAccessToken RPC.
+	AccessTokenServiceCreateAccessTokenProcedure = "/bff.v1.AccessTokenService/CreateAccessToken"
+	// AccessTokenServiceListAccessTokensProcedure is the fully-qualified name of the
+	// AccessTokenService's ListAccessTokens RPC.
+	AccessTokenServiceListAccessTokensProcedure = "/bff.v1.AccessTokenService/ListAccessTokens"
+	// AccessTokenServiceRevokeAccessTokenProcedure is the fully-qualified name of the
+	// AccessTokenService's RevokeAccessToken RPC.
+	AccessTokenServiceRevokeAccessTokenProcedure = "/bff.v1.AccessTokenService/RevokeAccessToken"
+)
+
+// AccessTokenServiceClient is a client for the bff.v1.AccessTokenService service.
+type AccessTokenServiceClient interface {
+	CreateAccessToken(context.Context, *v1.CreateAccessTokenRequest) (*v1.CreateAccessTokenResponse, error)
+	ListAccessTokens(context.Context, *v1.ListAccessTokensRequest) (*v1.ListAccessTokensResponse, error)
+	RevokeAccessToken(context.Context, *v1.RevokeAccessTokenRequest) (*v1.RevokeAccessTokenResponse, error)
+}
+
+// NewAccessTokenServiceClient constructs a client for the bff.v1.AccessTokenService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewAccessTokenServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) AccessTokenServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	accessTokenServiceMethods := v1.File_bff_v1_access_tokens_proto.Services().ByName("AccessTokenService").Methods()
+	return &accessTokenServiceClient{
+		createAccessToken: connect.NewClient[v1.CreateAccessTokenRequest, v1.CreateAccessTokenResponse](
+			httpClient,
+			baseURL+AccessTokenServiceCreateAccessTokenProcedure,
+			connect.WithSchema(accessTokenServiceMethods.ByName("CreateAccessToken")),
+			connect.WithClientOptions(opts...),
+		),
+		listAccessTokens: connect.NewClient[v1.ListAccessTokensRequest, v1.ListAccessTokensResponse](
+			httpClient,
+			baseURL+AccessTokenServiceListAccessTokensProcedure,
+			connect.WithSchema(accessTokenServiceMethods.ByName("ListAccessTokens")),
+			connect.WithClientOptions(opts...),
+		),
+		revokeAccessToken: connect.NewClient[v1.RevokeAccessTokenRequest, v1.RevokeAccessTokenResponse](
+			httpClient,
+			baseURL+AccessTokenServiceRevokeAccessTokenProcedure,
+			connect.WithSchema(accessTokenServiceMethods.ByName("RevokeAccessToken")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// accessTokenServiceClient implements AccessTokenServiceClient.
+type accessTokenServiceClient struct {
+	createAccessToken *connect.Client[v1.CreateAccessTokenRequest, v1.CreateAccessTokenResponse]
+	listAccessTokens  *connect.Client[v1.ListAccessTokensRequest, v1.ListAccessTokensResponse]
+	revokeAccessToken *connect.Client[v1.RevokeAccessTokenRequest, v1.RevokeAccessTokenResponse]
+}
+
+// CreateAccessToken calls bff.v1.AccessTokenService.CreateAccessToken.
+func (c *accessTokenServiceClient) CreateAccessToken(ctx context.Context, req *v1.CreateAccessTokenRequest) (*v1.CreateAccessTokenResponse, error) {
+	response, err := c.createAccessToken.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListAccessTokens calls bff.v1.AccessTokenService.ListAccessTokens.
+func (c *accessTokenServiceClient) ListAccessTokens(ctx context.Context, req *v1.ListAccessTokensRequest) (*v1.ListAccessTokensResponse, error) {
+	response, err := c.listAccessTokens.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// RevokeAccessToken calls bff.v1.AccessTokenService.RevokeAccessToken.
+func (c *accessTokenServiceClient) RevokeAccessToken(ctx context.Context, req *v1.RevokeAccessTokenRequest) (*v1.RevokeAccessTokenResponse, error) {
+	response, err := c.revokeAccessToken.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// AccessTokenServiceHandler is an implementation of the bff.v1.AccessTokenService service.
+type AccessTokenServiceHandler interface {
+	CreateAccessToken(context.Context, *v1.CreateAccessTokenRequest) (*v1.CreateAccessTokenResponse, error)
+	ListAccessTokens(context.Context, *v1.ListAccessTokensRequest) (*v1.ListAccessTokensResponse, error)
+	RevokeAccessToken(context.Context, *v1.RevokeAccessTokenRequest) (*v1.RevokeAccessTokenResponse, error)
+}
+
+// NewAccessTokenServiceHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewAccessTokenServiceHandler(svc AccessTokenServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	accessTokenServiceMethods := v1.File_bff_v1_access_tokens_proto.Services().ByName("AccessTokenService").Methods()
+	accessTokenServiceCreateAccessTokenHandler := connect.NewUnaryHandlerSimple(
+		AccessTokenServiceCreateAccessTokenProcedure,
+		svc.CreateAccessToken,
+		connect.WithSchema(accessTokenServiceMethods.ByName("CreateAccessToken")),
+		connect.WithHandlerOptions(opts...),
+	)
+	accessTokenServiceListAccessTokensHandler := connect.NewUnaryHandlerSimple(
+		AccessTokenServiceListAccessTokensProcedure,
+		svc.ListAccessTokens,
+		connect.WithSchema(accessTokenServiceMethods.ByName("ListAccessTokens")),
+		connect.WithHandlerOptions(opts...),
+	)
+	accessTokenServiceRevokeAccessTokenHandler := connect.NewUnaryHandlerSimple(
+		AccessTokenServiceRevokeAccessTokenProcedure,
+		svc.RevokeAccessToken,
+		connect.WithSchema(accessTokenServiceMethods.ByName("RevokeAccessToken")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/bff.v1.AccessTokenService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case AccessTokenServiceCreateAccessTokenProcedure:
+			accessTokenServiceCreateAccessTokenHandler.ServeHTTP(w, r)
+		case AccessTokenServiceListAccessTokensProcedure:
+			accessTokenServiceListAccessTokensHandler.ServeHTTP(w, r)
+		case AccessTokenServiceRevokeAccessTokenProcedure:
+			accessTokenServiceRevokeAccessTokenHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedAccessTokenServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedAccessTokenServiceHandler struct{}
+
+func (UnimplementedAccessTokenServiceHandler) CreateAccessToken(context.Context, *v1.CreateAccessTokenRequest) (*v1.CreateAccessTokenResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bff.v1.AccessTokenService.CreateAccessToken is not implemented"))
+}
+
+func (UnimplementedAccessTokenServiceHandler) ListAccessTokens(context.Context, *v1.ListAccessTokensRequest) (*v1.ListAccessTokensResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bff.v1.AccessTokenService.ListAccessTokens is not implemented"))
+}
+
+func (UnimplementedAccessTokenServiceHandler) RevokeAccessToken(context.Context, *v1.RevokeAccessTokenRequest) (*v1.RevokeAccessTokenResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bff.v1.AccessTokenService.RevokeAccessToken is not implemented"))
+}
@@ -0,0 +1,245 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: bff/v1/invitations.proto
+
+package gen_bff_v1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/ApeironFoundation/axle/contracts/go/bff/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// InvitationServiceName is the fully-qualified name of the InvitationService service.
+	InvitationServiceName = "bff.v1.InvitationService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// InvitationServiceInviteToProjectProcedure is the fully-qualified name of the InvitationService's
+	// InviteToProject RPC.
+	InvitationServiceInviteToProjectProcedure = "/bff.v1.InvitationService/InviteToProject"
+	// InvitationServiceListInvitationsProcedure is the fully-qualified name of the InvitationService's
+	// ListInvitations RPC.
+	InvitationServiceListInvitationsProcedure = "/bff.v1.InvitationService/ListInvitations"
+	// InvitationServiceRevokeInvitationProcedure is the fully-qualified name of the InvitationService's
+	// RevokeInvitation RPC.
+	InvitationServiceRevokeInvitationProcedure = "/bff.v1.InvitationService/RevokeInvitation"
+	// InvitationServiceAcceptInvitationProcedure is the fully-qualified name of the InvitationService's
+	// AcceptInvitation RPC.
+	InvitationServiceAcceptInvitationProcedure = "/bff.v1.InvitationService/AcceptInvitation"
+	// InvitationServiceDeclineInvitationProcedure is the fully-qualified name of the
+	// InvitationService's DeclineInvitation RPC.
+	InvitationServiceDeclineInvitationProcedure = "/bff.v1.InvitationService/DeclineInvitation"
+)
+
+// InvitationServiceClient is a client for the bff.v1.InvitationService service.
+type InvitationServiceClient interface {
+	InviteToProject(context.Context, *v1.InviteToProjectRequest) (*v1.InviteToProjectResponse, error)
+	ListInvitations(context.Context, *v1.ListInvitationsRequest) (*v1.ListInvitationsResponse, error)
+	RevokeInvitation(context.Context, *v1.RevokeInvitationRequest) (*v1.RevokeInvitationResponse, error)
+	AcceptInvitation(context.Context, *v1.AcceptInvitationRequest) (*v1.AcceptInvitationResponse, error)
+	DeclineInvitation(context.Context, *v1.DeclineInvitationRequest) (*v1.DeclineInvitationResponse, error)
+}
+
+// NewInvitationServiceClient constructs a client for the bff.v1.InvitationService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewInvitationServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) InvitationServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	invitationServiceMethods := v1.File_bff_v1_invitations_proto.Services().ByName("InvitationService").Methods()
+	return &invitationServiceClient{
+		inviteToProject: connect.NewClient[v1.InviteToProjectRequest, v1.InviteToProjectResponse](
+			httpClient,
+			baseURL+InvitationServiceInviteToProjectProcedure,
+			connect.WithSchema(invitationServiceMethods.ByName("InviteToProject")),
+			connect.WithClientOptions(opts...),
+		),
+		listInvitations: connect.NewClient[v1.ListInvitationsRequest, v1.ListInvitationsResponse](
+			httpClient,
+			baseURL+InvitationServiceListInvitationsProcedure,
+			connect.WithSchema(invitationServiceMethods.ByName("ListInvitations")),
+			connect.WithClientOptions(opts...),
+		),
+		revokeInvitation: connect.NewClient[v1.RevokeInvitationRequest, v1.RevokeInvitationResponse](
+			httpClient,
+			baseURL+InvitationServiceRevokeInvitationProcedure,
+			connect.WithSchema(invitationServiceMethods.ByName("RevokeInvitation")),
+			connect.WithClientOptions(opts...),
+		),
+		acceptInvitation: connect.NewClient[v1.AcceptInvitationRequest, v1.AcceptInvitationResponse](
+			httpClient,
+			baseURL+InvitationServiceAcceptInvitationProcedure,
+			connect.WithSchema(invitationServiceMethods.ByName("AcceptInvitation")),
+			connect.WithClientOptions(opts...),
+		),
+		declineInvitation: connect.NewClient[v1.DeclineInvitationRequest, v1.DeclineInvitationResponse](
+			httpClient,
+			baseURL+InvitationServiceDeclineInvitationProcedure,
+			connect.WithSchema(invitationServiceMethods.ByName("DeclineInvitation")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// invitationServiceClient implements InvitationServiceClient.
+type invitationServiceClient struct {
+	inviteToProject   *connect.Client[v1.InviteToProjectRequest, v1.InviteToProjectResponse]
+	listInvitations   *connect.Client[v1.ListInvitationsRequest, v1.ListInvitationsResponse]
+	revokeInvitation  *connect.Client[v1.RevokeInvitationRequest, v1.RevokeInvitationResponse]
+	acceptInvitation  *connect.Client[v1.AcceptInvitationRequest, v1.AcceptInvitationResponse]
+	declineInvitation *connect.Client[v1.DeclineInvitationRequest, v1.DeclineInvitationResponse]
+}
+
+// InviteToProject calls bff.v1.InvitationService.InviteToProject.
+func (c *invitationServiceClient) InviteToProject(ctx context.Context, req *v1.InviteToProjectRequest) (*v1.InviteToProjectResponse, error) {
+	response, err := c.inviteToProject.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListInvitations calls bff.v1.InvitationService.ListInvitations.
+func (c *invitationServiceClient) ListInvitations(ctx context.Context, req *v1.ListInvitationsRequest) (*v1.ListInvitationsResponse, error) {
+	response, err := c.listInvitations.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// RevokeInvitation calls bff.v1.InvitationService.RevokeInvitation.
+func (c *invitationServiceClient) RevokeInvitation(ctx context.Context, req *v1.RevokeInvitationRequest) (*v1.RevokeInvitationResponse, error) {
+	response, err := c.revokeInvitation.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// AcceptInvitation calls bff.v1.InvitationService.AcceptInvitation.
+func (c *invitationServiceClient) AcceptInvitation(ctx context.Context, req *v1.AcceptInvitationRequest) (*v1.AcceptInvitationResponse, error) {
+	response, err := c.acceptInvitation.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// DeclineInvitation calls bff.v1.InvitationService.DeclineInvitation.
+func (c *invitationServiceClient) DeclineInvitation(ctx context.Context, req *v1.DeclineInvitationRequest) (*v1.DeclineInvitationResponse, error) {
+	response, err := c.declineInvitation.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// InvitationServiceHandler is an implementation of the bff.v1.InvitationService service.
+type InvitationServiceHandler interface {
+	InviteToProject(context.Context, *v1.InviteToProjectRequest) (*v1.InviteToProjectResponse, error)
+	ListInvitations(context.Context, *v1.ListInvitationsRequest) (*v1.ListInvitationsResponse, error)
+	RevokeInvitation(context.Context, *v1.RevokeInvitationRequest) (*v1.RevokeInvitationResponse, error)
+	AcceptInvitation(context.Context, *v1.AcceptInvitationRequest) (*v1.AcceptInvitationResponse, error)
+	DeclineInvitation(context.Context, *v1.DeclineInvitationRequest) (*v1.DeclineInvitationResponse, error)
+}
+
+// NewInvitationServiceHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewInvitationServiceHandler(svc InvitationServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	invitationServiceMethods := v1.File_bff_v1_invitations_proto.Services().ByName("InvitationService").Methods()
+	invitationServiceInviteToProjectHandler := connect.NewUnaryHandlerSimple(
+		InvitationServiceInviteToProjectProcedure,
+		svc.InviteToProject,
+		connect.WithSchema(invitationServiceMethods.ByName("InviteToProject")),
+		connect.WithHandlerOptions(opts...),
+	)
+	invitationServiceListInvitationsHandler := connect.NewUnaryHandlerSimple(
+		InvitationServiceListInvitationsProcedure,
+		svc.ListInvitations,
+		connect.WithSchema(invitationServiceMethods.ByName("ListInvitations")),
+		connect.WithHandlerOptions(opts...),
+	)
+	invitationServiceRevokeInvitationHandler := connect.NewUnaryHandlerSimple(
+		InvitationServiceRevokeInvitationProcedure,
+		svc.RevokeInvitation,
+		connect.WithSchema(invitationServiceMethods.ByName("RevokeInvitation")),
+		connect.WithHandlerOptions(opts...),
+	)
+	invitationServiceAcceptInvitationHandler := connect.NewUnaryHandlerSimple(
+		InvitationServiceAcceptInvitationProcedure,
+		svc.AcceptInvitation,
+		connect.WithSchema(invitationServiceMethods.ByName("AcceptInvitation")),
+		connect.WithHandlerOptions(opts...),
+	)
+	invitationServiceDeclineInvitationHandler := connect.NewUnaryHandlerSimple(
+		InvitationServiceDeclineInvitationProcedure,
+		svc.DeclineInvitation,
+		connect.WithSchema(invitationServiceMethods.ByName("DeclineInvitation")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/bff.v1.InvitationService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case InvitationServiceInviteToProjectProcedure:
+			invitationServiceInviteToProjectHandler.ServeHTTP(w, r)
+		case InvitationServiceListInvitationsProcedure:
+			invitationServiceListInvitationsHandler.ServeHTTP(w, r)
+		case InvitationServiceRevokeInvitationProcedure:
+			invitationServiceRevokeInvitationHandler.ServeHTTP(w, r)
+		case InvitationServiceAcceptInvitationProcedure:
+			invitationServiceAcceptInvitationHandler.ServeHTTP(w, r)
+		case InvitationServiceDeclineInvitationProcedure:
+			invitationServiceDeclineInvitationHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedInvitationServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedInvitationServiceHandler struct{}
+
+func (UnimplementedInvitationServiceHandler) InviteToProject(context.Context, *v1.InviteToProjectRequest) (*v1.InviteToProjectResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bff.v1.InvitationService.InviteToProject is not implemented"))
+}
+
+func (UnimplementedInvitationServiceHandler) ListInvitations(context.Context, *v1.ListInvitationsRequest) (*v1.ListInvitationsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bff.v1.InvitationService.ListInvitations is not implemented"))
+}
+
+func (UnimplementedInvitationServiceHandler) RevokeInvitation(context.Context, *v1.RevokeInvitationRequest) (*v1.RevokeInvitationResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bff.v1.InvitationService.RevokeInvitation is not implemented"))
+}
+
+func (UnimplementedInvitationServiceHandler) AcceptInvitation(context.Context, *v1.AcceptInvitationRequest) (*v1.AcceptInvitationResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bff.v1.InvitationService.AcceptInvitation is not implemented"))
+}
+
+func (UnimplementedInvitationServiceHandler) DeclineInvitation(context.Context, *v1.DeclineInvitationRequest) (*v1.DeclineInvitationResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bff.v1.InvitationService.DeclineInvitation is not implemented"))
+}
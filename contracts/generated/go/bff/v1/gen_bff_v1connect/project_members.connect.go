@@ -0,0 +1,212 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: bff/v1/project_members.proto
+
+package gen_bff_v1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/ApeironFoundation/axle/contracts/go/bff/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// ProjectMemberServiceName is the fully-qualified name of the ProjectMemberService service.
+	ProjectMemberServiceName = "bff.v1.ProjectMemberService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// ProjectMemberServiceListProjectMembersProcedure is the fully-qualified name of the
+	// ProjectMemberService's ListProjectMembers RPC.
+	ProjectMemberServiceListProjectMembersProcedure = "/bff.v1.ProjectMemberService/ListProjectMembers"
+	// ProjectMemberServiceAddProjectMemberProcedure is the fully-qualified name of the
+	// ProjectMemberService's AddProjectMember RPC.
+	ProjectMemberServiceAddProjectMemberProcedure = "/bff.v1.ProjectMemberService/AddProjectMember"
+	// ProjectMemberServiceUpdateProjectMemberRoleProcedure is the fully-qualified name of the
+	// ProjectMemberService's UpdateProjectMemberRole RPC.
+	ProjectMemberServiceUpdateProjectMemberRoleProcedure = "/bff.v1.ProjectMemberService/UpdateProjectMemberRole"
+	// ProjectMemberServiceRemoveProjectMemberProcedure is the fully-qualified name of the
+	// ProjectMemberService's RemoveProjectMember RPC.
+	ProjectMemberServiceRemoveProjectMemberProcedure = "/bff.v1.ProjectMemberService/RemoveProjectMember"
+)
+
+// ProjectMemberServiceClient is a client for the bff.v1.ProjectMemberService service.
+type ProjectMemberServiceClient interface {
+	ListProjectMembers(context.Context, *v1.ListProjectMembersRequest) (*v1.ListProjectMembersResponse, error)
+	AddProjectMember(context.Context, *v1.AddProjectMemberRequest) (*v1.AddProjectMemberResponse, error)
+	UpdateProjectMemberRole(context.Context, *v1.UpdateProjectMemberRoleRequest) (*v1.UpdateProjectMemberRoleResponse, error)
+	RemoveProjectMember(context.Context, *v1.RemoveProjectMemberRequest) (*v1.RemoveProjectMemberResponse, error)
+}
+
+// NewProjectMemberServiceClient constructs a client for the bff.v1.ProjectMemberService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewProjectMemberServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ProjectMemberServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	projectMemberServiceMethods := v1.File_bff_v1_project_members_proto.Services().ByName("ProjectMemberService").Methods()
+	return &projectMemberServiceClient{
+		listProjectMembers: connect.NewClient[v1.ListProjectMembersRequest, v1.ListProjectMembersResponse](
+			httpClient,
+			baseURL+ProjectMemberServiceListProjectMembersProcedure,
+			connect.WithSchema(projectMemberServiceMethods.ByName("ListProjectMembers")),
+			connect.WithClientOptions(opts...),
+		),
+		addProjectMember: connect.NewClient[v1.AddProjectMemberRequest, v1.AddProjectMemberResponse](
+			httpClient,
+			baseURL+ProjectMemberServiceAddProjectMemberProcedure,
+			connect.WithSchema(projectMemberServiceMethods.ByName("AddProjectMember")),
+			connect.WithClientOptions(opts...),
+		),
+		updateProjectMemberRole: connect.NewClient[v1.UpdateProjectMemberRoleRequest, v1.UpdateProjectMemberRoleResponse](
+			httpClient,
+			baseURL+ProjectMemberServiceUpdateProjectMemberRoleProcedure,
+			connect.WithSchema(projectMemberServiceMethods.ByName("UpdateProjectMemberRole")),
+			connect.WithClientOptions(opts...),
+		),
+		removeProjectMember: connect.NewClient[v1.RemoveProjectMemberRequest, v1.RemoveProjectMemberResponse](
+			httpClient,
+			baseURL+ProjectMemberServiceRemoveProjectMemberProcedure,
+			connect.WithSchema(projectMemberServiceMethods.ByName("RemoveProjectMember")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// projectMemberServiceClient implements ProjectMemberServiceClient.
+type projectMemberServiceClient struct {
+	listProjectMembers      *connect.Client[v1.ListProjectMembersRequest, v1.ListProjectMembersResponse]
+	addProjectMember        *connect.Client[v1.AddProjectMemberRequest, v1.AddProjectMemberResponse]
+	updateProjectMemberRole *connect.Client[v1.UpdateProjectMemberRoleRequest, v1.UpdateProjectMemberRoleResponse]
+	removeProjectMember     *connect.Client[v1.RemoveProjectMemberRequest, v1.RemoveProjectMemberResponse]
+}
+
+// ListProjectMembers calls bff.v1.ProjectMemberService.ListProjectMembers.
+func (c *projectMemberServiceClient) ListProjectMembers(ctx context.Context, req *v1.ListProjectMembersRequest) (*v1.ListProjectMembersResponse, error) {
+	response, err := c.listProjectMembers.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// AddProjectMember calls bff.v1.ProjectMemberService.AddProjectMember.
+func (c *projectMemberServiceClient) AddProjectMember(ctx context.Context, req *v1.AddProjectMemberRequest) (*v1.AddProjectMemberResponse, error) {
+	response, err := c.addProjectMember.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// UpdateProjectMemberRole calls bff.v1.ProjectMemberService.UpdateProjectMemberRole.
+func (c *projectMemberServiceClient) UpdateProjectMemberRole(ctx context.Context, req *v1.UpdateProjectMemberRoleRequest) (*v1.UpdateProjectMemberRoleResponse, error) {
+	response, err := c.updateProjectMemberRole.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// RemoveProjectMember calls bff.v1.ProjectMemberService.RemoveProjectMember.
+func (c *projectMemberServiceClient) RemoveProjectMember(ctx context.Context, req *v1.RemoveProjectMemberRequest) (*v1.RemoveProjectMemberResponse, error) {
+	response, err := c.removeProjectMember.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ProjectMemberServiceHandler is an implementation of the bff.v1.ProjectMemberService service.
+type ProjectMemberServiceHandler interface {
+	ListProjectMembers(context.Context, *v1.ListProjectMembersRequest) (*v1.ListProjectMembersResponse, error)
+	AddProjectMember(context.Context, *v1.AddProjectMemberRequest) (*v1.AddProjectMemberResponse, error)
+	UpdateProjectMemberRole(context.Context, *v1.UpdateProjectMemberRoleRequest) (*v1.UpdateProjectMemberRoleResponse, error)
+	RemoveProjectMember(context.Context, *v1.RemoveProjectMemberRequest) (*v1.RemoveProjectMemberResponse, error)
+}
+
+// NewProjectMemberServiceHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewProjectMemberServiceHandler(svc ProjectMemberServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	projectMemberServiceMethods := v1.File_bff_v1_project_members_proto.Services().ByName("ProjectMemberService").Methods()
+	projectMemberServiceListProjectMembersHandler := connect.NewUnaryHandlerSimple(
+		ProjectMemberServiceListProjectMembersProcedure,
+		svc.ListProjectMembers,
+		connect.WithSchema(projectMemberServiceMethods.ByName("ListProjectMembers")),
+		connect.WithHandlerOptions(opts...),
+	)
+	projectMemberServiceAddProjectMemberHandler := connect.NewUnaryHandlerSimple(
+		ProjectMemberServiceAddProjectMemberProcedure,
+		svc.AddProjectMember,
+		connect.WithSchema(projectMemberServiceMethods.ByName("AddProjectMember")),
+		connect.WithHandlerOptions(opts...),
+	)
+	projectMemberServiceUpdateProjectMemberRoleHandler := connect.NewUnaryHandlerSimple(
+		ProjectMemberServiceUpdateProjectMemberRoleProcedure,
+		svc.UpdateProjectMemberRole,
+		connect.WithSchema(projectMemberServiceMethods.ByName("UpdateProjectMemberRole")),
+		connect.WithHandlerOptions(opts...),
+	)
+	projectMemberServiceRemoveProjectMemberHandler := connect.NewUnaryHandlerSimple(
+		ProjectMemberServiceRemoveProjectMemberProcedure,
+		svc.RemoveProjectMember,
+		connect.WithSchema(projectMemberServiceMethods.ByName("RemoveProjectMember")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/bff.v1.ProjectMemberService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case ProjectMemberServiceListProjectMembersProcedure:
+			projectMemberServiceListProjectMembersHandler.ServeHTTP(w, r)
+		case ProjectMemberServiceAddProjectMemberProcedure:
+			projectMemberServiceAddProjectMemberHandler.ServeHTTP(w, r)
+		case ProjectMemberServiceUpdateProjectMemberRoleProcedure:
+			projectMemberServiceUpdateProjectMemberRoleHandler.ServeHTTP(w, r)
+		case ProjectMemberServiceRemoveProjectMemberProcedure:
+			projectMemberServiceRemoveProjectMemberHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedProjectMemberServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedProjectMemberServiceHandler struct{}
+
+func (UnimplementedProjectMemberServiceHandler) ListProjectMembers(context.Context, *v1.ListProjectMembersRequest) (*v1.ListProjectMembersResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bff.v1.ProjectMemberService.ListProjectMembers is not implemented"))
+}
+
+func (UnimplementedProjectMemberServiceHandler) AddProjectMember(context.Context, *v1.AddProjectMemberRequest) (*v1.AddProjectMemberResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bff.v1.ProjectMemberService.AddProjectMember is not implemented"))
+}
+
+func (UnimplementedProjectMemberServiceHandler) UpdateProjectMemberRole(context.Context, *v1.UpdateProjectMemberRoleRequest) (*v1.UpdateProjectMemberRoleResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bff.v1.ProjectMemberService.UpdateProjectMemberRole is not implemented"))
+}
+
+func (UnimplementedProjectMemberServiceHandler) RemoveProjectMember(context.Context, *v1.RemoveProjectMemberRequest) (*v1.RemoveProjectMemberResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bff.v1.ProjectMemberService.RemoveProjectMember is not implemented"))
+}
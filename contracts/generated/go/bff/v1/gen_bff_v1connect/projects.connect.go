@@ -48,6 +48,18 @@ const (
 	// ProjectServiceDeleteProjectProcedure is the fully-qualified name of the ProjectService's
 	// DeleteProject RPC.
 	ProjectServiceDeleteProjectProcedure = "/bff.v1.ProjectService/DeleteProject"
+	// ProjectServiceListTrashedProjectsProcedure is the fully-qualified name of the ProjectService's
+	// ListTrashedProjects RPC.
+	ProjectServiceListTrashedProjectsProcedure = "/bff.v1.ProjectService/ListTrashedProjects"
+	// ProjectServiceRestoreProjectProcedure is the fully-qualified name of the ProjectService's
+	// RestoreProject RPC.
+	ProjectServiceRestoreProjectProcedure = "/bff.v1.ProjectService/RestoreProject"
+	// ProjectServiceGetProjectSettingsProcedure is the fully-qualified name of the ProjectService's
+	// GetProjectSettings RPC.
+	ProjectServiceGetProjectSettingsProcedure = "/bff.v1.ProjectService/GetProjectSettings"
+	// ProjectServiceUpdateProjectSettingsProcedure is the fully-qualified name of the ProjectService's
+	// UpdateProjectSettings RPC.
+	ProjectServiceUpdateProjectSettingsProcedure = "/bff.v1.ProjectService/UpdateProjectSettings"
 )
 
 // ProjectServiceClient is a client for the bff.v1.ProjectService service.
@@ -57,6 +69,10 @@ type ProjectServiceClient interface {
 	CreateProject(context.Context, *v1.CreateProjectRequest) (*v1.CreateProjectResponse, error)
 	UpdateProject(context.Context, *v1.UpdateProjectRequest) (*v1.UpdateProjectResponse, error)
 	DeleteProject(context.Context, *v1.DeleteProjectRequest) (*v1.DeleteProjectResponse, error)
+	ListTrashedProjects(context.Context, *v1.ListTrashedProjectsRequest) (*v1.ListTrashedProjectsResponse, error)
+	RestoreProject(context.Context, *v1.RestoreProjectRequest) (*v1.RestoreProjectResponse, error)
+	GetProjectSettings(context.Context, *v1.GetProjectSettingsRequest) (*v1.GetProjectSettingsResponse, error)
+	UpdateProjectSettings(context.Context, *v1.UpdateProjectSettingsRequest) (*v1.UpdateProjectSettingsResponse, error)
 }
 
 // NewProjectServiceClient constructs a client for the bff.v1.ProjectService service. By default, it
@@ -100,16 +116,44 @@ func NewProjectServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(projectServiceMethods.ByName("DeleteProject")),
 			connect.WithClientOptions(opts...),
 		),
+		listTrashedProjects: connect.NewClient[v1.ListTrashedProjectsRequest, v1.ListTrashedProjectsResponse](
+			httpClient,
+			baseURL+ProjectServiceListTrashedProjectsProcedure,
+			connect.WithSchema(projectServiceMethods.ByName("ListTrashedProjects")),
+			connect.WithClientOptions(opts...),
+		),
+		restoreProject: connect.NewClient[v1.RestoreProjectRequest, v1.RestoreProjectResponse](
+			httpClient,
+			baseURL+ProjectServiceRestoreProjectProcedure,
+			connect.WithSchema(projectServiceMethods.ByName("RestoreProject")),
+			connect.WithClientOptions(opts...),
+		),
+		getProjectSettings: connect.NewClient[v1.GetProjectSettingsRequest, v1.GetProjectSettingsResponse](
+			httpClient,
+			baseURL+ProjectServiceGetProjectSettingsProcedure,
+			connect.WithSchema(projectServiceMethods.ByName("GetProjectSettings")),
+			connect.WithClientOptions(opts...),
+		),
+		updateProjectSettings: connect.NewClient[v1.UpdateProjectSettingsRequest, v1.UpdateProjectSettingsResponse](
+			httpClient,
+			baseURL+ProjectServiceUpdateProjectSettingsProcedure,
+			connect.WithSchema(projectServiceMethods.ByName("UpdateProjectSettings")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // projectServiceClient implements ProjectServiceClient.
 type projectServiceClient struct {
-	listProjects  *connect.Client[v1.ListProjectsRequest, v1.ListProjectsResponse]
-	getProject    *connect.Client[v1.GetProjectRequest, v1.GetProjectResponse]
-	createProject *connect.Client[v1.CreateProjectRequest, v1.CreateProjectResponse]
-	updateProject *connect.Client[v1.UpdateProjectRequest, v1.UpdateProjectResponse]
-	deleteProject *connect.Client[v1.DeleteProjectRequest, v1.DeleteProjectResponse]
+	listProjects          *connect.Client[v1.ListProjectsRequest, v1.ListProjectsResponse]
+	getProject            *connect.Client[v1.GetProjectRequest, v1.GetProjectResponse]
+	createProject         *connect.Client[v1.CreateProjectRequest, v1.CreateProjectResponse]
+	updateProject         *connect.Client[v1.UpdateProjectRequest, v1.UpdateProjectResponse]
+	deleteProject         *connect.Client[v1.DeleteProjectRequest, v1.DeleteProjectResponse]
+	listTrashedProjects   *connect.Client[v1.ListTrashedProjectsRequest, v1.ListTrashedProjectsResponse]
+	restoreProject        *connect.Client[v1.RestoreProjectRequest, v1.RestoreProjectResponse]
+	getProjectSettings    *connect.Client[v1.GetProjectSettingsRequest, v1.GetProjectSettingsResponse]
+	updateProjectSettings *connect.Client[v1.UpdateProjectSettingsRequest, v1.UpdateProjectSettingsResponse]
 }
 
 // ListProjects calls bff.v1.ProjectService.ListProjects.
@@ -157,6 +201,42 @@ func (c *projectServiceClient) DeleteProject(ctx context.Context, req *v1.Delete
 	return nil, err
 }
 
+// ListTrashedProjects calls bff.v1.ProjectService.ListTrashedProjects.
+func (c *projectServiceClient) ListTrashedProjects(ctx context.Context, req *v1.ListTrashedProjectsRequest) (*v1.ListTrashedProjectsResponse, error) {
+	response, err := c.listTrashedProjects.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// RestoreProject calls bff.v1.ProjectService.RestoreProject.
+func (c *projectServiceClient) RestoreProject(ctx context.Context, req *v1.RestoreProjectRequest) (*v1.RestoreProjectResponse, error) {
+	response, err := c.restoreProject.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// GetProjectSettings calls bff.v1.ProjectService.GetProjectSettings.
+func (c *projectServiceClient) GetProjectSettings(ctx context.Context, req *v1.GetProjectSettingsRequest) (*v1.GetProjectSettingsResponse, error) {
+	response, err := c.getProjectSettings.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// UpdateProjectSettings calls bff.v1.ProjectService.UpdateProjectSettings.
+func (c *projectServiceClient) UpdateProjectSettings(ctx context.Context, req *v1.UpdateProjectSettingsRequest) (*v1.UpdateProjectSettingsResponse, error) {
+	response, err := c.updateProjectSettings.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
 // ProjectServiceHandler is an implementation of the bff.v1.ProjectService service.
 type ProjectServiceHandler interface {
 	ListProjects(context.Context, *v1.ListProjectsRequest) (*v1.ListProjectsResponse, error)
@@ -164,6 +244,10 @@ type ProjectServiceHandler interface {
 	CreateProject(context.Context, *v1.CreateProjectRequest) (*v1.CreateProjectResponse, error)
 	UpdateProject(context.Context, *v1.UpdateProjectRequest) (*v1.UpdateProjectResponse, error)
 	DeleteProject(context.Context, *v1.DeleteProjectRequest) (*v1.DeleteProjectResponse, error)
+	ListTrashedProjects(context.Context, *v1.ListTrashedProjectsRequest) (*v1.ListTrashedProjectsResponse, error)
+	RestoreProject(context.Context, *v1.RestoreProjectRequest) (*v1.RestoreProjectResponse, error)
+	GetProjectSettings(context.Context, *v1.GetProjectSettingsRequest) (*v1.GetProjectSettingsResponse, error)
+	UpdateProjectSettings(context.Context, *v1.UpdateProjectSettingsRequest) (*v1.UpdateProjectSettingsResponse, error)
 }
 
 // NewProjectServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -203,6 +287,30 @@ func NewProjectServiceHandler(svc ProjectServiceHandler, opts ...connect.Handler
 		connect.WithSchema(projectServiceMethods.ByName("DeleteProject")),
 		connect.WithHandlerOptions(opts...),
 	)
+	projectServiceListTrashedProjectsHandler := connect.NewUnaryHandlerSimple(
+		ProjectServiceListTrashedProjectsProcedure,
+		svc.ListTrashedProjects,
+		connect.WithSchema(projectServiceMethods.ByName("ListTrashedProjects")),
+		connect.WithHandlerOptions(opts...),
+	)
+	projectServiceRestoreProjectHandler := connect.NewUnaryHandlerSimple(
+		ProjectServiceRestoreProjectProcedure,
+		svc.RestoreProject,
+		connect.WithSchema(projectServiceMethods.ByName("RestoreProject")),
+		connect.WithHandlerOptions(opts...),
+	)
+	projectServiceGetProjectSettingsHandler := connect.NewUnaryHandlerSimple(
+		ProjectServiceGetProjectSettingsProcedure,
+		svc.GetProjectSettings,
+		connect.WithSchema(projectServiceMethods.ByName("GetProjectSettings")),
+		connect.WithHandlerOptions(opts...),
+	)
+	projectServiceUpdateProjectSettingsHandler := connect.NewUnaryHandlerSimple(
+		ProjectServiceUpdateProjectSettingsProcedure,
+		svc.UpdateProjectSettings,
+		connect.WithSchema(projectServiceMethods.ByName("UpdateProjectSettings")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/bff.v1.ProjectService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case ProjectServiceListProjectsProcedure:
@@ -215,6 +323,14 @@ func NewProjectServiceHandler(svc ProjectServiceHandler, opts ...connect.Handler
 			projectServiceUpdateProjectHandler.ServeHTTP(w, r)
 		case ProjectServiceDeleteProjectProcedure:
 			projectServiceDeleteProjectHandler.ServeHTTP(w, r)
+		case ProjectServiceListTrashedProjectsProcedure:
+			projectServiceListTrashedProjectsHandler.ServeHTTP(w, r)
+		case ProjectServiceRestoreProjectProcedure:
+			projectServiceRestoreProjectHandler.ServeHTTP(w, r)
+		case ProjectServiceGetProjectSettingsProcedure:
+			projectServiceGetProjectSettingsHandler.ServeHTTP(w, r)
+		case ProjectServiceUpdateProjectSettingsProcedure:
+			projectServiceUpdateProjectSettingsHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -243,3 +359,19 @@ func (UnimplementedProjectServiceHandler) UpdateProject(context.Context, *v1.Upd
 func (UnimplementedProjectServiceHandler) DeleteProject(context.Context, *v1.DeleteProjectRequest) (*v1.DeleteProjectResponse, error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bff.v1.ProjectService.DeleteProject is not implemented"))
 }
+
+func (UnimplementedProjectServiceHandler) ListTrashedProjects(context.Context, *v1.ListTrashedProjectsRequest) (*v1.ListTrashedProjectsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bff.v1.ProjectService.ListTrashedProjects is not implemented"))
+}
+
+func (UnimplementedProjectServiceHandler) RestoreProject(context.Context, *v1.RestoreProjectRequest) (*v1.RestoreProjectResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bff.v1.ProjectService.RestoreProject is not implemented"))
+}
+
+func (UnimplementedProjectServiceHandler) GetProjectSettings(context.Context, *v1.GetProjectSettingsRequest) (*v1.GetProjectSettingsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bff.v1.ProjectService.GetProjectSettings is not implemented"))
+}
+
+func (UnimplementedProjectServiceHandler) UpdateProjectSettings(context.Context, *v1.UpdateProjectSettingsRequest) (*v1.UpdateProjectSettingsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bff.v1.ProjectService.UpdateProjectSettings is not implemented"))
+}
@@ -39,8 +39,29 @@ const (
 	UserServiceGetUserProcedure = "/bff.v1.UserService/GetUser"
 	// UserServiceGetMeProcedure is the fully-qualified name of the UserService's GetMe RPC.
 	UserServiceGetMeProcedure = "/bff.v1.UserService/GetMe"
+	// UserServiceGetMyAccessProcedure is the fully-qualified name of the UserService's GetMyAccess RPC.
+	UserServiceGetMyAccessProcedure = "/bff.v1.UserService/GetMyAccess"
 	// UserServiceUpdateUserProcedure is the fully-qualified name of the UserService's UpdateUser RPC.
 	UserServiceUpdateUserProcedure = "/bff.v1.UserService/UpdateUser"
+	// UserServiceGetMyPreferencesProcedure is the fully-qualified name of the UserService's
+	// GetMyPreferences RPC.
+	UserServiceGetMyPreferencesProcedure = "/bff.v1.UserService/GetMyPreferences"
+	// UserServiceUpdateMyPreferencesProcedure is the fully-qualified name of the UserService's
+	// UpdateMyPreferences RPC.
+	UserServiceUpdateMyPreferencesProcedure = "/bff.v1.UserService/UpdateMyPreferences"
+	// UserServiceCreateUserProcedure is the fully-qualified name of the UserService's CreateUser RPC.
+	UserServiceCreateUserProcedure = "/bff.v1.UserService/CreateUser"
+	// UserServiceUpdateUserRoleProcedure is the fully-qualified name of the UserService's
+	// UpdateUserRole RPC.
+	UserServiceUpdateUserRoleProcedure = "/bff.v1.UserService/UpdateUserRole"
+	// UserServiceDeactivateUserProcedure is the fully-qualified name of the UserService's
+	// DeactivateUser RPC.
+	UserServiceDeactivateUserProcedure = "/bff.v1.UserService/DeactivateUser"
+	// UserServiceReactivateUserProcedure is the fully-qualified name of the UserService's
+	// ReactivateUser RPC.
+	UserServiceReactivateUserProcedure = "/bff.v1.UserService/ReactivateUser"
+	// UserServiceDeleteUserProcedure is the fully-qualified name of the UserService's DeleteUser RPC.
+	UserServiceDeleteUserProcedure = "/bff.v1.UserService/DeleteUser"
 )
 
 // UserServiceClient is a client for the bff.v1.UserService service.
@@ -48,7 +69,15 @@ type UserServiceClient interface {
 	ListUsers(context.Context, *v1.ListUsersRequest) (*v1.ListUsersResponse, error)
 	GetUser(context.Context, *v1.GetUserRequest) (*v1.GetUserResponse, error)
 	GetMe(context.Context, *v1.GetMeRequest) (*v1.GetMeResponse, error)
+	GetMyAccess(context.Context, *v1.GetMyAccessRequest) (*v1.GetMyAccessResponse, error)
 	UpdateUser(context.Context, *v1.UpdateUserRequest) (*v1.UpdateUserResponse, error)
+	GetMyPreferences(context.Context, *v1.GetMyPreferencesRequest) (*v1.GetMyPreferencesResponse, error)
+	UpdateMyPreferences(context.Context, *v1.UpdateMyPreferencesRequest) (*v1.UpdateMyPreferencesResponse, error)
+	CreateUser(context.Context, *v1.CreateUserRequest) (*v1.CreateUserResponse, error)
+	UpdateUserRole(context.Context, *v1.UpdateUserRoleRequest) (*v1.UpdateUserRoleResponse, error)
+	DeactivateUser(context.Context, *v1.DeactivateUserRequest) (*v1.DeactivateUserResponse, error)
+	ReactivateUser(context.Context, *v1.ReactivateUserRequest) (*v1.ReactivateUserResponse, error)
+	DeleteUser(context.Context, *v1.DeleteUserRequest) (*v1.DeleteUserResponse, error)
 }
 
 // NewUserServiceClient constructs a client for the bff.v1.UserService service. By default, it uses
@@ -80,21 +109,77 @@ func NewUserServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(userServiceMethods.ByName("GetMe")),
 			connect.WithClientOptions(opts...),
 		),
+		getMyAccess: connect.NewClient[v1.GetMyAccessRequest, v1.GetMyAccessResponse](
+			httpClient,
+			baseURL+UserServiceGetMyAccessProcedure,
+			connect.WithSchema(userServiceMethods.ByName("GetMyAccess")),
+			connect.WithClientOptions(opts...),
+		),
 		updateUser: connect.NewClient[v1.UpdateUserRequest, v1.UpdateUserResponse](
 			httpClient,
 			baseURL+UserServiceUpdateUserProcedure,
 			connect.WithSchema(userServiceMethods.ByName("UpdateUser")),
 			connect.WithClientOptions(opts...),
 		),
+		getMyPreferences: connect.NewClient[v1.GetMyPreferencesRequest, v1.GetMyPreferencesResponse](
+			httpClient,
+			baseURL+UserServiceGetMyPreferencesProcedure,
+			connect.WithSchema(userServiceMethods.ByName("GetMyPreferences")),
+			connect.WithClientOptions(opts...),
+		),
+		updateMyPreferences: connect.NewClient[v1.UpdateMyPreferencesRequest, v1.UpdateMyPreferencesResponse](
+			httpClient,
+			baseURL+UserServiceUpdateMyPreferencesProcedure,
+			connect.WithSchema(userServiceMethods.ByName("UpdateMyPreferences")),
+			connect.WithClientOptions(opts...),
+		),
+		createUser: connect.NewClient[v1.CreateUserRequest, v1.CreateUserResponse](
+			httpClient,
+			baseURL+UserServiceCreateUserProcedure,
+			connect.WithSchema(userServiceMethods.ByName("CreateUser")),
+			connect.WithClientOptions(opts...),
+		),
+		updateUserRole: connect.NewClient[v1.UpdateUserRoleRequest, v1.UpdateUserRoleResponse](
+			httpClient,
+			baseURL+UserServiceUpdateUserRoleProcedure,
+			connect.WithSchema(userServiceMethods.ByName("UpdateUserRole")),
+			connect.WithClientOptions(opts...),
+		),
+		deactivateUser: connect.NewClient[v1.DeactivateUserRequest, v1.DeactivateUserResponse](
+			httpClient,
+			baseURL+UserServiceDeactivateUserProcedure,
+			connect.WithSchema(userServiceMethods.ByName("DeactivateUser")),
+			connect.WithClientOptions(opts...),
+		),
+		reactivateUser: connect.NewClient[v1.ReactivateUserRequest, v1.ReactivateUserResponse](
+			httpClient,
+			baseURL+UserServiceReactivateUserProcedure,
+			connect.WithSchema(userServiceMethods.ByName("ReactivateUser")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteUser: connect.NewClient[v1.DeleteUserRequest, v1.DeleteUserResponse](
+			httpClient,
+			baseURL+UserServiceDeleteUserProcedure,
+			connect.WithSchema(userServiceMethods.ByName("DeleteUser")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // userServiceClient implements UserServiceClient.
 type userServiceClient struct {
-	listUsers  *connect.Client[v1.ListUsersRequest, v1.ListUsersResponse]
-	getUser    *connect.Client[v1.GetUserRequest, v1.GetUserResponse]
-	getMe      *connect.Client[v1.GetMeRequest, v1.GetMeResponse]
-	updateUser *connect.Client[v1.UpdateUserRequest, v1.UpdateUserResponse]
+	listUsers           *connect.Client[v1.ListUsersRequest, v1.ListUsersResponse]
+	getUser             *connect.Client[v1.GetUserRequest, v1.GetUserResponse]
+	getMe               *connect.Client[v1.GetMeRequest, v1.GetMeResponse]
+	getMyAccess         *connect.Client[v1.GetMyAccessRequest, v1.GetMyAccessResponse]
+	updateUser          *connect.Client[v1.UpdateUserRequest, v1.UpdateUserResponse]
+	getMyPreferences    *connect.Client[v1.GetMyPreferencesRequest, v1.GetMyPreferencesResponse]
+	updateMyPreferences *connect.Client[v1.UpdateMyPreferencesRequest, v1.UpdateMyPreferencesResponse]
+	createUser          *connect.Client[v1.CreateUserRequest, v1.CreateUserResponse]
+	updateUserRole      *connect.Client[v1.UpdateUserRoleRequest, v1.UpdateUserRoleResponse]
+	deactivateUser      *connect.Client[v1.DeactivateUserRequest, v1.DeactivateUserResponse]
+	reactivateUser      *connect.Client[v1.ReactivateUserRequest, v1.ReactivateUserResponse]
+	deleteUser          *connect.Client[v1.DeleteUserRequest, v1.DeleteUserResponse]
 }
 
 // ListUsers calls bff.v1.UserService.ListUsers.
@@ -124,6 +209,15 @@ func (c *userServiceClient) GetMe(ctx context.Context, req *v1.GetMeRequest) (*v
 	return nil, err
 }
 
+// GetMyAccess calls bff.v1.UserService.GetMyAccess.
+func (c *userServiceClient) GetMyAccess(ctx context.Context, req *v1.GetMyAccessRequest) (*v1.GetMyAccessResponse, error) {
+	response, err := c.getMyAccess.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
 // UpdateUser calls bff.v1.UserService.UpdateUser.
 func (c *userServiceClient) UpdateUser(ctx context.Context, req *v1.UpdateUserRequest) (*v1.UpdateUserResponse, error) {
 	response, err := c.updateUser.CallUnary(ctx, connect.NewRequest(req))
@@ -133,12 +227,83 @@ func (c *userServiceClient) UpdateUser(ctx context.Context, req *v1.UpdateUserRe
 	return nil, err
 }
 
+// GetMyPreferences calls bff.v1.UserService.GetMyPreferences.
+func (c *userServiceClient) GetMyPreferences(ctx context.Context, req *v1.GetMyPreferencesRequest) (*v1.GetMyPreferencesResponse, error) {
+	response, err := c.getMyPreferences.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// UpdateMyPreferences calls bff.v1.UserService.UpdateMyPreferences.
+func (c *userServiceClient) UpdateMyPreferences(ctx context.Context, req *v1.UpdateMyPreferencesRequest) (*v1.UpdateMyPreferencesResponse, error) {
+	response, err := c.updateMyPreferences.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// CreateUser calls bff.v1.UserService.CreateUser.
+func (c *userServiceClient) CreateUser(ctx context.Context, req *v1.CreateUserRequest) (*v1.CreateUserResponse, error) {
+	response, err := c.createUser.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// UpdateUserRole calls bff.v1.UserService.UpdateUserRole.
+func (c *userServiceClient) UpdateUserRole(ctx context.Context, req *v1.UpdateUserRoleRequest) (*v1.UpdateUserRoleResponse, error) {
+	response, err := c.updateUserRole.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// DeactivateUser calls bff.v1.UserService.DeactivateUser.
+func (c *userServiceClient) DeactivateUser(ctx context.Context, req *v1.DeactivateUserRequest) (*v1.DeactivateUserResponse, error) {
+	response, err := c.deactivateUser.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ReactivateUser calls bff.v1.UserService.ReactivateUser.
+func (c *userServiceClient) ReactivateUser(ctx context.Context, req *v1.ReactivateUserRequest) (*v1.ReactivateUserResponse, error) {
+	response, err := c.reactivateUser.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// DeleteUser calls bff.v1.UserService.DeleteUser.
+func (c *userServiceClient) DeleteUser(ctx context.Context, req *v1.DeleteUserRequest) (*v1.DeleteUserResponse, error) {
+	response, err := c.deleteUser.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
 // UserServiceHandler is an implementation of the bff.v1.UserService service.
 type UserServiceHandler interface {
 	ListUsers(context.Context, *v1.ListUsersRequest) (*v1.ListUsersResponse, error)
 	GetUser(context.Context, *v1.GetUserRequest) (*v1.GetUserResponse, error)
 	GetMe(context.Context, *v1.GetMeRequest) (*v1.GetMeResponse, error)
+	GetMyAccess(context.Context, *v1.GetMyAccessRequest) (*v1.GetMyAccessResponse, error)
 	UpdateUser(context.Context, *v1.UpdateUserRequest) (*v1.UpdateUserResponse, error)
+	GetMyPreferences(context.Context, *v1.GetMyPreferencesRequest) (*v1.GetMyPreferencesResponse, error)
+	UpdateMyPreferences(context.Context, *v1.UpdateMyPreferencesRequest) (*v1.UpdateMyPreferencesResponse, error)
+	CreateUser(context.Context, *v1.CreateUserRequest) (*v1.CreateUserResponse, error)
+	UpdateUserRole(context.Context, *v1.UpdateUserRoleRequest) (*v1.UpdateUserRoleResponse, error)
+	DeactivateUser(context.Context, *v1.DeactivateUserRequest) (*v1.DeactivateUserResponse, error)
+	ReactivateUser(context.Context, *v1.ReactivateUserRequest) (*v1.ReactivateUserResponse, error)
+	DeleteUser(context.Context, *v1.DeleteUserRequest) (*v1.DeleteUserResponse, error)
 }
 
 // NewUserServiceHandler builds an HTTP handler from the service implementation. It returns the path
@@ -166,12 +331,60 @@ func NewUserServiceHandler(svc UserServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(userServiceMethods.ByName("GetMe")),
 		connect.WithHandlerOptions(opts...),
 	)
+	userServiceGetMyAccessHandler := connect.NewUnaryHandlerSimple(
+		UserServiceGetMyAccessProcedure,
+		svc.GetMyAccess,
+		connect.WithSchema(userServiceMethods.ByName("GetMyAccess")),
+		connect.WithHandlerOptions(opts...),
+	)
 	userServiceUpdateUserHandler := connect.NewUnaryHandlerSimple(
 		UserServiceUpdateUserProcedure,
 		svc.UpdateUser,
 		connect.WithSchema(userServiceMethods.ByName("UpdateUser")),
 		connect.WithHandlerOptions(opts...),
 	)
+	userServiceGetMyPreferencesHandler := connect.NewUnaryHandlerSimple(
+		UserServiceGetMyPreferencesProcedure,
+		svc.GetMyPreferences,
+		connect.WithSchema(userServiceMethods.ByName("GetMyPreferences")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceUpdateMyPreferencesHandler := connect.NewUnaryHandlerSimple(
+		UserServiceUpdateMyPreferencesProcedure,
+		svc.UpdateMyPreferences,
+		connect.WithSchema(userServiceMethods.ByName("UpdateMyPreferences")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceCreateUserHandler := connect.NewUnaryHandlerSimple(
+		UserServiceCreateUserProcedure,
+		svc.CreateUser,
+		connect.WithSchema(userServiceMethods.ByName("CreateUser")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceUpdateUserRoleHandler := connect.NewUnaryHandlerSimple(
+		UserServiceUpdateUserRoleProcedure,
+		svc.UpdateUserRole,
+		connect.WithSchema(userServiceMethods.ByName("UpdateUserRole")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceDeactivateUserHandler := connect.NewUnaryHandlerSimple(
+		UserServiceDeactivateUserProcedure,
+		svc.DeactivateUser,
+		connect.WithSchema(userServiceMethods.ByName("DeactivateUser")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceReactivateUserHandler := connect.NewUnaryHandlerSimple(
+		UserServiceReactivateUserProcedure,
+		svc.ReactivateUser,
+		connect.WithSchema(userServiceMethods.ByName("ReactivateUser")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceDeleteUserHandler := connect.NewUnaryHandlerSimple(
+		UserServiceDeleteUserProcedure,
+		svc.DeleteUser,
+		connect.WithSchema(userServiceMethods.ByName("DeleteUser")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/bff.v1.UserService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case UserServiceListUsersProcedure:
@@ -180,8 +393,24 @@ func NewUserServiceHandler(svc UserServiceHandler, opts ...connect.HandlerOption
 			userServiceGetUserHandler.ServeHTTP(w, r)
 		case UserServiceGetMeProcedure:
 			userServiceGetMeHandler.ServeHTTP(w, r)
+		case UserServiceGetMyAccessProcedure:
+			userServiceGetMyAccessHandler.ServeHTTP(w, r)
 		case UserServiceUpdateUserProcedure:
 			userServiceUpdateUserHandler.ServeHTTP(w, r)
+		case UserServiceGetMyPreferencesProcedure:
+			userServiceGetMyPreferencesHandler.ServeHTTP(w, r)
+		case UserServiceUpdateMyPreferencesProcedure:
+			userServiceUpdateMyPreferencesHandler.ServeHTTP(w, r)
+		case UserServiceCreateUserProcedure:
+			userServiceCreateUserHandler.ServeHTTP(w, r)
+		case UserServiceUpdateUserRoleProcedure:
+			userServiceUpdateUserRoleHandler.ServeHTTP(w, r)
+		case UserServiceDeactivateUserProcedure:
+			userServiceDeactivateUserHandler.ServeHTTP(w, r)
+		case UserServiceReactivateUserProcedure:
+			userServiceReactivateUserHandler.ServeHTTP(w, r)
+		case UserServiceDeleteUserProcedure:
+			userServiceDeleteUserHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -203,6 +432,38 @@ func (UnimplementedUserServiceHandler) GetMe(context.Context, *v1.GetMeRequest)
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bff.v1.UserService.GetMe is not implemented"))
 }
 
+func (UnimplementedUserServiceHandler) GetMyAccess(context.Context, *v1.GetMyAccessRequest) (*v1.GetMyAccessResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bff.v1.UserService.GetMyAccess is not implemented"))
+}
+
 func (UnimplementedUserServiceHandler) UpdateUser(context.Context, *v1.UpdateUserRequest) (*v1.UpdateUserResponse, error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bff.v1.UserService.UpdateUser is not implemented"))
 }
+
+func (UnimplementedUserServiceHandler) GetMyPreferences(context.Context, *v1.GetMyPreferencesRequest) (*v1.GetMyPreferencesResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bff.v1.UserService.GetMyPreferences is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) UpdateMyPreferences(context.Context, *v1.UpdateMyPreferencesRequest) (*v1.UpdateMyPreferencesResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bff.v1.UserService.UpdateMyPreferences is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) CreateUser(context.Context, *v1.CreateUserRequest) (*v1.CreateUserResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bff.v1.UserService.CreateUser is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) UpdateUserRole(context.Context, *v1.UpdateUserRoleRequest) (*v1.UpdateUserRoleResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bff.v1.UserService.UpdateUserRole is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) DeactivateUser(context.Context, *v1.DeactivateUserRequest) (*v1.DeactivateUserResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bff.v1.UserService.DeactivateUser is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) ReactivateUser(context.Context, *v1.ReactivateUserRequest) (*v1.ReactivateUserResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bff.v1.UserService.ReactivateUser is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) DeleteUser(context.Context, *v1.DeleteUserRequest) (*v1.DeleteUserResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bff.v1.UserService.DeleteUser is not implemented"))
+}
@@ -0,0 +1,817 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: bff/v1/invitations.proto
+
+package gen_bff_v1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type InvitationStatus int32
+
+const (
+	InvitationStatus_INVITATION_STATUS_UNSPECIFIED InvitationStatus = 0
+	InvitationStatus_INVITATION_STATUS_PENDING     InvitationStatus = 1
+	InvitationStatus_INVITATION_STATUS_ACCEPTED    InvitationStatus = 2
+	InvitationStatus_INVITATION_STATUS_DECLINED    InvitationStatus = 3
+	InvitationStatus_INVITATION_STATUS_REVOKED     InvitationStatus = 4
+)
+
+// Enum value maps for InvitationStatus.
+var (
+	InvitationStatus_name = map[int32]string{
+		0: "INVITATION_STATUS_UNSPECIFIED",
+		1: "INVITATION_STATUS_PENDING",
+		2: "INVITATION_STATUS_ACCEPTED",
+		3: "INVITATION_STATUS_DECLINED",
+		4: "INVITATION_STATUS_REVOKED",
+	}
+	InvitationStatus_value = map[string]int32{
+		"INVITATION_STATUS_UNSPECIFIED": 0,
+		"INVITATION_STATUS_PENDING":     1,
+		"INVITATION_STATUS_ACCEPTED":    2,
+		"INVITATION_STATUS_DECLINED":    3,
+		"INVITATION_STATUS_REVOKED":     4,
+	}
+)
+
+func (x InvitationStatus) Enum() *InvitationStatus {
+	p := new(InvitationStatus)
+	*p = x
+	return p
+}
+
+func (x InvitationStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (InvitationStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_bff_v1_invitations_proto_enumTypes[0].Descriptor()
+}
+
+func (InvitationStatus) Type() protoreflect.EnumType {
+	return &file_bff_v1_invitations_proto_enumTypes[0]
+}
+
+func (x InvitationStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use InvitationStatus.Descriptor instead.
+func (InvitationStatus) EnumDescriptor() ([]byte, []int) {
+	return file_bff_v1_invitations_proto_rawDescGZIP(), []int{0}
+}
+
+// Invitation offers a project role to an email address. The invitee receives
+// a signed link and answers it after signing in with that address.
+type Invitation struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProjectId string                 `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Email     string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	Role      UserRole               `protobuf:"varint,4,opt,name=role,proto3,enum=bff.v1.UserRole" json:"role,omitempty"`
+	Status    InvitationStatus       `protobuf:"varint,5,opt,name=status,proto3,enum=bff.v1.InvitationStatus" json:"status,omitempty"`
+	// invited_by is empty once the inviting user has been deleted.
+	InvitedBy     string                 `protobuf:"bytes,6,opt,name=invited_by,json=invitedBy,proto3" json:"invited_by,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	RespondedAt   *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=responded_at,json=respondedAt,proto3" json:"responded_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Invitation) Reset() {
+	*x = Invitation{}
+	mi := &file_bff_v1_invitations_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Invitation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Invitation) ProtoMessage() {}
+
+func (x *Invitation) ProtoReflect() protoreflect.Message {
+	mi := &file_bff_v1_invitations_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Invitation.ProtoReflect.Descriptor instead.
+func (*Invitation) Descriptor() ([]byte, []int) {
+	return file_bff_v1_invitations_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Invitation) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Invitation) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *Invitation) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *Invitation) GetRole() UserRole {
+	if x != nil {
+		return x.Role
+	}
+	return UserRole_USER_ROLE_UNSPECIFIED
+}
+
+func (x *Invitation) GetStatus() InvitationStatus {
+	if x != nil {
+		return x.Status
+	}
+	return InvitationStatus_INVITATION_STATUS_UNSPECIFIED
+}
+
+func (x *Invitation) GetInvitedBy() string {
+	if x != nil {
+		return x.InvitedBy
+	}
+	return ""
+}
+
+func (x *Invitation) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *Invitation) GetRespondedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RespondedAt
+	}
+	return nil
+}
+
+func (x *Invitation) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// InviteToProject emails an invitation. Inviting an address again replaces its
+// pending invitation, so it doubles as "resend".
+type InviteToProjectRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Email     string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	// role defaults to USER_ROLE_MEMBER.
+	Role          UserRole `protobuf:"varint,3,opt,name=role,proto3,enum=bff.v1.UserRole" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InviteToProjectRequest) Reset() {
+	*x = InviteToProjectRequest{}
+	mi := &file_bff_v1_invitations_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InviteToProjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InviteToProjectRequest) ProtoMessage() {}
+
+func (x *InviteToProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bff_v1_invitations_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InviteToProjectRequest.ProtoReflect.Descriptor instead.
+func (*InviteToProjectRequest) Descriptor() ([]byte, []int) {
+	return file_bff_v1_invitations_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *InviteToProjectRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *InviteToProjectRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *InviteToProjectRequest) GetRole() UserRole {
+	if x != nil {
+		return x.Role
+	}
+	return UserRole_USER_ROLE_UNSPECIFIED
+}
+
+type InviteToProjectResponse struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Invitation *Invitation            `protobuf:"bytes,1,opt,name=invitation,proto3" json:"invitation,omitempty"`
+	// email_sent is false when the mail server could not be reached. The
+	// invitation stands; invite again to resend it.
+	EmailSent     bool `protobuf:"varint,2,opt,name=email_sent,json=emailSent,proto3" json:"email_sent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InviteToProjectResponse) Reset() {
+	*x = InviteToProjectResponse{}
+	mi := &file_bff_v1_invitations_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InviteToProjectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InviteToProjectResponse) ProtoMessage() {}
+
+func (x *InviteToProjectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bff_v1_invitations_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InviteToProjectResponse.ProtoReflect.Descriptor instead.
+func (*InviteToProjectResponse) Descriptor() ([]byte, []int) {
+	return file_bff_v1_invitations_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *InviteToProjectResponse) GetInvitation() *Invitation {
+	if x != nil {
+		return x.Invitation
+	}
+	return nil
+}
+
+func (x *InviteToProjectResponse) GetEmailSent() bool {
+	if x != nil {
+		return x.EmailSent
+	}
+	return false
+}
+
+// ListInvitations returns the project's pending invitations, newest first,
+// including ones that have expired without an answer.
+type ListInvitationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInvitationsRequest) Reset() {
+	*x = ListInvitationsRequest{}
+	mi := &file_bff_v1_invitations_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInvitationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInvitationsRequest) ProtoMessage() {}
+
+func (x *ListInvitationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bff_v1_invitations_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInvitationsRequest.ProtoReflect.Descriptor instead.
+func (*ListInvitationsRequest) Descriptor() ([]byte, []int) {
+	return file_bff_v1_invitations_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListInvitationsRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+type ListInvitationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invitations   []*Invitation          `protobuf:"bytes,1,rep,name=invitations,proto3" json:"invitations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInvitationsResponse) Reset() {
+	*x = ListInvitationsResponse{}
+	mi := &file_bff_v1_invitations_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInvitationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInvitationsResponse) ProtoMessage() {}
+
+func (x *ListInvitationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bff_v1_invitations_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInvitationsResponse.ProtoReflect.Descriptor instead.
+func (*ListInvitationsResponse) Descriptor() ([]byte, []int) {
+	return file_bff_v1_invitations_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListInvitationsResponse) GetInvitations() []*Invitation {
+	if x != nil {
+		return x.Invitations
+	}
+	return nil
+}
+
+type RevokeInvitationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeInvitationRequest) Reset() {
+	*x = RevokeInvitationRequest{}
+	mi := &file_bff_v1_invitations_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeInvitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeInvitationRequest) ProtoMessage() {}
+
+func (x *RevokeInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bff_v1_invitations_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeInvitationRequest.ProtoReflect.Descriptor instead.
+func (*RevokeInvitationRequest) Descriptor() ([]byte, []int) {
+	return file_bff_v1_invitations_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RevokeInvitationRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *RevokeInvitationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RevokeInvitationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invitation    *Invitation            `protobuf:"bytes,1,opt,name=invitation,proto3" json:"invitation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeInvitationResponse) Reset() {
+	*x = RevokeInvitationResponse{}
+	mi := &file_bff_v1_invitations_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeInvitationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeInvitationResponse) ProtoMessage() {}
+
+func (x *RevokeInvitationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bff_v1_invitations_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeInvitationResponse.ProtoReflect.Descriptor instead.
+func (*RevokeInvitationResponse) Descriptor() ([]byte, []int) {
+	return file_bff_v1_invitations_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *RevokeInvitationResponse) GetInvitation() *Invitation {
+	if x != nil {
+		return x.Invitation
+	}
+	return nil
+}
+
+// token is the one from the invitation link. The caller must be signed in with
+// the invited email address.
+type AcceptInvitationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptInvitationRequest) Reset() {
+	*x = AcceptInvitationRequest{}
+	mi := &file_bff_v1_invitations_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptInvitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptInvitationRequest) ProtoMessage() {}
+
+func (x *AcceptInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bff_v1_invitations_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptInvitationRequest.ProtoReflect.Descriptor instead.
+func (*AcceptInvitationRequest) Descriptor() ([]byte, []int) {
+	return file_bff_v1_invitations_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *AcceptInvitationRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type AcceptInvitationResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// member is the caller's membership. Accepting does not lower the role of
+	// someone who is already a member.
+	Member        *ProjectMember `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptInvitationResponse) Reset() {
+	*x = AcceptInvitationResponse{}
+	mi := &file_bff_v1_invitations_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptInvitationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptInvitationResponse) ProtoMessage() {}
+
+func (x *AcceptInvitationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bff_v1_invitations_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptInvitationResponse.ProtoReflect.Descriptor instead.
+func (*AcceptInvitationResponse) Descriptor() ([]byte, []int) {
+	return file_bff_v1_invitations_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *AcceptInvitationResponse) GetMember() *ProjectMember {
+	if x != nil {
+		return x.Member
+	}
+	return nil
+}
+
+type DeclineInvitationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeclineInvitationRequest) Reset() {
+	*x = DeclineInvitationRequest{}
+	mi := &file_bff_v1_invitations_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeclineInvitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeclineInvitationRequest) ProtoMessage() {}
+
+func (x *DeclineInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bff_v1_invitations_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeclineInvitationRequest.ProtoReflect.Descriptor instead.
+func (*DeclineInvitationRequest) Descriptor() ([]byte, []int) {
+	return file_bff_v1_invitations_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DeclineInvitationRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type DeclineInvitationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeclineInvitationResponse) Reset() {
+	*x = DeclineInvitationResponse{}
+	mi := &file_bff_v1_invitations_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeclineInvitationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeclineInvitationResponse) ProtoMessage() {}
+
+func (x *DeclineInvitationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bff_v1_invitations_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeclineInvitationResponse.ProtoReflect.Descriptor instead.
+func (*DeclineInvitationResponse) Descriptor() ([]byte, []int) {
+	return file_bff_v1_invitations_proto_rawDescGZIP(), []int{10}
+}
+
+var File_bff_v1_invitations_proto protoreflect.FileDescriptor
+
+const file_bff_v1_invitations_proto_rawDesc = "" +
+	"\n" +
+	"\x18bff/v1/invitations.proto\x12\x06bff.v1\x1a\x1cbff/v1/project_members.proto\x1a\x12bff/v1/users.proto\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xfd\x02\n" +
+	"\n" +
+	"Invitation\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x02 \x01(\tR\tprojectId\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12$\n" +
+	"\x04role\x18\x04 \x01(\x0e2\x10.bff.v1.UserRoleR\x04role\x120\n" +
+	"\x06status\x18\x05 \x01(\x0e2\x18.bff.v1.InvitationStatusR\x06status\x12\x1d\n" +
+	"\n" +
+	"invited_by\x18\x06 \x01(\tR\tinvitedBy\x129\n" +
+	"\n" +
+	"expires_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12=\n" +
+	"\fresponded_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\vrespondedAt\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x90\x01\n" +
+	"\x16InviteToProjectRequest\x12'\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\tprojectId\x12\x1d\n" +
+	"\x05email\x18\x02 \x01(\tB\a\xbaH\x04r\x02`\x01R\x05email\x12.\n" +
+	"\x04role\x18\x03 \x01(\x0e2\x10.bff.v1.UserRoleB\b\xbaH\x05\x82\x01\x02\x10\x01R\x04role\"l\n" +
+	"\x17InviteToProjectResponse\x122\n" +
+	"\n" +
+	"invitation\x18\x01 \x01(\v2\x12.bff.v1.InvitationR\n" +
+	"invitation\x12\x1d\n" +
+	"\n" +
+	"email_sent\x18\x02 \x01(\bR\temailSent\"A\n" +
+	"\x16ListInvitationsRequest\x12'\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\tprojectId\"O\n" +
+	"\x17ListInvitationsResponse\x124\n" +
+	"\vinvitations\x18\x01 \x03(\v2\x12.bff.v1.InvitationR\vinvitations\"\\\n" +
+	"\x17RevokeInvitationRequest\x12'\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\tprojectId\x12\x18\n" +
+	"\x02id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"N\n" +
+	"\x18RevokeInvitationResponse\x122\n" +
+	"\n" +
+	"invitation\x18\x01 \x01(\v2\x12.bff.v1.InvitationR\n" +
+	"invitation\";\n" +
+	"\x17AcceptInvitationRequest\x12 \n" +
+	"\x05token\x18\x01 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\x80\x02R\x05token\"I\n" +
+	"\x18AcceptInvitationResponse\x12-\n" +
+	"\x06member\x18\x01 \x01(\v2\x15.bff.v1.ProjectMemberR\x06member\"<\n" +
+	"\x18DeclineInvitationRequest\x12 \n" +
+	"\x05token\x18\x01 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\x80\x02R\x05token\"\x1b\n" +
+	"\x19DeclineInvitationResponse*\xb3\x01\n" +
+	"\x10InvitationStatus\x12!\n" +
+	"\x1dINVITATION_STATUS_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19INVITATION_STATUS_PENDING\x10\x01\x12\x1e\n" +
+	"\x1aINVITATION_STATUS_ACCEPTED\x10\x02\x12\x1e\n" +
+	"\x1aINVITATION_STATUS_DECLINED\x10\x03\x12\x1d\n" +
+	"\x19INVITATION_STATUS_REVOKED\x10\x042\xc3\x03\n" +
+	"\x11InvitationService\x12R\n" +
+	"\x0fInviteToProject\x12\x1e.bff.v1.InviteToProjectRequest\x1a\x1f.bff.v1.InviteToProjectResponse\x12R\n" +
+	"\x0fListInvitations\x12\x1e.bff.v1.ListInvitationsRequest\x1a\x1f.bff.v1.ListInvitationsResponse\x12U\n" +
+	"\x10RevokeInvitation\x12\x1f.bff.v1.RevokeInvitationRequest\x1a .bff.v1.RevokeInvitationResponse\x12U\n" +
+	"\x10AcceptInvitation\x12\x1f.bff.v1.AcceptInvitationRequest\x1a .bff.v1.AcceptInvitationResponse\x12X\n" +
+	"\x11DeclineInvitation\x12 .bff.v1.DeclineInvitationRequest\x1a!.bff.v1.DeclineInvitationResponseBBZ@github.com/ApeironFoundation/axle/contracts/go/bff/v1;gen_bff_v1b\x06proto3"
+
+var (
+	file_bff_v1_invitations_proto_rawDescOnce sync.Once
+	file_bff_v1_invitations_proto_rawDescData []byte
+)
+
+func file_bff_v1_invitations_proto_rawDescGZIP() []byte {
+	file_bff_v1_invitations_proto_rawDescOnce.Do(func() {
+		file_bff_v1_invitations_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_bff_v1_invitations_proto_rawDesc), len(file_bff_v1_invitations_proto_rawDesc)))
+	})
+	return file_bff_v1_invitations_proto_rawDescData
+}
+
+var file_bff_v1_invitations_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_bff_v1_invitations_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_bff_v1_invitations_proto_goTypes = []any{
+	(InvitationStatus)(0),             // 0: bff.v1.InvitationStatus
+	(*Invitation)(nil),                // 1: bff.v1.Invitation
+	(*InviteToProjectRequest)(nil),    // 2: bff.v1.InviteToProjectRequest
+	(*InviteToProjectResponse)(nil),   // 3: bff.v1.InviteToProjectResponse
+	(*ListInvitationsRequest)(nil),    // 4: bff.v1.ListInvitationsRequest
+	(*ListInvitationsResponse)(nil),   // 5: bff.v1.ListInvitationsResponse
+	(*RevokeInvitationRequest)(nil),   // 6: bff.v1.RevokeInvitationRequest
+	(*RevokeInvitationResponse)(nil),  // 7: bff.v1.RevokeInvitationResponse
+	(*AcceptInvitationRequest)(nil),   // 8: bff.v1.AcceptInvitationRequest
+	(*AcceptInvitationResponse)(nil),  // 9: bff.v1.AcceptInvitationResponse
+	(*DeclineInvitationRequest)(nil),  // 10: bff.v1.DeclineInvitationRequest
+	(*DeclineInvitationResponse)(nil), // 11: bff.v1.DeclineInvitationResponse
+	(UserRole)(0),                     // 12: bff.v1.UserRole
+	(*timestamppb.Timestamp)(nil),     // 13: google.protobuf.Timestamp
+	(*ProjectMember)(nil),             // 14: bff.v1.ProjectMember
+}
+var file_bff_v1_invitations_proto_depIdxs = []int32{
+	12, // 0: bff.v1.Invitation.role:type_name -> bff.v1.UserRole
+	0,  // 1: bff.v1.Invitation.status:type_name -> bff.v1.InvitationStatus
+	13, // 2: bff.v1.Invitation.expires_at:type_name -> google.protobuf.Timestamp
+	13, // 3: bff.v1.Invitation.responded_at:type_name -> google.protobuf.Timestamp
+	13, // 4: bff.v1.Invitation.created_at:type_name -> google.protobuf.Timestamp
+	12, // 5: bff.v1.InviteToProjectRequest.role:type_name -> bff.v1.UserRole
+	1,  // 6: bff.v1.InviteToProjectResponse.invitation:type_name -> bff.v1.Invitation
+	1,  // 7: bff.v1.ListInvitationsResponse.invitations:type_name -> bff.v1.Invitation
+	1,  // 8: bff.v1.RevokeInvitationResponse.invitation:type_name -> bff.v1.Invitation
+	14, // 9: bff.v1.AcceptInvitationResponse.member:type_name -> bff.v1.ProjectMember
+	2,  // 10: bff.v1.InvitationService.InviteToProject:input_type -> bff.v1.InviteToProjectRequest
+	4,  // 11: bff.v1.InvitationService.ListInvitations:input_type -> bff.v1.ListInvitationsRequest
+	6,  // 12: bff.v1.InvitationService.RevokeInvitation:input_type -> bff.v1.RevokeInvitationRequest
+	8,  // 13: bff.v1.InvitationService.AcceptInvitation:input_type -> bff.v1.AcceptInvitationRequest
+	10, // 14: bff.v1.InvitationService.DeclineInvitation:input_type -> bff.v1.DeclineInvitationRequest
+	3,  // 15: bff.v1.InvitationService.InviteToProject:output_type -> bff.v1.InviteToProjectResponse
+	5,  // 16: bff.v1.InvitationService.ListInvitations:output_type -> bff.v1.ListInvitationsResponse
+	7,  // 17: bff.v1.InvitationService.RevokeInvitation:output_type -> bff.v1.RevokeInvitationResponse
+	9,  // 18: bff.v1.InvitationService.AcceptInvitation:output_type -> bff.v1.AcceptInvitationResponse
+	11, // 19: bff.v1.InvitationService.DeclineInvitation:output_type -> bff.v1.DeclineInvitationResponse
+	15, // [15:20] is the sub-list for method output_type
+	10, // [10:15] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
+}
+
+func init() { file_bff_v1_invitations_proto_init() }
+func file_bff_v1_invitations_proto_init() {
+	if File_bff_v1_invitations_proto != nil {
+		return
+	}
+	file_bff_v1_project_members_proto_init()
+	file_bff_v1_users_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_bff_v1_invitations_proto_rawDesc), len(file_bff_v1_invitations_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_bff_v1_invitations_proto_goTypes,
+		DependencyIndexes: file_bff_v1_invitations_proto_depIdxs,
+		EnumInfos:         file_bff_v1_invitations_proto_enumTypes,
+		MessageInfos:      file_bff_v1_invitations_proto_msgTypes,
+	}.Build()
+	File_bff_v1_invitations_proto = out.File
+	file_bff_v1_invitations_proto_goTypes = nil
+	file_bff_v1_invitations_proto_depIdxs = nil
+}
@@ -7,8 +7,12 @@
 package gen_bff_v1
 
 import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	structpb "google.golang.org/protobuf/types/known/structpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -73,13 +77,18 @@ func (ProjectStatus) EnumDescriptor() ([]byte, []int) {
 }
 
 type Project struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Status        ProjectStatus          `protobuf:"varint,4,opt,name=status,proto3,enum=bff.v1.ProjectStatus" json:"status,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Status      ProjectStatus          `protobuf:"varint,4,opt,name=status,proto3,enum=bff.v1.ProjectStatus" json:"status,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// etag changes on every write; pass it back as expected_etag to detect
+	// concurrent edits.
+	Etag string `protobuf:"bytes,7,opt,name=etag,proto3" json:"etag,omitempty"`
+	// deleted_at is set while the project is in the trash.
+	DeletedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -156,10 +165,41 @@ func (x *Project) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Project) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+func (x *Project) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+// Pagination is keyset-based: pass the previous next_page_token as page_token.
+// The 1-based page number is still honoured for older clients when page_token is empty.
 type ListProjectsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Deprecated: Marked as deprecated in bff/v1/projects.proto.
+	Page int32 `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	// page_size is clamped to 100; zero selects the default of 20.
+	PageSize  int32  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// skip_total omits the total count, which saves a COUNT(*) per page.
+	SkipTotal bool `protobuf:"varint,4,opt,name=skip_total,json=skipTotal,proto3" json:"skip_total,omitempty"`
+	// Filters are ANDed together; zero values are ignored.
+	Status ProjectStatus `protobuf:"varint,5,opt,name=status,proto3,enum=bff.v1.ProjectStatus" json:"status,omitempty"`
+	// name_contains matches a case-insensitive substring of the project name.
+	NameContains string `protobuf:"bytes,6,opt,name=name_contains,json=nameContains,proto3" json:"name_contains,omitempty"`
+	// member_user_id limits results to projects the user belongs to ("my projects").
+	MemberUserId string                 `protobuf:"bytes,7,opt,name=member_user_id,json=memberUserId,proto3" json:"member_user_id,omitempty"`
+	UpdatedSince *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_since,json=updatedSince,proto3" json:"updated_since,omitempty"`
+	// query runs a full-text search over name and description. Results are then
+	// ordered by relevance, and page tokens are only valid for the same query.
+	Query         string `protobuf:"bytes,9,opt,name=query,proto3" json:"query,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -194,6 +234,7 @@ func (*ListProjectsRequest) Descriptor() ([]byte, []int) {
 	return file_bff_v1_projects_proto_rawDescGZIP(), []int{1}
 }
 
+// Deprecated: Marked as deprecated in bff/v1/projects.proto.
 func (x *ListProjectsRequest) GetPage() int32 {
 	if x != nil {
 		return x.Page
@@ -208,17 +249,133 @@ func (x *ListProjectsRequest) GetPageSize() int32 {
 	return 0
 }
 
-type ListProjectsResponse struct {
+func (x *ListProjectsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListProjectsRequest) GetSkipTotal() bool {
+	if x != nil {
+		return x.SkipTotal
+	}
+	return false
+}
+
+func (x *ListProjectsRequest) GetStatus() ProjectStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ProjectStatus_PROJECT_STATUS_UNSPECIFIED
+}
+
+func (x *ListProjectsRequest) GetNameContains() string {
+	if x != nil {
+		return x.NameContains
+	}
+	return ""
+}
+
+func (x *ListProjectsRequest) GetMemberUserId() string {
+	if x != nil {
+		return x.MemberUserId
+	}
+	return ""
+}
+
+func (x *ListProjectsRequest) GetUpdatedSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedSince
+	}
+	return nil
+}
+
+func (x *ListProjectsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+// ProjectHighlight marks where a search query matched a project.
+// Matches are wrapped in <mark></mark>; the rest of the text is HTML-escaped.
+type ProjectHighlight struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Projects      []*Project             `protobuf:"bytes,1,rep,name=projects,proto3" json:"projects,omitempty"`
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Rank          float32                `protobuf:"fixed32,3,opt,name=rank,proto3" json:"rank,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProjectHighlight) Reset() {
+	*x = ProjectHighlight{}
+	mi := &file_bff_v1_projects_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProjectHighlight) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectHighlight) ProtoMessage() {}
+
+func (x *ProjectHighlight) ProtoReflect() protoreflect.Message {
+	mi := &file_bff_v1_projects_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectHighlight.ProtoReflect.Descriptor instead.
+func (*ProjectHighlight) Descriptor() ([]byte, []int) {
+	return file_bff_v1_projects_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ProjectHighlight) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ProjectHighlight) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ProjectHighlight) GetRank() float32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+type ListProjectsResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Projects []*Project             `protobuf:"bytes,1,rep,name=projects,proto3" json:"projects,omitempty"`
+	// total is 0 when skip_total was requested.
+	Total int32 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	// next_page_token is empty on the last page.
+	NextPageToken string `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// highlights is keyed by project id and only set when query was given.
+	Highlights    map[string]*ProjectHighlight `protobuf:"bytes,4,rep,name=highlights,proto3" json:"highlights,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListProjectsResponse) Reset() {
 	*x = ListProjectsResponse{}
-	mi := &file_bff_v1_projects_proto_msgTypes[2]
+	mi := &file_bff_v1_projects_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -230,7 +387,7 @@ func (x *ListProjectsResponse) String() string {
 func (*ListProjectsResponse) ProtoMessage() {}
 
 func (x *ListProjectsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_bff_v1_projects_proto_msgTypes[2]
+	mi := &file_bff_v1_projects_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -243,7 +400,7 @@ func (x *ListProjectsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListProjectsResponse.ProtoReflect.Descriptor instead.
 func (*ListProjectsResponse) Descriptor() ([]byte, []int) {
-	return file_bff_v1_projects_proto_rawDescGZIP(), []int{2}
+	return file_bff_v1_projects_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *ListProjectsResponse) GetProjects() []*Project {
@@ -260,6 +417,20 @@ func (x *ListProjectsResponse) GetTotal() int32 {
 	return 0
 }
 
+func (x *ListProjectsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListProjectsResponse) GetHighlights() map[string]*ProjectHighlight {
+	if x != nil {
+		return x.Highlights
+	}
+	return nil
+}
+
 type GetProjectRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -269,7 +440,7 @@ type GetProjectRequest struct {
 
 func (x *GetProjectRequest) Reset() {
 	*x = GetProjectRequest{}
-	mi := &file_bff_v1_projects_proto_msgTypes[3]
+	mi := &file_bff_v1_projects_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -281,7 +452,7 @@ func (x *GetProjectRequest) String() string {
 func (*GetProjectRequest) ProtoMessage() {}
 
 func (x *GetProjectRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_bff_v1_projects_proto_msgTypes[3]
+	mi := &file_bff_v1_projects_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -294,7 +465,7 @@ func (x *GetProjectRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetProjectRequest.ProtoReflect.Descriptor instead.
 func (*GetProjectRequest) Descriptor() ([]byte, []int) {
-	return file_bff_v1_projects_proto_rawDescGZIP(), []int{3}
+	return file_bff_v1_projects_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *GetProjectRequest) GetId() string {
@@ -313,7 +484,7 @@ type GetProjectResponse struct {
 
 func (x *GetProjectResponse) Reset() {
 	*x = GetProjectResponse{}
-	mi := &file_bff_v1_projects_proto_msgTypes[4]
+	mi := &file_bff_v1_projects_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -325,7 +496,7 @@ func (x *GetProjectResponse) String() string {
 func (*GetProjectResponse) ProtoMessage() {}
 
 func (x *GetProjectResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_bff_v1_projects_proto_msgTypes[4]
+	mi := &file_bff_v1_projects_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -338,7 +509,7 @@ func (x *GetProjectResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetProjectResponse.ProtoReflect.Descriptor instead.
 func (*GetProjectResponse) Descriptor() ([]byte, []int) {
-	return file_bff_v1_projects_proto_rawDescGZIP(), []int{4}
+	return file_bff_v1_projects_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *GetProjectResponse) GetProject() *Project {
@@ -349,16 +520,17 @@ func (x *GetProjectResponse) GetProject() *Project {
 }
 
 type CreateProjectRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name must contain at least one non-space character.
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreateProjectRequest) Reset() {
 	*x = CreateProjectRequest{}
-	mi := &file_bff_v1_projects_proto_msgTypes[5]
+	mi := &file_bff_v1_projects_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -370,7 +542,7 @@ func (x *CreateProjectRequest) String() string {
 func (*CreateProjectRequest) ProtoMessage() {}
 
 func (x *CreateProjectRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_bff_v1_projects_proto_msgTypes[5]
+	mi := &file_bff_v1_projects_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -383,7 +555,7 @@ func (x *CreateProjectRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateProjectRequest.ProtoReflect.Descriptor instead.
 func (*CreateProjectRequest) Descriptor() ([]byte, []int) {
-	return file_bff_v1_projects_proto_rawDescGZIP(), []int{5}
+	return file_bff_v1_projects_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *CreateProjectRequest) GetName() string {
@@ -409,7 +581,7 @@ type CreateProjectResponse struct {
 
 func (x *CreateProjectResponse) Reset() {
 	*x = CreateProjectResponse{}
-	mi := &file_bff_v1_projects_proto_msgTypes[6]
+	mi := &file_bff_v1_projects_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -421,7 +593,7 @@ func (x *CreateProjectResponse) String() string {
 func (*CreateProjectResponse) ProtoMessage() {}
 
 func (x *CreateProjectResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_bff_v1_projects_proto_msgTypes[6]
+	mi := &file_bff_v1_projects_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -434,7 +606,7 @@ func (x *CreateProjectResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateProjectResponse.ProtoReflect.Descriptor instead.
 func (*CreateProjectResponse) Descriptor() ([]byte, []int) {
-	return file_bff_v1_projects_proto_rawDescGZIP(), []int{6}
+	return file_bff_v1_projects_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *CreateProjectResponse) GetProject() *Project {
@@ -444,19 +616,26 @@ func (x *CreateProjectResponse) GetProject() *Project {
 	return nil
 }
 
+// update_mask lists the fields to write, using proto field names. Masked fields
+// are set even when empty; "*" masks every updatable field. Without a mask,
+// only non-empty fields are applied.
 type UpdateProjectRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Status        ProjectStatus          `protobuf:"varint,4,opt,name=status,proto3,enum=bff.v1.ProjectStatus" json:"status,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Status      ProjectStatus          `protobuf:"varint,4,opt,name=status,proto3,enum=bff.v1.ProjectStatus" json:"status,omitempty"`
+	UpdateMask  *fieldmaskpb.FieldMask `protobuf:"bytes,5,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	// expected_etag, when set, makes the update fail with ABORTED if the project
+	// has changed since that etag was read.
+	ExpectedEtag  string `protobuf:"bytes,6,opt,name=expected_etag,json=expectedEtag,proto3" json:"expected_etag,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UpdateProjectRequest) Reset() {
 	*x = UpdateProjectRequest{}
-	mi := &file_bff_v1_projects_proto_msgTypes[7]
+	mi := &file_bff_v1_projects_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -468,7 +647,7 @@ func (x *UpdateProjectRequest) String() string {
 func (*UpdateProjectRequest) ProtoMessage() {}
 
 func (x *UpdateProjectRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_bff_v1_projects_proto_msgTypes[7]
+	mi := &file_bff_v1_projects_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -481,7 +660,7 @@ func (x *UpdateProjectRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateProjectRequest.ProtoReflect.Descriptor instead.
 func (*UpdateProjectRequest) Descriptor() ([]byte, []int) {
-	return file_bff_v1_projects_proto_rawDescGZIP(), []int{7}
+	return file_bff_v1_projects_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *UpdateProjectRequest) GetId() string {
@@ -512,6 +691,20 @@ func (x *UpdateProjectRequest) GetStatus() ProjectStatus {
 	return ProjectStatus_PROJECT_STATUS_UNSPECIFIED
 }
 
+func (x *UpdateProjectRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *UpdateProjectRequest) GetExpectedEtag() string {
+	if x != nil {
+		return x.ExpectedEtag
+	}
+	return ""
+}
+
 type UpdateProjectResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Project       *Project               `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
@@ -521,7 +714,7 @@ type UpdateProjectResponse struct {
 
 func (x *UpdateProjectResponse) Reset() {
 	*x = UpdateProjectResponse{}
-	mi := &file_bff_v1_projects_proto_msgTypes[8]
+	mi := &file_bff_v1_projects_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -533,7 +726,7 @@ func (x *UpdateProjectResponse) String() string {
 func (*UpdateProjectResponse) ProtoMessage() {}
 
 func (x *UpdateProjectResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_bff_v1_projects_proto_msgTypes[8]
+	mi := &file_bff_v1_projects_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -546,7 +739,7 @@ func (x *UpdateProjectResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateProjectResponse.ProtoReflect.Descriptor instead.
 func (*UpdateProjectResponse) Descriptor() ([]byte, []int) {
-	return file_bff_v1_projects_proto_rawDescGZIP(), []int{8}
+	return file_bff_v1_projects_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *UpdateProjectResponse) GetProject() *Project {
@@ -556,16 +749,22 @@ func (x *UpdateProjectResponse) GetProject() *Project {
 	return nil
 }
 
+// DeleteProject moves the project to the trash. It disappears from every other
+// RPC but can be restored until the trash retention period has passed, after
+// which it is deleted for good along with its members.
 type DeleteProjectRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// expected_etag, when set, makes the delete fail with ABORTED if the project
+	// has changed since that etag was read.
+	ExpectedEtag  string `protobuf:"bytes,2,opt,name=expected_etag,json=expectedEtag,proto3" json:"expected_etag,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *DeleteProjectRequest) Reset() {
 	*x = DeleteProjectRequest{}
-	mi := &file_bff_v1_projects_proto_msgTypes[9]
+	mi := &file_bff_v1_projects_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -577,7 +776,7 @@ func (x *DeleteProjectRequest) String() string {
 func (*DeleteProjectRequest) ProtoMessage() {}
 
 func (x *DeleteProjectRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_bff_v1_projects_proto_msgTypes[9]
+	mi := &file_bff_v1_projects_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -590,7 +789,7 @@ func (x *DeleteProjectRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteProjectRequest.ProtoReflect.Descriptor instead.
 func (*DeleteProjectRequest) Descriptor() ([]byte, []int) {
-	return file_bff_v1_projects_proto_rawDescGZIP(), []int{9}
+	return file_bff_v1_projects_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *DeleteProjectRequest) GetId() string {
@@ -600,6 +799,13 @@ func (x *DeleteProjectRequest) GetId() string {
 	return ""
 }
 
+func (x *DeleteProjectRequest) GetExpectedEtag() string {
+	if x != nil {
+		return x.ExpectedEtag
+	}
+	return ""
+}
+
 type DeleteProjectResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -608,7 +814,7 @@ type DeleteProjectResponse struct {
 
 func (x *DeleteProjectResponse) Reset() {
 	*x = DeleteProjectResponse{}
-	mi := &file_bff_v1_projects_proto_msgTypes[10]
+	mi := &file_bff_v1_projects_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -620,7 +826,7 @@ func (x *DeleteProjectResponse) String() string {
 func (*DeleteProjectResponse) ProtoMessage() {}
 
 func (x *DeleteProjectResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_bff_v1_projects_proto_msgTypes[10]
+	mi := &file_bff_v1_projects_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -633,14 +839,432 @@ func (x *DeleteProjectResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteProjectResponse.ProtoReflect.Descriptor instead.
 func (*DeleteProjectResponse) Descriptor() ([]byte, []int) {
-	return file_bff_v1_projects_proto_rawDescGZIP(), []int{10}
+	return file_bff_v1_projects_proto_rawDescGZIP(), []int{11}
+}
+
+// ListTrashedProjects returns trashed projects, most recently deleted first.
+// Global admins see all of them; everyone else sees the projects they
+// administer, which are the ones they can restore.
+type ListTrashedProjectsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// page_size is clamped to 100; zero selects the default of 20.
+	PageSize      int32  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTrashedProjectsRequest) Reset() {
+	*x = ListTrashedProjectsRequest{}
+	mi := &file_bff_v1_projects_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTrashedProjectsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTrashedProjectsRequest) ProtoMessage() {}
+
+func (x *ListTrashedProjectsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bff_v1_projects_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTrashedProjectsRequest.ProtoReflect.Descriptor instead.
+func (*ListTrashedProjectsRequest) Descriptor() ([]byte, []int) {
+	return file_bff_v1_projects_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListTrashedProjectsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListTrashedProjectsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListTrashedProjectsResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Projects []*Project             `protobuf:"bytes,1,rep,name=projects,proto3" json:"projects,omitempty"`
+	// next_page_token is empty on the last page.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// retention is how long a project stays in the trash: it is purged at
+	// deleted_at + retention.
+	Retention     *durationpb.Duration `protobuf:"bytes,3,opt,name=retention,proto3" json:"retention,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTrashedProjectsResponse) Reset() {
+	*x = ListTrashedProjectsResponse{}
+	mi := &file_bff_v1_projects_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTrashedProjectsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTrashedProjectsResponse) ProtoMessage() {}
+
+func (x *ListTrashedProjectsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bff_v1_projects_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTrashedProjectsResponse.ProtoReflect.Descriptor instead.
+func (*ListTrashedProjectsResponse) Descriptor() ([]byte, []int) {
+	return file_bff_v1_projects_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListTrashedProjectsResponse) GetProjects() []*Project {
+	if x != nil {
+		return x.Projects
+	}
+	return nil
+}
+
+func (x *ListTrashedProjectsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListTrashedProjectsResponse) GetRetention() *durationpb.Duration {
+	if x != nil {
+		return x.Retention
+	}
+	return nil
+}
+
+type RestoreProjectRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreProjectRequest) Reset() {
+	*x = RestoreProjectRequest{}
+	mi := &file_bff_v1_projects_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreProjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreProjectRequest) ProtoMessage() {}
+
+func (x *RestoreProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bff_v1_projects_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreProjectRequest.ProtoReflect.Descriptor instead.
+func (*RestoreProjectRequest) Descriptor() ([]byte, []int) {
+	return file_bff_v1_projects_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *RestoreProjectRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RestoreProjectResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Project       *Project               `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreProjectResponse) Reset() {
+	*x = RestoreProjectResponse{}
+	mi := &file_bff_v1_projects_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreProjectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreProjectResponse) ProtoMessage() {}
+
+func (x *RestoreProjectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bff_v1_projects_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreProjectResponse.ProtoReflect.Descriptor instead.
+func (*RestoreProjectResponse) Descriptor() ([]byte, []int) {
+	return file_bff_v1_projects_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *RestoreProjectResponse) GetProject() *Project {
+	if x != nil {
+		return x.Project
+	}
+	return nil
+}
+
+type GetProjectSettingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProjectSettingsRequest) Reset() {
+	*x = GetProjectSettingsRequest{}
+	mi := &file_bff_v1_projects_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProjectSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProjectSettingsRequest) ProtoMessage() {}
+
+func (x *GetProjectSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bff_v1_projects_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProjectSettingsRequest.ProtoReflect.Descriptor instead.
+func (*GetProjectSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_bff_v1_projects_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetProjectSettingsRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+type GetProjectSettingsResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Settings *structpb.Struct       `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+	// etag is the project etag; settings writes change it too.
+	Etag          string `protobuf:"bytes,2,opt,name=etag,proto3" json:"etag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProjectSettingsResponse) Reset() {
+	*x = GetProjectSettingsResponse{}
+	mi := &file_bff_v1_projects_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProjectSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProjectSettingsResponse) ProtoMessage() {}
+
+func (x *GetProjectSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bff_v1_projects_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProjectSettingsResponse.ProtoReflect.Descriptor instead.
+func (*GetProjectSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_bff_v1_projects_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetProjectSettingsResponse) GetSettings() *structpb.Struct {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+func (x *GetProjectSettingsResponse) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+// patch is applied with JSON merge-patch semantics (RFC 7386): keys set to
+// null are removed and all other keys are replaced.
+type UpdateProjectSettingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Patch         *structpb.Struct       `protobuf:"bytes,2,opt,name=patch,proto3" json:"patch,omitempty"`
+	ExpectedEtag  string                 `protobuf:"bytes,3,opt,name=expected_etag,json=expectedEtag,proto3" json:"expected_etag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProjectSettingsRequest) Reset() {
+	*x = UpdateProjectSettingsRequest{}
+	mi := &file_bff_v1_projects_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProjectSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProjectSettingsRequest) ProtoMessage() {}
+
+func (x *UpdateProjectSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bff_v1_projects_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProjectSettingsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProjectSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_bff_v1_projects_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *UpdateProjectSettingsRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *UpdateProjectSettingsRequest) GetPatch() *structpb.Struct {
+	if x != nil {
+		return x.Patch
+	}
+	return nil
+}
+
+func (x *UpdateProjectSettingsRequest) GetExpectedEtag() string {
+	if x != nil {
+		return x.ExpectedEtag
+	}
+	return ""
+}
+
+type UpdateProjectSettingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Settings      *structpb.Struct       `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+	Etag          string                 `protobuf:"bytes,2,opt,name=etag,proto3" json:"etag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProjectSettingsResponse) Reset() {
+	*x = UpdateProjectSettingsResponse{}
+	mi := &file_bff_v1_projects_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProjectSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProjectSettingsResponse) ProtoMessage() {}
+
+func (x *UpdateProjectSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bff_v1_projects_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProjectSettingsResponse.ProtoReflect.Descriptor instead.
+func (*UpdateProjectSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_bff_v1_projects_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *UpdateProjectSettingsResponse) GetSettings() *structpb.Struct {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+func (x *UpdateProjectSettingsResponse) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
 }
 
 var File_bff_v1_projects_proto protoreflect.FileDescriptor
 
 const file_bff_v1_projects_proto_rawDesc = "" +
 	"\n" +
-	"\x15bff/v1/projects.proto\x12\x06bff.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xf4\x01\n" +
+	"\x15bff/v1/projects.proto\x12\x06bff.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1egoogle/protobuf/duration.proto\x1a google/protobuf/field_mask.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xc3\x02\n" +
 	"\aProject\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
@@ -649,43 +1273,100 @@ const file_bff_v1_projects_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"F\n" +
-	"\x13ListProjectsRequest\x12\x12\n" +
-	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\"Y\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x12\n" +
+	"\x04etag\x18\a \x01(\tR\x04etag\x129\n" +
+	"\n" +
+	"deleted_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\"\x94\x03\n" +
+	"\x13ListProjectsRequest\x12\x1d\n" +
+	"\x04page\x18\x01 \x01(\x05B\t\xbaH\x04\x1a\x02(\x00\x18\x01R\x04page\x12$\n" +
+	"\tpage_size\x18\x02 \x01(\x05B\a\xbaH\x04\x1a\x02(\x00R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\x12\x1d\n" +
+	"\n" +
+	"skip_total\x18\x04 \x01(\bR\tskipTotal\x127\n" +
+	"\x06status\x18\x05 \x01(\x0e2\x15.bff.v1.ProjectStatusB\b\xbaH\x05\x82\x01\x02\x10\x01R\x06status\x12-\n" +
+	"\rname_contains\x18\x06 \x01(\tB\b\xbaH\x05r\x03\x18\xc8\x01R\fnameContains\x121\n" +
+	"\x0emember_user_id\x18\a \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\fmemberUserId\x12?\n" +
+	"\rupdated_since\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\fupdatedSince\x12\x1e\n" +
+	"\x05query\x18\t \x01(\tB\b\xbaH\x05r\x03\x18\xf4\x03R\x05query\"\\\n" +
+	"\x10ProjectHighlight\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x12\n" +
+	"\x04rank\x18\x03 \x01(\x02R\x04rank\"\xa8\x02\n" +
 	"\x14ListProjectsResponse\x12+\n" +
 	"\bprojects\x18\x01 \x03(\v2\x0f.bff.v1.ProjectR\bprojects\x12\x14\n" +
-	"\x05total\x18\x02 \x01(\x05R\x05total\"#\n" +
-	"\x11GetProjectRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"?\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12&\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageToken\x12L\n" +
+	"\n" +
+	"highlights\x18\x04 \x03(\v2,.bff.v1.ListProjectsResponse.HighlightsEntryR\n" +
+	"highlights\x1aW\n" +
+	"\x0fHighlightsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12.\n" +
+	"\x05value\x18\x02 \x01(\v2\x18.bff.v1.ProjectHighlightR\x05value:\x028\x01\"-\n" +
+	"\x11GetProjectRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"?\n" +
 	"\x12GetProjectResponse\x12)\n" +
-	"\aproject\x18\x01 \x01(\v2\x0f.bff.v1.ProjectR\aproject\"L\n" +
-	"\x14CreateProjectRequest\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
-	"\vdescription\x18\x02 \x01(\tR\vdescription\"B\n" +
+	"\aproject\x18\x01 \x01(\v2\x0f.bff.v1.ProjectR\aproject\"d\n" +
+	"\x14CreateProjectRequest\x12 \n" +
+	"\x04name\x18\x01 \x01(\tB\f\xbaH\tr\a\x18\xc8\x012\x02\\SR\x04name\x12*\n" +
+	"\vdescription\x18\x02 \x01(\tB\b\xbaH\x05r\x03\x18\xa0\x1fR\vdescription\"B\n" +
 	"\x15CreateProjectResponse\x12)\n" +
-	"\aproject\x18\x01 \x01(\v2\x0f.bff.v1.ProjectR\aproject\"\x8b\x01\n" +
-	"\x14UpdateProjectRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
-	"\vdescription\x18\x03 \x01(\tR\vdescription\x12-\n" +
-	"\x06status\x18\x04 \x01(\x0e2\x15.bff.v1.ProjectStatusR\x06status\"B\n" +
+	"\aproject\x18\x01 \x01(\v2\x0f.bff.v1.ProjectR\aproject\"\x95\x02\n" +
+	"\x14UpdateProjectRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12\x1c\n" +
+	"\x04name\x18\x02 \x01(\tB\b\xbaH\x05r\x03\x18\xc8\x01R\x04name\x12*\n" +
+	"\vdescription\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\xa0\x1fR\vdescription\x127\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x15.bff.v1.ProjectStatusB\b\xbaH\x05\x82\x01\x02\x10\x01R\x06status\x12;\n" +
+	"\vupdate_mask\x18\x05 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\x12#\n" +
+	"\rexpected_etag\x18\x06 \x01(\tR\fexpectedEtag\"B\n" +
 	"\x15UpdateProjectResponse\x12)\n" +
-	"\aproject\x18\x01 \x01(\v2\x0f.bff.v1.ProjectR\aproject\"&\n" +
-	"\x14DeleteProjectRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"\x17\n" +
-	"\x15DeleteProjectResponse*g\n" +
+	"\aproject\x18\x01 \x01(\v2\x0f.bff.v1.ProjectR\aproject\"U\n" +
+	"\x14DeleteProjectRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12#\n" +
+	"\rexpected_etag\x18\x02 \x01(\tR\fexpectedEtag\"\x17\n" +
+	"\x15DeleteProjectResponse\"a\n" +
+	"\x1aListTrashedProjectsRequest\x12$\n" +
+	"\tpage_size\x18\x01 \x01(\x05B\a\xbaH\x04\x1a\x02(\x00R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\"\xab\x01\n" +
+	"\x1bListTrashedProjectsResponse\x12+\n" +
+	"\bprojects\x18\x01 \x03(\v2\x0f.bff.v1.ProjectR\bprojects\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x127\n" +
+	"\tretention\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\tretention\"1\n" +
+	"\x15RestoreProjectRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"C\n" +
+	"\x16RestoreProjectResponse\x12)\n" +
+	"\aproject\x18\x01 \x01(\v2\x0f.bff.v1.ProjectR\aproject\"D\n" +
+	"\x19GetProjectSettingsRequest\x12'\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\tprojectId\"e\n" +
+	"\x1aGetProjectSettingsResponse\x123\n" +
+	"\bsettings\x18\x01 \x01(\v2\x17.google.protobuf.StructR\bsettings\x12\x12\n" +
+	"\x04etag\x18\x02 \x01(\tR\x04etag\"\xa3\x01\n" +
+	"\x1cUpdateProjectSettingsRequest\x12'\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\tprojectId\x125\n" +
+	"\x05patch\x18\x02 \x01(\v2\x17.google.protobuf.StructB\x06\xbaH\x03\xc8\x01\x01R\x05patch\x12#\n" +
+	"\rexpected_etag\x18\x03 \x01(\tR\fexpectedEtag\"h\n" +
+	"\x1dUpdateProjectSettingsResponse\x123\n" +
+	"\bsettings\x18\x01 \x01(\v2\x17.google.protobuf.StructR\bsettings\x12\x12\n" +
+	"\x04etag\x18\x02 \x01(\tR\x04etag*g\n" +
 	"\rProjectStatus\x12\x1e\n" +
 	"\x1aPROJECT_STATUS_UNSPECIFIED\x10\x00\x12\x19\n" +
 	"\x15PROJECT_STATUS_ACTIVE\x10\x01\x12\x1b\n" +
-	"\x17PROJECT_STATUS_ARCHIVED\x10\x022\x8a\x03\n" +
+	"\x17PROJECT_STATUS_ARCHIVED\x10\x022\xfe\x05\n" +
 	"\x0eProjectService\x12I\n" +
 	"\fListProjects\x12\x1b.bff.v1.ListProjectsRequest\x1a\x1c.bff.v1.ListProjectsResponse\x12C\n" +
 	"\n" +
 	"GetProject\x12\x19.bff.v1.GetProjectRequest\x1a\x1a.bff.v1.GetProjectResponse\x12L\n" +
 	"\rCreateProject\x12\x1c.bff.v1.CreateProjectRequest\x1a\x1d.bff.v1.CreateProjectResponse\x12L\n" +
 	"\rUpdateProject\x12\x1c.bff.v1.UpdateProjectRequest\x1a\x1d.bff.v1.UpdateProjectResponse\x12L\n" +
-	"\rDeleteProject\x12\x1c.bff.v1.DeleteProjectRequest\x1a\x1d.bff.v1.DeleteProjectResponseBBZ@github.com/ApeironFoundation/axle/contracts/go/bff/v1;gen_bff_v1b\x06proto3"
+	"\rDeleteProject\x12\x1c.bff.v1.DeleteProjectRequest\x1a\x1d.bff.v1.DeleteProjectResponse\x12^\n" +
+	"\x13ListTrashedProjects\x12\".bff.v1.ListTrashedProjectsRequest\x1a#.bff.v1.ListTrashedProjectsResponse\x12O\n" +
+	"\x0eRestoreProject\x12\x1d.bff.v1.RestoreProjectRequest\x1a\x1e.bff.v1.RestoreProjectResponse\x12[\n" +
+	"\x12GetProjectSettings\x12!.bff.v1.GetProjectSettingsRequest\x1a\".bff.v1.GetProjectSettingsResponse\x12d\n" +
+	"\x15UpdateProjectSettings\x12$.bff.v1.UpdateProjectSettingsRequest\x1a%.bff.v1.UpdateProjectSettingsResponseBBZ@github.com/ApeironFoundation/axle/contracts/go/bff/v1;gen_bff_v1b\x06proto3"
 
 var (
 	file_bff_v1_projects_proto_rawDescOnce sync.Once
@@ -700,46 +1381,79 @@ func file_bff_v1_projects_proto_rawDescGZIP() []byte {
 }
 
 var file_bff_v1_projects_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_bff_v1_projects_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_bff_v1_projects_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
 var file_bff_v1_projects_proto_goTypes = []any{
-	(ProjectStatus)(0),            // 0: bff.v1.ProjectStatus
-	(*Project)(nil),               // 1: bff.v1.Project
-	(*ListProjectsRequest)(nil),   // 2: bff.v1.ListProjectsRequest
-	(*ListProjectsResponse)(nil),  // 3: bff.v1.ListProjectsResponse
-	(*GetProjectRequest)(nil),     // 4: bff.v1.GetProjectRequest
-	(*GetProjectResponse)(nil),    // 5: bff.v1.GetProjectResponse
-	(*CreateProjectRequest)(nil),  // 6: bff.v1.CreateProjectRequest
-	(*CreateProjectResponse)(nil), // 7: bff.v1.CreateProjectResponse
-	(*UpdateProjectRequest)(nil),  // 8: bff.v1.UpdateProjectRequest
-	(*UpdateProjectResponse)(nil), // 9: bff.v1.UpdateProjectResponse
-	(*DeleteProjectRequest)(nil),  // 10: bff.v1.DeleteProjectRequest
-	(*DeleteProjectResponse)(nil), // 11: bff.v1.DeleteProjectResponse
-	(*timestamppb.Timestamp)(nil), // 12: google.protobuf.Timestamp
+	(ProjectStatus)(0),                    // 0: bff.v1.ProjectStatus
+	(*Project)(nil),                       // 1: bff.v1.Project
+	(*ListProjectsRequest)(nil),           // 2: bff.v1.ListProjectsRequest
+	(*ProjectHighlight)(nil),              // 3: bff.v1.ProjectHighlight
+	(*ListProjectsResponse)(nil),          // 4: bff.v1.ListProjectsResponse
+	(*GetProjectRequest)(nil),             // 5: bff.v1.GetProjectRequest
+	(*GetProjectResponse)(nil),            // 6: bff.v1.GetProjectResponse
+	(*CreateProjectRequest)(nil),          // 7: bff.v1.CreateProjectRequest
+	(*CreateProjectResponse)(nil),         // 8: bff.v1.CreateProjectResponse
+	(*UpdateProjectRequest)(nil),          // 9: bff.v1.UpdateProjectRequest
+	(*UpdateProjectResponse)(nil),         // 10: bff.v1.UpdateProjectResponse
+	(*DeleteProjectRequest)(nil),          // 11: bff.v1.DeleteProjectRequest
+	(*DeleteProjectResponse)(nil),         // 12: bff.v1.DeleteProjectResponse
+	(*ListTrashedProjectsRequest)(nil),    // 13: bff.v1.ListTrashedProjectsRequest
+	(*ListTrashedProjectsResponse)(nil),   // 14: bff.v1.ListTrashedProjectsResponse
+	(*RestoreProjectRequest)(nil),         // 15: bff.v1.RestoreProjectRequest
+	(*RestoreProjectResponse)(nil),        // 16: bff.v1.RestoreProjectResponse
+	(*GetProjectSettingsRequest)(nil),     // 17: bff.v1.GetProjectSettingsRequest
+	(*GetProjectSettingsResponse)(nil),    // 18: bff.v1.GetProjectSettingsResponse
+	(*UpdateProjectSettingsRequest)(nil),  // 19: bff.v1.UpdateProjectSettingsRequest
+	(*UpdateProjectSettingsResponse)(nil), // 20: bff.v1.UpdateProjectSettingsResponse
+	nil,                                   // 21: bff.v1.ListProjectsResponse.HighlightsEntry
+	(*timestamppb.Timestamp)(nil),         // 22: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),         // 23: google.protobuf.FieldMask
+	(*durationpb.Duration)(nil),           // 24: google.protobuf.Duration
+	(*structpb.Struct)(nil),               // 25: google.protobuf.Struct
 }
 var file_bff_v1_projects_proto_depIdxs = []int32{
 	0,  // 0: bff.v1.Project.status:type_name -> bff.v1.ProjectStatus
-	12, // 1: bff.v1.Project.created_at:type_name -> google.protobuf.Timestamp
-	12, // 2: bff.v1.Project.updated_at:type_name -> google.protobuf.Timestamp
-	1,  // 3: bff.v1.ListProjectsResponse.projects:type_name -> bff.v1.Project
-	1,  // 4: bff.v1.GetProjectResponse.project:type_name -> bff.v1.Project
-	1,  // 5: bff.v1.CreateProjectResponse.project:type_name -> bff.v1.Project
-	0,  // 6: bff.v1.UpdateProjectRequest.status:type_name -> bff.v1.ProjectStatus
-	1,  // 7: bff.v1.UpdateProjectResponse.project:type_name -> bff.v1.Project
-	2,  // 8: bff.v1.ProjectService.ListProjects:input_type -> bff.v1.ListProjectsRequest
-	4,  // 9: bff.v1.ProjectService.GetProject:input_type -> bff.v1.GetProjectRequest
-	6,  // 10: bff.v1.ProjectService.CreateProject:input_type -> bff.v1.CreateProjectRequest
-	8,  // 11: bff.v1.ProjectService.UpdateProject:input_type -> bff.v1.UpdateProjectRequest
-	10, // 12: bff.v1.ProjectService.DeleteProject:input_type -> bff.v1.DeleteProjectRequest
-	3,  // 13: bff.v1.ProjectService.ListProjects:output_type -> bff.v1.ListProjectsResponse
-	5,  // 14: bff.v1.ProjectService.GetProject:output_type -> bff.v1.GetProjectResponse
-	7,  // 15: bff.v1.ProjectService.CreateProject:output_type -> bff.v1.CreateProjectResponse
-	9,  // 16: bff.v1.ProjectService.UpdateProject:output_type -> bff.v1.UpdateProjectResponse
-	11, // 17: bff.v1.ProjectService.DeleteProject:output_type -> bff.v1.DeleteProjectResponse
-	13, // [13:18] is the sub-list for method output_type
-	8,  // [8:13] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	22, // 1: bff.v1.Project.created_at:type_name -> google.protobuf.Timestamp
+	22, // 2: bff.v1.Project.updated_at:type_name -> google.protobuf.Timestamp
+	22, // 3: bff.v1.Project.deleted_at:type_name -> google.protobuf.Timestamp
+	0,  // 4: bff.v1.ListProjectsRequest.status:type_name -> bff.v1.ProjectStatus
+	22, // 5: bff.v1.ListProjectsRequest.updated_since:type_name -> google.protobuf.Timestamp
+	1,  // 6: bff.v1.ListProjectsResponse.projects:type_name -> bff.v1.Project
+	21, // 7: bff.v1.ListProjectsResponse.highlights:type_name -> bff.v1.ListProjectsResponse.HighlightsEntry
+	1,  // 8: bff.v1.GetProjectResponse.project:type_name -> bff.v1.Project
+	1,  // 9: bff.v1.CreateProjectResponse.project:type_name -> bff.v1.Project
+	0,  // 10: bff.v1.UpdateProjectRequest.status:type_name -> bff.v1.ProjectStatus
+	23, // 11: bff.v1.UpdateProjectRequest.update_mask:type_name -> google.protobuf.FieldMask
+	1,  // 12: bff.v1.UpdateProjectResponse.project:type_name -> bff.v1.Project
+	1,  // 13: bff.v1.ListTrashedProjectsResponse.projects:type_name -> bff.v1.Project
+	24, // 14: bff.v1.ListTrashedProjectsResponse.retention:type_name -> google.protobuf.Duration
+	1,  // 15: bff.v1.RestoreProjectResponse.project:type_name -> bff.v1.Project
+	25, // 16: bff.v1.GetProjectSettingsResponse.settings:type_name -> google.protobuf.Struct
+	25, // 17: bff.v1.UpdateProjectSettingsRequest.patch:type_name -> google.protobuf.Struct
+	25, // 18: bff.v1.UpdateProjectSettingsResponse.settings:type_name -> google.protobuf.Struct
+	3,  // 19: bff.v1.ListProjectsResponse.HighlightsEntry.value:type_name -> bff.v1.ProjectHighlight
+	2,  // 20: bff.v1.ProjectService.ListProjects:input_type -> bff.v1.ListProjectsRequest
+	5,  // 21: bff.v1.ProjectService.GetProject:input_type -> bff.v1.GetProjectRequest
+	7,  // 22: bff.v1.ProjectService.CreateProject:input_type -> bff.v1.CreateProjectRequest
+	9,  // 23: bff.v1.ProjectService.UpdateProject:input_type -> bff.v1.UpdateProjectRequest
+	11, // 24: bff.v1.ProjectService.DeleteProject:input_type -> bff.v1.DeleteProjectRequest
+	13, // 25: bff.v1.ProjectService.ListTrashedProjects:input_type -> bff.v1.ListTrashedProjectsRequest
+	15, // 26: bff.v1.ProjectService.RestoreProject:input_type -> bff.v1.RestoreProjectRequest
+	17, // 27: bff.v1.ProjectService.GetProjectSettings:input_type -> bff.v1.GetProjectSettingsRequest
+	19, // 28: bff.v1.ProjectService.UpdateProjectSettings:input_type -> bff.v1.UpdateProjectSettingsRequest
+	4,  // 29: bff.v1.ProjectService.ListProjects:output_type -> bff.v1.ListProjectsResponse
+	6,  // 30: bff.v1.ProjectService.GetProject:output_type -> bff.v1.GetProjectResponse
+	8,  // 31: bff.v1.ProjectService.CreateProject:output_type -> bff.v1.CreateProjectResponse
+	10, // 32: bff.v1.ProjectService.UpdateProject:output_type -> bff.v1.UpdateProjectResponse
+	12, // 33: bff.v1.ProjectService.DeleteProject:output_type -> bff.v1.DeleteProjectResponse
+	14, // 34: bff.v1.ProjectService.ListTrashedProjects:output_type -> bff.v1.ListTrashedProjectsResponse
+	16, // 35: bff.v1.ProjectService.RestoreProject:output_type -> bff.v1.RestoreProjectResponse
+	18, // 36: bff.v1.ProjectService.GetProjectSettings:output_type -> bff.v1.GetProjectSettingsResponse
+	20, // 37: bff.v1.ProjectService.UpdateProjectSettings:output_type -> bff.v1.UpdateProjectSettingsResponse
+	29, // [29:38] is the sub-list for method output_type
+	20, // [20:29] is the sub-list for method input_type
+	20, // [20:20] is the sub-list for extension type_name
+	20, // [20:20] is the sub-list for extension extendee
+	0,  // [0:20] is the sub-list for field type_name
 }
 
 func init() { file_bff_v1_projects_proto_init() }
@@ -753,7 +1467,7 @@ func file_bff_v1_projects_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_bff_v1_projects_proto_rawDesc), len(file_bff_v1_projects_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   11,
+			NumMessages:   21,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -7,8 +7,11 @@
 package gen_bff_v1
 
 import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	structpb "google.golang.org/protobuf/types/known/structpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -75,15 +78,71 @@ func (UserRole) EnumDescriptor() ([]byte, []int) {
 	return file_bff_v1_users_proto_rawDescGZIP(), []int{0}
 }
 
+// UserStatus says whether a user can sign in.
+type UserStatus int32
+
+const (
+	UserStatus_USER_STATUS_UNSPECIFIED UserStatus = 0
+	UserStatus_USER_STATUS_ACTIVE      UserStatus = 1
+	// Deactivated users cannot sign in or use their access tokens, but keep
+	// their memberships and history.
+	UserStatus_USER_STATUS_DEACTIVATED UserStatus = 2
+)
+
+// Enum value maps for UserStatus.
+var (
+	UserStatus_name = map[int32]string{
+		0: "USER_STATUS_UNSPECIFIED",
+		1: "USER_STATUS_ACTIVE",
+		2: "USER_STATUS_DEACTIVATED",
+	}
+	UserStatus_value = map[string]int32{
+		"USER_STATUS_UNSPECIFIED": 0,
+		"USER_STATUS_ACTIVE":      1,
+		"USER_STATUS_DEACTIVATED": 2,
+	}
+)
+
+func (x UserStatus) Enum() *UserStatus {
+	p := new(UserStatus)
+	*p = x
+	return p
+}
+
+func (x UserStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UserStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_bff_v1_users_proto_enumTypes[1].Descriptor()
+}
+
+func (UserStatus) Type() protoreflect.EnumType {
+	return &file_bff_v1_users_proto_enumTypes[1]
+}
+
+func (x UserStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UserStatus.Descriptor instead.
+func (UserStatus) EnumDescriptor() ([]byte, []int) {
+	return file_bff_v1_users_proto_rawDescGZIP(), []int{1}
+}
+
 type User struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
-	Role          UserRole               `protobuf:"varint,4,opt,name=role,proto3,enum=bff.v1.UserRole" json:"role,omitempty"`
-	Locale        string                 `protobuf:"bytes,5,opt,name=locale,proto3" json:"locale,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name      string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Email     string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	Role      UserRole               `protobuf:"varint,4,opt,name=role,proto3,enum=bff.v1.UserRole" json:"role,omitempty"`
+	Locale    string                 `protobuf:"bytes,5,opt,name=locale,proto3" json:"locale,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// etag changes on every write; pass it back as expected_etag to detect
+	// concurrent edits.
+	Etag          string     `protobuf:"bytes,8,opt,name=etag,proto3" json:"etag,omitempty"`
+	Status        UserStatus `protobuf:"varint,9,opt,name=status,proto3,enum=bff.v1.UserStatus" json:"status,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -167,10 +226,31 @@ func (x *User) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *User) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+func (x *User) GetStatus() UserStatus {
+	if x != nil {
+		return x.Status
+	}
+	return UserStatus_USER_STATUS_UNSPECIFIED
+}
+
+// Pagination is keyset-based: pass the previous next_page_token as page_token.
+// The 1-based page number is still honoured for older clients when page_token is empty.
 type ListUsersRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Deprecated: Marked as deprecated in bff/v1/users.proto.
+	Page int32 `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	// page_size is clamped to 100; zero selects the default of 20.
+	PageSize  int32  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// skip_total omits the total count, which saves a COUNT(*) per page.
+	SkipTotal     bool `protobuf:"varint,4,opt,name=skip_total,json=skipTotal,proto3" json:"skip_total,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -205,6 +285,7 @@ func (*ListUsersRequest) Descriptor() ([]byte, []int) {
 	return file_bff_v1_users_proto_rawDescGZIP(), []int{1}
 }
 
+// Deprecated: Marked as deprecated in bff/v1/users.proto.
 func (x *ListUsersRequest) GetPage() int32 {
 	if x != nil {
 		return x.Page
@@ -219,10 +300,27 @@ func (x *ListUsersRequest) GetPageSize() int32 {
 	return 0
 }
 
+func (x *ListUsersRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListUsersRequest) GetSkipTotal() bool {
+	if x != nil {
+		return x.SkipTotal
+	}
+	return false
+}
+
 type ListUsersResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Users         []*User                `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Users []*User                `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	// total is 0 when skip_total was requested.
+	Total int32 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	// next_page_token is empty on the last page.
+	NextPageToken string `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -271,6 +369,13 @@ func (x *ListUsersResponse) GetTotal() int32 {
 	return 0
 }
 
+func (x *ListUsersResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
 type GetUserRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -439,18 +544,127 @@ func (x *GetMeResponse) GetUser() *User {
 	return nil
 }
 
-type UpdateUserRequest struct {
+// GetMyAccess tells services in front of the BFF what the caller may see. The
+// Gateway calls it with a subscriber's token to authorize event streams.
+type GetMyAccessRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Locale        string                 `protobuf:"bytes,3,opt,name=locale,proto3" json:"locale,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMyAccessRequest) Reset() {
+	*x = GetMyAccessRequest{}
+	mi := &file_bff_v1_users_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMyAccessRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMyAccessRequest) ProtoMessage() {}
+
+func (x *GetMyAccessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bff_v1_users_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMyAccessRequest.ProtoReflect.Descriptor instead.
+func (*GetMyAccessRequest) Descriptor() ([]byte, []int) {
+	return file_bff_v1_users_proto_rawDescGZIP(), []int{7}
+}
+
+type GetMyAccessResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// role is the caller's account role; admins can see every project.
+	Role UserRole `protobuf:"varint,2,opt,name=role,proto3,enum=bff.v1.UserRole" json:"role,omitempty"`
+	// project_ids lists the projects the caller is a member of, trashed ones
+	// excluded.
+	ProjectIds    []string `protobuf:"bytes,3,rep,name=project_ids,json=projectIds,proto3" json:"project_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMyAccessResponse) Reset() {
+	*x = GetMyAccessResponse{}
+	mi := &file_bff_v1_users_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMyAccessResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMyAccessResponse) ProtoMessage() {}
+
+func (x *GetMyAccessResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bff_v1_users_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMyAccessResponse.ProtoReflect.Descriptor instead.
+func (*GetMyAccessResponse) Descriptor() ([]byte, []int) {
+	return file_bff_v1_users_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetMyAccessResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetMyAccessResponse) GetRole() UserRole {
+	if x != nil {
+		return x.Role
+	}
+	return UserRole_USER_ROLE_UNSPECIFIED
+}
+
+func (x *GetMyAccessResponse) GetProjectIds() []string {
+	if x != nil {
+		return x.ProjectIds
+	}
+	return nil
+}
+
+// update_mask lists the fields to write, using proto field names. Masked fields
+// are set even when empty; "*" masks every updatable field. Without a mask,
+// only non-empty fields are applied.
+type UpdateUserRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// locale is a BCP 47 language tag such as "en" or "pt-BR".
+	Locale     string                 `protobuf:"bytes,3,opt,name=locale,proto3" json:"locale,omitempty"`
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,4,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	// expected_etag, when set, makes the update fail with ABORTED if the user
+	// has changed since that etag was read.
+	ExpectedEtag  string `protobuf:"bytes,5,opt,name=expected_etag,json=expectedEtag,proto3" json:"expected_etag,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UpdateUserRequest) Reset() {
 	*x = UpdateUserRequest{}
-	mi := &file_bff_v1_users_proto_msgTypes[7]
+	mi := &file_bff_v1_users_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -462,7 +676,7 @@ func (x *UpdateUserRequest) String() string {
 func (*UpdateUserRequest) ProtoMessage() {}
 
 func (x *UpdateUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_bff_v1_users_proto_msgTypes[7]
+	mi := &file_bff_v1_users_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -475,7 +689,7 @@ func (x *UpdateUserRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateUserRequest.ProtoReflect.Descriptor instead.
 func (*UpdateUserRequest) Descriptor() ([]byte, []int) {
-	return file_bff_v1_users_proto_rawDescGZIP(), []int{7}
+	return file_bff_v1_users_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *UpdateUserRequest) GetId() string {
@@ -499,6 +713,20 @@ func (x *UpdateUserRequest) GetLocale() string {
 	return ""
 }
 
+func (x *UpdateUserRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *UpdateUserRequest) GetExpectedEtag() string {
+	if x != nil {
+		return x.ExpectedEtag
+	}
+	return ""
+}
+
 type UpdateUserResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
@@ -508,7 +736,7 @@ type UpdateUserResponse struct {
 
 func (x *UpdateUserResponse) Reset() {
 	*x = UpdateUserResponse{}
-	mi := &file_bff_v1_users_proto_msgTypes[8]
+	mi := &file_bff_v1_users_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -520,7 +748,7 @@ func (x *UpdateUserResponse) String() string {
 func (*UpdateUserResponse) ProtoMessage() {}
 
 func (x *UpdateUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_bff_v1_users_proto_msgTypes[8]
+	mi := &file_bff_v1_users_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -533,7 +761,7 @@ func (x *UpdateUserResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateUserResponse.ProtoReflect.Descriptor instead.
 func (*UpdateUserResponse) Descriptor() ([]byte, []int) {
-	return file_bff_v1_users_proto_rawDescGZIP(), []int{8}
+	return file_bff_v1_users_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *UpdateUserResponse) GetUser() *User {
@@ -543,51 +771,784 @@ func (x *UpdateUserResponse) GetUser() *User {
 	return nil
 }
 
-var File_bff_v1_users_proto protoreflect.FileDescriptor
+type GetMyPreferencesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_bff_v1_users_proto_rawDesc = "" +
-	"\n" +
-	"\x12bff/v1/users.proto\x12\x06bff.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xf4\x01\n" +
-	"\x04User\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
-	"\x05email\x18\x03 \x01(\tR\x05email\x12$\n" +
-	"\x04role\x18\x04 \x01(\x0e2\x10.bff.v1.UserRoleR\x04role\x12\x16\n" +
-	"\x06locale\x18\x05 \x01(\tR\x06locale\x129\n" +
-	"\n" +
-	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
-	"\n" +
-	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"C\n" +
-	"\x10ListUsersRequest\x12\x12\n" +
-	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\"M\n" +
-	"\x11ListUsersResponse\x12\"\n" +
-	"\x05users\x18\x01 \x03(\v2\f.bff.v1.UserR\x05users\x12\x14\n" +
-	"\x05total\x18\x02 \x01(\x05R\x05total\" \n" +
-	"\x0eGetUserRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"3\n" +
-	"\x0fGetUserResponse\x12 \n" +
-	"\x04user\x18\x01 \x01(\v2\f.bff.v1.UserR\x04user\"\x0e\n" +
-	"\fGetMeRequest\"1\n" +
-	"\rGetMeResponse\x12 \n" +
-	"\x04user\x18\x01 \x01(\v2\f.bff.v1.UserR\x04user\"O\n" +
-	"\x11UpdateUserRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
-	"\x06locale\x18\x03 \x01(\tR\x06locale\"6\n" +
-	"\x12UpdateUserResponse\x12 \n" +
-	"\x04user\x18\x01 \x01(\v2\f.bff.v1.UserR\x04user*f\n" +
-	"\bUserRole\x12\x19\n" +
-	"\x15USER_ROLE_UNSPECIFIED\x10\x00\x12\x13\n" +
-	"\x0fUSER_ROLE_ADMIN\x10\x01\x12\x14\n" +
-	"\x10USER_ROLE_MEMBER\x10\x02\x12\x14\n" +
-	"\x10USER_ROLE_VIEWER\x10\x032\x86\x02\n" +
-	"\vUserService\x12@\n" +
-	"\tListUsers\x12\x18.bff.v1.ListUsersRequest\x1a\x19.bff.v1.ListUsersResponse\x12:\n" +
-	"\aGetUser\x12\x16.bff.v1.GetUserRequest\x1a\x17.bff.v1.GetUserResponse\x124\n" +
-	"\x05GetMe\x12\x14.bff.v1.GetMeRequest\x1a\x15.bff.v1.GetMeResponse\x12C\n" +
+func (x *GetMyPreferencesRequest) Reset() {
+	*x = GetMyPreferencesRequest{}
+	mi := &file_bff_v1_users_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMyPreferencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMyPreferencesRequest) ProtoMessage() {}
+
+func (x *GetMyPreferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bff_v1_users_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMyPreferencesRequest.ProtoReflect.Descriptor instead.
+func (*GetMyPreferencesRequest) Descriptor() ([]byte, []int) {
+	return file_bff_v1_users_proto_rawDescGZIP(), []int{11}
+}
+
+type GetMyPreferencesResponse struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Preferences *structpb.Struct       `protobuf:"bytes,1,opt,name=preferences,proto3" json:"preferences,omitempty"`
+	// etag is the user etag; preference writes change it too.
+	Etag          string `protobuf:"bytes,2,opt,name=etag,proto3" json:"etag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMyPreferencesResponse) Reset() {
+	*x = GetMyPreferencesResponse{}
+	mi := &file_bff_v1_users_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMyPreferencesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMyPreferencesResponse) ProtoMessage() {}
+
+func (x *GetMyPreferencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bff_v1_users_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMyPreferencesResponse.ProtoReflect.Descriptor instead.
+func (*GetMyPreferencesResponse) Descriptor() ([]byte, []int) {
+	return file_bff_v1_users_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetMyPreferencesResponse) GetPreferences() *structpb.Struct {
+	if x != nil {
+		return x.Preferences
+	}
+	return nil
+}
+
+func (x *GetMyPreferencesResponse) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+// patch is applied with JSON merge-patch semantics (RFC 7386): keys set to
+// null are removed and all other keys are replaced.
+type UpdateMyPreferencesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Patch         *structpb.Struct       `protobuf:"bytes,1,opt,name=patch,proto3" json:"patch,omitempty"`
+	ExpectedEtag  string                 `protobuf:"bytes,2,opt,name=expected_etag,json=expectedEtag,proto3" json:"expected_etag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateMyPreferencesRequest) Reset() {
+	*x = UpdateMyPreferencesRequest{}
+	mi := &file_bff_v1_users_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMyPreferencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMyPreferencesRequest) ProtoMessage() {}
+
+func (x *UpdateMyPreferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bff_v1_users_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMyPreferencesRequest.ProtoReflect.Descriptor instead.
+func (*UpdateMyPreferencesRequest) Descriptor() ([]byte, []int) {
+	return file_bff_v1_users_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *UpdateMyPreferencesRequest) GetPatch() *structpb.Struct {
+	if x != nil {
+		return x.Patch
+	}
+	return nil
+}
+
+func (x *UpdateMyPreferencesRequest) GetExpectedEtag() string {
+	if x != nil {
+		return x.ExpectedEtag
+	}
+	return ""
+}
+
+type UpdateMyPreferencesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Preferences   *structpb.Struct       `protobuf:"bytes,1,opt,name=preferences,proto3" json:"preferences,omitempty"`
+	Etag          string                 `protobuf:"bytes,2,opt,name=etag,proto3" json:"etag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateMyPreferencesResponse) Reset() {
+	*x = UpdateMyPreferencesResponse{}
+	mi := &file_bff_v1_users_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMyPreferencesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMyPreferencesResponse) ProtoMessage() {}
+
+func (x *UpdateMyPreferencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bff_v1_users_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMyPreferencesResponse.ProtoReflect.Descriptor instead.
+func (*UpdateMyPreferencesResponse) Descriptor() ([]byte, []int) {
+	return file_bff_v1_users_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *UpdateMyPreferencesResponse) GetPreferences() *structpb.Struct {
+	if x != nil {
+		return x.Preferences
+	}
+	return nil
+}
+
+func (x *UpdateMyPreferencesResponse) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+// CreateUser adds a user ahead of their first sign-in, e.g. to grant a role
+// up front. The user is matched to their identity provider account by email.
+type CreateUserRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Email string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	// role defaults to USER_ROLE_MEMBER.
+	Role UserRole `protobuf:"varint,3,opt,name=role,proto3,enum=bff.v1.UserRole" json:"role,omitempty"`
+	// locale defaults to "en".
+	Locale        string `protobuf:"bytes,4,opt,name=locale,proto3" json:"locale,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateUserRequest) Reset() {
+	*x = CreateUserRequest{}
+	mi := &file_bff_v1_users_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateUserRequest) ProtoMessage() {}
+
+func (x *CreateUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bff_v1_users_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateUserRequest.ProtoReflect.Descriptor instead.
+func (*CreateUserRequest) Descriptor() ([]byte, []int) {
+	return file_bff_v1_users_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CreateUserRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateUserRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *CreateUserRequest) GetRole() UserRole {
+	if x != nil {
+		return x.Role
+	}
+	return UserRole_USER_ROLE_UNSPECIFIED
+}
+
+func (x *CreateUserRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+type CreateUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateUserResponse) Reset() {
+	*x = CreateUserResponse{}
+	mi := &file_bff_v1_users_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateUserResponse) ProtoMessage() {}
+
+func (x *CreateUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bff_v1_users_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateUserResponse.ProtoReflect.Descriptor instead.
+func (*CreateUserResponse) Descriptor() ([]byte, []int) {
+	return file_bff_v1_users_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CreateUserResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type UpdateUserRoleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Role          UserRole               `protobuf:"varint,2,opt,name=role,proto3,enum=bff.v1.UserRole" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateUserRoleRequest) Reset() {
+	*x = UpdateUserRoleRequest{}
+	mi := &file_bff_v1_users_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateUserRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserRoleRequest) ProtoMessage() {}
+
+func (x *UpdateUserRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bff_v1_users_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserRoleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateUserRoleRequest) Descriptor() ([]byte, []int) {
+	return file_bff_v1_users_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *UpdateUserRoleRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateUserRoleRequest) GetRole() UserRole {
+	if x != nil {
+		return x.Role
+	}
+	return UserRole_USER_ROLE_UNSPECIFIED
+}
+
+type UpdateUserRoleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateUserRoleResponse) Reset() {
+	*x = UpdateUserRoleResponse{}
+	mi := &file_bff_v1_users_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateUserRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserRoleResponse) ProtoMessage() {}
+
+func (x *UpdateUserRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bff_v1_users_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserRoleResponse.ProtoReflect.Descriptor instead.
+func (*UpdateUserRoleResponse) Descriptor() ([]byte, []int) {
+	return file_bff_v1_users_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *UpdateUserRoleResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+// DeactivateUser stops a user from signing in. Requests already in flight
+// finish, and cached access tokens stop working within a minute.
+type DeactivateUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeactivateUserRequest) Reset() {
+	*x = DeactivateUserRequest{}
+	mi := &file_bff_v1_users_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeactivateUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeactivateUserRequest) ProtoMessage() {}
+
+func (x *DeactivateUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bff_v1_users_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeactivateUserRequest.ProtoReflect.Descriptor instead.
+func (*DeactivateUserRequest) Descriptor() ([]byte, []int) {
+	return file_bff_v1_users_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *DeactivateUserRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeactivateUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeactivateUserResponse) Reset() {
+	*x = DeactivateUserResponse{}
+	mi := &file_bff_v1_users_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeactivateUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeactivateUserResponse) ProtoMessage() {}
+
+func (x *DeactivateUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bff_v1_users_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeactivateUserResponse.ProtoReflect.Descriptor instead.
+func (*DeactivateUserResponse) Descriptor() ([]byte, []int) {
+	return file_bff_v1_users_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *DeactivateUserResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type ReactivateUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReactivateUserRequest) Reset() {
+	*x = ReactivateUserRequest{}
+	mi := &file_bff_v1_users_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReactivateUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReactivateUserRequest) ProtoMessage() {}
+
+func (x *ReactivateUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bff_v1_users_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReactivateUserRequest.ProtoReflect.Descriptor instead.
+func (*ReactivateUserRequest) Descriptor() ([]byte, []int) {
+	return file_bff_v1_users_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ReactivateUserRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ReactivateUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReactivateUserResponse) Reset() {
+	*x = ReactivateUserResponse{}
+	mi := &file_bff_v1_users_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReactivateUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReactivateUserResponse) ProtoMessage() {}
+
+func (x *ReactivateUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bff_v1_users_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReactivateUserResponse.ProtoReflect.Descriptor instead.
+func (*ReactivateUserResponse) Descriptor() ([]byte, []int) {
+	return file_bff_v1_users_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ReactivateUserResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+// DeleteUser removes a user with their memberships and access tokens. It fails
+// with FAILED_PRECONDITION while the user is the only admin of a project;
+// deactivate the user instead to keep their history attached.
+type DeleteUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteUserRequest) Reset() {
+	*x = DeleteUserRequest{}
+	mi := &file_bff_v1_users_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserRequest) ProtoMessage() {}
+
+func (x *DeleteUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bff_v1_users_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUserRequest.ProtoReflect.Descriptor instead.
+func (*DeleteUserRequest) Descriptor() ([]byte, []int) {
+	return file_bff_v1_users_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *DeleteUserRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteUserResponse) Reset() {
+	*x = DeleteUserResponse{}
+	mi := &file_bff_v1_users_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserResponse) ProtoMessage() {}
+
+func (x *DeleteUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bff_v1_users_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUserResponse.ProtoReflect.Descriptor instead.
+func (*DeleteUserResponse) Descriptor() ([]byte, []int) {
+	return file_bff_v1_users_proto_rawDescGZIP(), []int{24}
+}
+
+var File_bff_v1_users_proto protoreflect.FileDescriptor
+
+const file_bff_v1_users_proto_rawDesc = "" +
+	"\n" +
+	"\x12bff/v1/users.proto\x12\x06bff.v1\x1a\x1bbuf/validate/validate.proto\x1a google/protobuf/field_mask.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xb4\x02\n" +
+	"\x04User\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12$\n" +
+	"\x04role\x18\x04 \x01(\x0e2\x10.bff.v1.UserRoleR\x04role\x12\x16\n" +
+	"\x06locale\x18\x05 \x01(\tR\x06locale\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x12\n" +
+	"\x04etag\x18\b \x01(\tR\x04etag\x12*\n" +
+	"\x06status\x18\t \x01(\x0e2\x12.bff.v1.UserStatusR\x06status\"\x95\x01\n" +
+	"\x10ListUsersRequest\x12\x1d\n" +
+	"\x04page\x18\x01 \x01(\x05B\t\xbaH\x04\x1a\x02(\x00\x18\x01R\x04page\x12$\n" +
+	"\tpage_size\x18\x02 \x01(\x05B\a\xbaH\x04\x1a\x02(\x00R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\x12\x1d\n" +
+	"\n" +
+	"skip_total\x18\x04 \x01(\bR\tskipTotal\"u\n" +
+	"\x11ListUsersResponse\x12\"\n" +
+	"\x05users\x18\x01 \x03(\v2\f.bff.v1.UserR\x05users\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12&\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageToken\"*\n" +
+	"\x0eGetUserRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"3\n" +
+	"\x0fGetUserResponse\x12 \n" +
+	"\x04user\x18\x01 \x01(\v2\f.bff.v1.UserR\x04user\"\x0e\n" +
+	"\fGetMeRequest\"1\n" +
+	"\rGetMeResponse\x12 \n" +
+	"\x04user\x18\x01 \x01(\v2\f.bff.v1.UserR\x04user\"\x14\n" +
+	"\x12GetMyAccessRequest\"u\n" +
+	"\x13GetMyAccessResponse\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12$\n" +
+	"\x04role\x18\x02 \x01(\x0e2\x10.bff.v1.UserRoleR\x04role\x12\x1f\n" +
+	"\vproject_ids\x18\x03 \x03(\tR\n" +
+	"projectIds\"\xce\x01\n" +
+	"\x11UpdateUserRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12\x1c\n" +
+	"\x04name\x18\x02 \x01(\tB\b\xbaH\x05r\x03\x18\xc8\x01R\x04name\x12\x1f\n" +
+	"\x06locale\x18\x03 \x01(\tB\a\xbaH\x04r\x02\x18#R\x06locale\x12;\n" +
+	"\vupdate_mask\x18\x04 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\x12#\n" +
+	"\rexpected_etag\x18\x05 \x01(\tR\fexpectedEtag\"6\n" +
+	"\x12UpdateUserResponse\x12 \n" +
+	"\x04user\x18\x01 \x01(\v2\f.bff.v1.UserR\x04user\"\x19\n" +
+	"\x17GetMyPreferencesRequest\"i\n" +
+	"\x18GetMyPreferencesResponse\x129\n" +
+	"\vpreferences\x18\x01 \x01(\v2\x17.google.protobuf.StructR\vpreferences\x12\x12\n" +
+	"\x04etag\x18\x02 \x01(\tR\x04etag\"x\n" +
+	"\x1aUpdateMyPreferencesRequest\x125\n" +
+	"\x05patch\x18\x01 \x01(\v2\x17.google.protobuf.StructB\x06\xbaH\x03\xc8\x01\x01R\x05patch\x12#\n" +
+	"\rexpected_etag\x18\x02 \x01(\tR\fexpectedEtag\"l\n" +
+	"\x1bUpdateMyPreferencesResponse\x129\n" +
+	"\vpreferences\x18\x01 \x01(\v2\x17.google.protobuf.StructR\vpreferences\x12\x12\n" +
+	"\x04etag\x18\x02 \x01(\tR\x04etag\"\xa5\x01\n" +
+	"\x11CreateUserRequest\x12 \n" +
+	"\x04name\x18\x01 \x01(\tB\f\xbaH\tr\a\x18\xc8\x012\x02\\SR\x04name\x12\x1d\n" +
+	"\x05email\x18\x02 \x01(\tB\a\xbaH\x04r\x02`\x01R\x05email\x12.\n" +
+	"\x04role\x18\x03 \x01(\x0e2\x10.bff.v1.UserRoleB\b\xbaH\x05\x82\x01\x02\x10\x01R\x04role\x12\x1f\n" +
+	"\x06locale\x18\x04 \x01(\tB\a\xbaH\x04r\x02\x18#R\x06locale\"6\n" +
+	"\x12CreateUserResponse\x12 \n" +
+	"\x04user\x18\x01 \x01(\v2\f.bff.v1.UserR\x04user\"c\n" +
+	"\x15UpdateUserRoleRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x120\n" +
+	"\x04role\x18\x02 \x01(\x0e2\x10.bff.v1.UserRoleB\n" +
+	"\xbaH\a\x82\x01\x04\x10\x01 \x00R\x04role\":\n" +
+	"\x16UpdateUserRoleResponse\x12 \n" +
+	"\x04user\x18\x01 \x01(\v2\f.bff.v1.UserR\x04user\"1\n" +
+	"\x15DeactivateUserRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\":\n" +
+	"\x16DeactivateUserResponse\x12 \n" +
+	"\x04user\x18\x01 \x01(\v2\f.bff.v1.UserR\x04user\"1\n" +
+	"\x15ReactivateUserRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\":\n" +
+	"\x16ReactivateUserResponse\x12 \n" +
+	"\x04user\x18\x01 \x01(\v2\f.bff.v1.UserR\x04user\"-\n" +
+	"\x11DeleteUserRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"\x14\n" +
+	"\x12DeleteUserResponse*f\n" +
+	"\bUserRole\x12\x19\n" +
+	"\x15USER_ROLE_UNSPECIFIED\x10\x00\x12\x13\n" +
+	"\x0fUSER_ROLE_ADMIN\x10\x01\x12\x14\n" +
+	"\x10USER_ROLE_MEMBER\x10\x02\x12\x14\n" +
+	"\x10USER_ROLE_VIEWER\x10\x03*^\n" +
+	"\n" +
+	"UserStatus\x12\x1b\n" +
+	"\x17USER_STATUS_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12USER_STATUS_ACTIVE\x10\x01\x12\x1b\n" +
+	"\x17USER_STATUS_DEACTIVATED\x10\x022\x82\a\n" +
+	"\vUserService\x12@\n" +
+	"\tListUsers\x12\x18.bff.v1.ListUsersRequest\x1a\x19.bff.v1.ListUsersResponse\x12:\n" +
+	"\aGetUser\x12\x16.bff.v1.GetUserRequest\x1a\x17.bff.v1.GetUserResponse\x124\n" +
+	"\x05GetMe\x12\x14.bff.v1.GetMeRequest\x1a\x15.bff.v1.GetMeResponse\x12F\n" +
+	"\vGetMyAccess\x12\x1a.bff.v1.GetMyAccessRequest\x1a\x1b.bff.v1.GetMyAccessResponse\x12C\n" +
+	"\n" +
+	"UpdateUser\x12\x19.bff.v1.UpdateUserRequest\x1a\x1a.bff.v1.UpdateUserResponse\x12U\n" +
+	"\x10GetMyPreferences\x12\x1f.bff.v1.GetMyPreferencesRequest\x1a .bff.v1.GetMyPreferencesResponse\x12^\n" +
+	"\x13UpdateMyPreferences\x12\".bff.v1.UpdateMyPreferencesRequest\x1a#.bff.v1.UpdateMyPreferencesResponse\x12C\n" +
+	"\n" +
+	"CreateUser\x12\x19.bff.v1.CreateUserRequest\x1a\x1a.bff.v1.CreateUserResponse\x12O\n" +
+	"\x0eUpdateUserRole\x12\x1d.bff.v1.UpdateUserRoleRequest\x1a\x1e.bff.v1.UpdateUserRoleResponse\x12O\n" +
+	"\x0eDeactivateUser\x12\x1d.bff.v1.DeactivateUserRequest\x1a\x1e.bff.v1.DeactivateUserResponse\x12O\n" +
+	"\x0eReactivateUser\x12\x1d.bff.v1.ReactivateUserRequest\x1a\x1e.bff.v1.ReactivateUserResponse\x12C\n" +
 	"\n" +
-	"UpdateUser\x12\x19.bff.v1.UpdateUserRequest\x1a\x1a.bff.v1.UpdateUserResponseBBZ@github.com/ApeironFoundation/axle/contracts/go/bff/v1;gen_bff_v1b\x06proto3"
+	"DeleteUser\x12\x19.bff.v1.DeleteUserRequest\x1a\x1a.bff.v1.DeleteUserResponseBBZ@github.com/ApeironFoundation/axle/contracts/go/bff/v1;gen_bff_v1b\x06proto3"
 
 var (
 	file_bff_v1_users_proto_rawDescOnce sync.Once
@@ -601,42 +1562,89 @@ func file_bff_v1_users_proto_rawDescGZIP() []byte {
 	return file_bff_v1_users_proto_rawDescData
 }
 
-var file_bff_v1_users_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_bff_v1_users_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_bff_v1_users_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_bff_v1_users_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
 var file_bff_v1_users_proto_goTypes = []any{
-	(UserRole)(0),                 // 0: bff.v1.UserRole
-	(*User)(nil),                  // 1: bff.v1.User
-	(*ListUsersRequest)(nil),      // 2: bff.v1.ListUsersRequest
-	(*ListUsersResponse)(nil),     // 3: bff.v1.ListUsersResponse
-	(*GetUserRequest)(nil),        // 4: bff.v1.GetUserRequest
-	(*GetUserResponse)(nil),       // 5: bff.v1.GetUserResponse
-	(*GetMeRequest)(nil),          // 6: bff.v1.GetMeRequest
-	(*GetMeResponse)(nil),         // 7: bff.v1.GetMeResponse
-	(*UpdateUserRequest)(nil),     // 8: bff.v1.UpdateUserRequest
-	(*UpdateUserResponse)(nil),    // 9: bff.v1.UpdateUserResponse
-	(*timestamppb.Timestamp)(nil), // 10: google.protobuf.Timestamp
+	(UserRole)(0),                       // 0: bff.v1.UserRole
+	(UserStatus)(0),                     // 1: bff.v1.UserStatus
+	(*User)(nil),                        // 2: bff.v1.User
+	(*ListUsersRequest)(nil),            // 3: bff.v1.ListUsersRequest
+	(*ListUsersResponse)(nil),           // 4: bff.v1.ListUsersResponse
+	(*GetUserRequest)(nil),              // 5: bff.v1.GetUserRequest
+	(*GetUserResponse)(nil),             // 6: bff.v1.GetUserResponse
+	(*GetMeRequest)(nil),                // 7: bff.v1.GetMeRequest
+	(*GetMeResponse)(nil),               // 8: bff.v1.GetMeResponse
+	(*GetMyAccessRequest)(nil),          // 9: bff.v1.GetMyAccessRequest
+	(*GetMyAccessResponse)(nil),         // 10: bff.v1.GetMyAccessResponse
+	(*UpdateUserRequest)(nil),           // 11: bff.v1.UpdateUserRequest
+	(*UpdateUserResponse)(nil),          // 12: bff.v1.UpdateUserResponse
+	(*GetMyPreferencesRequest)(nil),     // 13: bff.v1.GetMyPreferencesRequest
+	(*GetMyPreferencesResponse)(nil),    // 14: bff.v1.GetMyPreferencesResponse
+	(*UpdateMyPreferencesRequest)(nil),  // 15: bff.v1.UpdateMyPreferencesRequest
+	(*UpdateMyPreferencesResponse)(nil), // 16: bff.v1.UpdateMyPreferencesResponse
+	(*CreateUserRequest)(nil),           // 17: bff.v1.CreateUserRequest
+	(*CreateUserResponse)(nil),          // 18: bff.v1.CreateUserResponse
+	(*UpdateUserRoleRequest)(nil),       // 19: bff.v1.UpdateUserRoleRequest
+	(*UpdateUserRoleResponse)(nil),      // 20: bff.v1.UpdateUserRoleResponse
+	(*DeactivateUserRequest)(nil),       // 21: bff.v1.DeactivateUserRequest
+	(*DeactivateUserResponse)(nil),      // 22: bff.v1.DeactivateUserResponse
+	(*ReactivateUserRequest)(nil),       // 23: bff.v1.ReactivateUserRequest
+	(*ReactivateUserResponse)(nil),      // 24: bff.v1.ReactivateUserResponse
+	(*DeleteUserRequest)(nil),           // 25: bff.v1.DeleteUserRequest
+	(*DeleteUserResponse)(nil),          // 26: bff.v1.DeleteUserResponse
+	(*timestamppb.Timestamp)(nil),       // 27: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),       // 28: google.protobuf.FieldMask
+	(*structpb.Struct)(nil),             // 29: google.protobuf.Struct
 }
 var file_bff_v1_users_proto_depIdxs = []int32{
 	0,  // 0: bff.v1.User.role:type_name -> bff.v1.UserRole
-	10, // 1: bff.v1.User.created_at:type_name -> google.protobuf.Timestamp
-	10, // 2: bff.v1.User.updated_at:type_name -> google.protobuf.Timestamp
-	1,  // 3: bff.v1.ListUsersResponse.users:type_name -> bff.v1.User
-	1,  // 4: bff.v1.GetUserResponse.user:type_name -> bff.v1.User
-	1,  // 5: bff.v1.GetMeResponse.user:type_name -> bff.v1.User
-	1,  // 6: bff.v1.UpdateUserResponse.user:type_name -> bff.v1.User
-	2,  // 7: bff.v1.UserService.ListUsers:input_type -> bff.v1.ListUsersRequest
-	4,  // 8: bff.v1.UserService.GetUser:input_type -> bff.v1.GetUserRequest
-	6,  // 9: bff.v1.UserService.GetMe:input_type -> bff.v1.GetMeRequest
-	8,  // 10: bff.v1.UserService.UpdateUser:input_type -> bff.v1.UpdateUserRequest
-	3,  // 11: bff.v1.UserService.ListUsers:output_type -> bff.v1.ListUsersResponse
-	5,  // 12: bff.v1.UserService.GetUser:output_type -> bff.v1.GetUserResponse
-	7,  // 13: bff.v1.UserService.GetMe:output_type -> bff.v1.GetMeResponse
-	9,  // 14: bff.v1.UserService.UpdateUser:output_type -> bff.v1.UpdateUserResponse
-	11, // [11:15] is the sub-list for method output_type
-	7,  // [7:11] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+	27, // 1: bff.v1.User.created_at:type_name -> google.protobuf.Timestamp
+	27, // 2: bff.v1.User.updated_at:type_name -> google.protobuf.Timestamp
+	1,  // 3: bff.v1.User.status:type_name -> bff.v1.UserStatus
+	2,  // 4: bff.v1.ListUsersResponse.users:type_name -> bff.v1.User
+	2,  // 5: bff.v1.GetUserResponse.user:type_name -> bff.v1.User
+	2,  // 6: bff.v1.GetMeResponse.user:type_name -> bff.v1.User
+	0,  // 7: bff.v1.GetMyAccessResponse.role:type_name -> bff.v1.UserRole
+	28, // 8: bff.v1.UpdateUserRequest.update_mask:type_name -> google.protobuf.FieldMask
+	2,  // 9: bff.v1.UpdateUserResponse.user:type_name -> bff.v1.User
+	29, // 10: bff.v1.GetMyPreferencesResponse.preferences:type_name -> google.protobuf.Struct
+	29, // 11: bff.v1.UpdateMyPreferencesRequest.patch:type_name -> google.protobuf.Struct
+	29, // 12: bff.v1.UpdateMyPreferencesResponse.preferences:type_name -> google.protobuf.Struct
+	0,  // 13: bff.v1.CreateUserRequest.role:type_name -> bff.v1.UserRole
+	2,  // 14: bff.v1.CreateUserResponse.user:type_name -> bff.v1.User
+	0,  // 15: bff.v1.UpdateUserRoleRequest.role:type_name -> bff.v1.UserRole
+	2,  // 16: bff.v1.UpdateUserRoleResponse.user:type_name -> bff.v1.User
+	2,  // 17: bff.v1.DeactivateUserResponse.user:type_name -> bff.v1.User
+	2,  // 18: bff.v1.ReactivateUserResponse.user:type_name -> bff.v1.User
+	3,  // 19: bff.v1.UserService.ListUsers:input_type -> bff.v1.ListUsersRequest
+	5,  // 20: bff.v1.UserService.GetUser:input_type -> bff.v1.GetUserRequest
+	7,  // 21: bff.v1.UserService.GetMe:input_type -> bff.v1.GetMeRequest
+	9,  // 22: bff.v1.UserService.GetMyAccess:input_type -> bff.v1.GetMyAccessRequest
+	11, // 23: bff.v1.UserService.UpdateUser:input_type -> bff.v1.UpdateUserRequest
+	13, // 24: bff.v1.UserService.GetMyPreferences:input_type -> bff.v1.GetMyPreferencesRequest
+	15, // 25: bff.v1.UserService.UpdateMyPreferences:input_type -> bff.v1.UpdateMyPreferencesRequest
+	17, // 26: bff.v1.UserService.CreateUser:input_type -> bff.v1.CreateUserRequest
+	19, // 27: bff.v1.UserService.UpdateUserRole:input_type -> bff.v1.UpdateUserRoleRequest
+	21, // 28: bff.v1.UserService.DeactivateUser:input_type -> bff.v1.DeactivateUserRequest
+	23, // 29: bff.v1.UserService.ReactivateUser:input_type -> bff.v1.ReactivateUserRequest
+	25, // 30: bff.v1.UserService.DeleteUser:input_type -> bff.v1.DeleteUserRequest
+	4,  // 31: bff.v1.UserService.ListUsers:output_type -> bff.v1.ListUsersResponse
+	6,  // 32: bff.v1.UserService.GetUser:output_type -> bff.v1.GetUserResponse
+	8,  // 33: bff.v1.UserService.GetMe:output_type -> bff.v1.GetMeResponse
+	10, // 34: bff.v1.UserService.GetMyAccess:output_type -> bff.v1.GetMyAccessResponse
+	12, // 35: bff.v1.UserService.UpdateUser:output_type -> bff.v1.UpdateUserResponse
+	14, // 36: bff.v1.UserService.GetMyPreferences:output_type -> bff.v1.GetMyPreferencesResponse
+	16, // 37: bff.v1.UserService.UpdateMyPreferences:output_type -> bff.v1.UpdateMyPreferencesResponse
+	18, // 38: bff.v1.UserService.CreateUser:output_type -> bff.v1.CreateUserResponse
+	20, // 39: bff.v1.UserService.UpdateUserRole:output_type -> bff.v1.UpdateUserRoleResponse
+	22, // 40: bff.v1.UserService.DeactivateUser:output_type -> bff.v1.DeactivateUserResponse
+	24, // 41: bff.v1.UserService.ReactivateUser:output_type -> bff.v1.ReactivateUserResponse
+	26, // 42: bff.v1.UserService.DeleteUser:output_type -> bff.v1.DeleteUserResponse
+	31, // [31:43] is the sub-list for method output_type
+	19, // [19:31] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
 }
 
 func init() { file_bff_v1_users_proto_init() }
@@ -649,8 +1657,8 @@ func file_bff_v1_users_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_bff_v1_users_proto_rawDesc), len(file_bff_v1_users_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   9,
+			NumEnums:      2,
+			NumMessages:   25,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -7,6 +7,7 @@
 package gen_gateway_v1
 
 import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
@@ -26,31 +27,61 @@ const (
 type EventType int32
 
 const (
-	EventType_EVENT_TYPE_UNSPECIFIED  EventType = 0
-	EventType_EVENT_TYPE_TASK_CREATED EventType = 1
-	EventType_EVENT_TYPE_TASK_UPDATED EventType = 2
-	EventType_EVENT_TYPE_TASK_DELETED EventType = 3
-	EventType_EVENT_TYPE_AI_CHUNK     EventType = 4
-	EventType_EVENT_TYPE_AI_DONE      EventType = 5
+	EventType_EVENT_TYPE_UNSPECIFIED            EventType = 0
+	EventType_EVENT_TYPE_TASK_CREATED           EventType = 1
+	EventType_EVENT_TYPE_TASK_UPDATED           EventType = 2
+	EventType_EVENT_TYPE_TASK_DELETED           EventType = 3
+	EventType_EVENT_TYPE_AI_CHUNK               EventType = 4
+	EventType_EVENT_TYPE_AI_DONE                EventType = 5
+	EventType_EVENT_TYPE_PROJECT_CREATED        EventType = 6
+	EventType_EVENT_TYPE_PROJECT_UPDATED        EventType = 7
+	EventType_EVENT_TYPE_PROJECT_DELETED        EventType = 8
+	EventType_EVENT_TYPE_PROJECT_MEMBER_ADDED   EventType = 9
+	EventType_EVENT_TYPE_PROJECT_MEMBER_UPDATED EventType = 10
+	EventType_EVENT_TYPE_PROJECT_MEMBER_REMOVED EventType = 11
+	EventType_EVENT_TYPE_USER_UPDATED           EventType = 12
+	EventType_EVENT_TYPE_USER_CREATED           EventType = 13
+	EventType_EVENT_TYPE_USER_DELETED           EventType = 14
+	EventType_EVENT_TYPE_PROJECT_RESTORED       EventType = 15
 )
 
 // Enum value maps for EventType.
 var (
 	EventType_name = map[int32]string{
-		0: "EVENT_TYPE_UNSPECIFIED",
-		1: "EVENT_TYPE_TASK_CREATED",
-		2: "EVENT_TYPE_TASK_UPDATED",
-		3: "EVENT_TYPE_TASK_DELETED",
-		4: "EVENT_TYPE_AI_CHUNK",
-		5: "EVENT_TYPE_AI_DONE",
+		0:  "EVENT_TYPE_UNSPECIFIED",
+		1:  "EVENT_TYPE_TASK_CREATED",
+		2:  "EVENT_TYPE_TASK_UPDATED",
+		3:  "EVENT_TYPE_TASK_DELETED",
+		4:  "EVENT_TYPE_AI_CHUNK",
+		5:  "EVENT_TYPE_AI_DONE",
+		6:  "EVENT_TYPE_PROJECT_CREATED",
+		7:  "EVENT_TYPE_PROJECT_UPDATED",
+		8:  "EVENT_TYPE_PROJECT_DELETED",
+		9:  "EVENT_TYPE_PROJECT_MEMBER_ADDED",
+		10: "EVENT_TYPE_PROJECT_MEMBER_UPDATED",
+		11: "EVENT_TYPE_PROJECT_MEMBER_REMOVED",
+		12: "EVENT_TYPE_USER_UPDATED",
+		13: "EVENT_TYPE_USER_CREATED",
+		14: "EVENT_TYPE_USER_DELETED",
+		15: "EVENT_TYPE_PROJECT_RESTORED",
 	}
 	EventType_value = map[string]int32{
-		"EVENT_TYPE_UNSPECIFIED":  0,
-		"EVENT_TYPE_TASK_CREATED": 1,
-		"EVENT_TYPE_TASK_UPDATED": 2,
-		"EVENT_TYPE_TASK_DELETED": 3,
-		"EVENT_TYPE_AI_CHUNK":     4,
-		"EVENT_TYPE_AI_DONE":      5,
+		"EVENT_TYPE_UNSPECIFIED":            0,
+		"EVENT_TYPE_TASK_CREATED":           1,
+		"EVENT_TYPE_TASK_UPDATED":           2,
+		"EVENT_TYPE_TASK_DELETED":           3,
+		"EVENT_TYPE_AI_CHUNK":               4,
+		"EVENT_TYPE_AI_DONE":                5,
+		"EVENT_TYPE_PROJECT_CREATED":        6,
+		"EVENT_TYPE_PROJECT_UPDATED":        7,
+		"EVENT_TYPE_PROJECT_DELETED":        8,
+		"EVENT_TYPE_PROJECT_MEMBER_ADDED":   9,
+		"EVENT_TYPE_PROJECT_MEMBER_UPDATED": 10,
+		"EVENT_TYPE_PROJECT_MEMBER_REMOVED": 11,
+		"EVENT_TYPE_USER_UPDATED":           12,
+		"EVENT_TYPE_USER_CREATED":           13,
+		"EVENT_TYPE_USER_DELETED":           14,
+		"EVENT_TYPE_PROJECT_RESTORED":       15,
 	}
 )
 
@@ -81,14 +112,87 @@ func (EventType) EnumDescriptor() ([]byte, []int) {
 	return file_gateway_v1_streaming_proto_rawDescGZIP(), []int{0}
 }
 
-// Event is a single server-push event delivered to the frontend.
+// SlowConsumerPolicy decides what the Gateway does once a subscriber falls so
+// far behind that its buffer is full.
+type SlowConsumerPolicy int32
+
+const (
+	// The Gateway's configured default, DISCONNECT unless set otherwise.
+	SlowConsumerPolicy_SLOW_CONSUMER_POLICY_UNSPECIFIED SlowConsumerPolicy = 0
+	// End the stream with RESOURCE_EXHAUSTED. The error's Axle-Resume-After
+	// metadata holds the sequence to pass as resume_after, so resubscribing
+	// loses nothing.
+	SlowConsumerPolicy_SLOW_CONSUMER_POLICY_DISCONNECT SlowConsumerPolicy = 1
+	// Discard the oldest buffered event to make room.
+	SlowConsumerPolicy_SLOW_CONSUMER_POLICY_DROP_OLDEST SlowConsumerPolicy = 2
+	// Keep only the latest buffered *_UPDATED event per entity, then
+	// disconnect as DISCONNECT does once the buffer is full regardless.
+	SlowConsumerPolicy_SLOW_CONSUMER_POLICY_COALESCE SlowConsumerPolicy = 3
+)
+
+// Enum value maps for SlowConsumerPolicy.
+var (
+	SlowConsumerPolicy_name = map[int32]string{
+		0: "SLOW_CONSUMER_POLICY_UNSPECIFIED",
+		1: "SLOW_CONSUMER_POLICY_DISCONNECT",
+		2: "SLOW_CONSUMER_POLICY_DROP_OLDEST",
+		3: "SLOW_CONSUMER_POLICY_COALESCE",
+	}
+	SlowConsumerPolicy_value = map[string]int32{
+		"SLOW_CONSUMER_POLICY_UNSPECIFIED": 0,
+		"SLOW_CONSUMER_POLICY_DISCONNECT":  1,
+		"SLOW_CONSUMER_POLICY_DROP_OLDEST": 2,
+		"SLOW_CONSUMER_POLICY_COALESCE":    3,
+	}
+)
+
+func (x SlowConsumerPolicy) Enum() *SlowConsumerPolicy {
+	p := new(SlowConsumerPolicy)
+	*p = x
+	return p
+}
+
+func (x SlowConsumerPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SlowConsumerPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_gateway_v1_streaming_proto_enumTypes[1].Descriptor()
+}
+
+func (SlowConsumerPolicy) Type() protoreflect.EnumType {
+	return &file_gateway_v1_streaming_proto_enumTypes[1]
+}
+
+func (x SlowConsumerPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SlowConsumerPolicy.Descriptor instead.
+func (SlowConsumerPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_gateway_v1_streaming_proto_rawDescGZIP(), []int{1}
+}
+
+// Event is a single server-push event delivered to the frontend. The Gateway
+// checks each event against these rules as it reads it, and sends those that
+// break them to its dead-letter log instead of to subscribers.
 type Event struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Type          EventType              `protobuf:"varint,2,opt,name=type,proto3,enum=gateway.v1.EventType" json:"type,omitempty"`
-	ProjectId     string                 `protobuf:"bytes,3,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
-	Payload       []byte                 `protobuf:"bytes,4,opt,name=payload,proto3" json:"payload,omitempty"`
-	OccurredAt    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id is unique per event; delivery is at-least-once, so clients should
+	// ignore ids they have already seen.
+	Id        string    `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type      EventType `protobuf:"varint,2,opt,name=type,proto3,enum=gateway.v1.EventType" json:"type,omitempty"`
+	ProjectId string    `protobuf:"bytes,3,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	// payload is the serialized entity the event is about: an ai.v1.AITask for
+	// TASK_*, a bff.v1.Project for PROJECT_*, a bff.v1.ProjectMember for
+	// PROJECT_MEMBER_* and a bff.v1.User for USER_*. Delete and remove events
+	// carry only the identifying fields.
+	Payload    []byte                 `protobuf:"bytes,4,opt,name=payload,proto3" json:"payload,omitempty"`
+	OccurredAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	// sequence is the event's position in the Gateway's event log; it grows
+	// with every event. Pass the last one received as
+	// SubscribeRequest.resume_after when reconnecting.
+	Sequence      uint64 `protobuf:"varint,6,opt,name=sequence,proto3" json:"sequence,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -158,15 +262,31 @@ func (x *Event) GetOccurredAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Event) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
 // SubscribeRequest allows filtering events by project and type.
 type SubscribeRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// project_ids filters events; empty means all accessible projects.
 	ProjectIds []string `protobuf:"bytes,1,rep,name=project_ids,json=projectIds,proto3" json:"project_ids,omitempty"`
 	// event_types filters which event types to receive; empty means all.
-	EventTypes    []EventType `protobuf:"varint,2,rep,packed,name=event_types,json=eventTypes,proto3,enum=gateway.v1.EventType" json:"event_types,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	EventTypes []EventType `protobuf:"varint,2,rep,packed,name=event_types,json=eventTypes,proto3,enum=gateway.v1.EventType" json:"event_types,omitempty"`
+	// resume_after replays the matching events logged after this sequence
+	// before live delivery starts, so a reconnecting client misses none of
+	// them. 0 starts with live events. Events are kept for a limited time
+	// (24h by default); resuming from an expired sequence fails with
+	// OUT_OF_RANGE, and the client should reload its state and subscribe
+	// afresh.
+	ResumeAfter uint64 `protobuf:"varint,3,opt,name=resume_after,json=resumeAfter,proto3" json:"resume_after,omitempty"`
+	// slow_consumer_policy applies once this subscriber falls behind.
+	SlowConsumerPolicy SlowConsumerPolicy `protobuf:"varint,4,opt,name=slow_consumer_policy,json=slowConsumerPolicy,proto3,enum=gateway.v1.SlowConsumerPolicy" json:"slow_consumer_policy,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *SubscribeRequest) Reset() {
@@ -213,32 +333,65 @@ func (x *SubscribeRequest) GetEventTypes() []EventType {
 	return nil
 }
 
+func (x *SubscribeRequest) GetResumeAfter() uint64 {
+	if x != nil {
+		return x.ResumeAfter
+	}
+	return 0
+}
+
+func (x *SubscribeRequest) GetSlowConsumerPolicy() SlowConsumerPolicy {
+	if x != nil {
+		return x.SlowConsumerPolicy
+	}
+	return SlowConsumerPolicy_SLOW_CONSUMER_POLICY_UNSPECIFIED
+}
+
 var File_gateway_v1_streaming_proto protoreflect.FileDescriptor
 
 const file_gateway_v1_streaming_proto_rawDesc = "" +
 	"\n" +
 	"\x1agateway/v1/streaming.proto\x12\n" +
-	"gateway.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xb8\x01\n" +
-	"\x05Event\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12)\n" +
-	"\x04type\x18\x02 \x01(\x0e2\x15.gateway.v1.EventTypeR\x04type\x12\x1d\n" +
+	"gateway.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xef\x01\n" +
+	"\x05Event\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x02id\x123\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x15.gateway.v1.EventTypeB\b\xbaH\x05\x82\x01\x02\x10\x01R\x04type\x12\x1d\n" +
 	"\n" +
 	"project_id\x18\x03 \x01(\tR\tprojectId\x12\x18\n" +
-	"\apayload\x18\x04 \x01(\fR\apayload\x12;\n" +
-	"\voccurred_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
-	"occurredAt\"k\n" +
-	"\x10SubscribeRequest\x12\x1f\n" +
-	"\vproject_ids\x18\x01 \x03(\tR\n" +
-	"projectIds\x126\n" +
-	"\vevent_types\x18\x02 \x03(\x0e2\x15.gateway.v1.EventTypeR\n" +
-	"eventTypes*\xaf\x01\n" +
+	"\apayload\x18\x04 \x01(\fR\apayload\x12C\n" +
+	"\voccurred_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\n" +
+	"occurredAt\x12\x1a\n" +
+	"\bsequence\x18\x06 \x01(\x04R\bsequence\"\x8e\x02\n" +
+	"\x10SubscribeRequest\x122\n" +
+	"\vproject_ids\x18\x01 \x03(\tB\x11\xbaH\x0e\x92\x01\v\x10d\x18\x01\"\x05r\x03\xb0\x01\x01R\n" +
+	"projectIds\x12G\n" +
+	"\vevent_types\x18\x02 \x03(\x0e2\x15.gateway.v1.EventTypeB\x0f\xbaH\f\x92\x01\t\"\a\x82\x01\x04\x10\x01 \x00R\n" +
+	"eventTypes\x12!\n" +
+	"\fresume_after\x18\x03 \x01(\x04R\vresumeAfter\x12Z\n" +
+	"\x14slow_consumer_policy\x18\x04 \x01(\x0e2\x1e.gateway.v1.SlowConsumerPolicyB\b\xbaH\x05\x82\x01\x02\x10\x01R\x12slowConsumerPolicy*\xfa\x03\n" +
 	"\tEventType\x12\x1a\n" +
 	"\x16EVENT_TYPE_UNSPECIFIED\x10\x00\x12\x1b\n" +
 	"\x17EVENT_TYPE_TASK_CREATED\x10\x01\x12\x1b\n" +
 	"\x17EVENT_TYPE_TASK_UPDATED\x10\x02\x12\x1b\n" +
 	"\x17EVENT_TYPE_TASK_DELETED\x10\x03\x12\x17\n" +
 	"\x13EVENT_TYPE_AI_CHUNK\x10\x04\x12\x16\n" +
-	"\x12EVENT_TYPE_AI_DONE\x10\x052R\n" +
+	"\x12EVENT_TYPE_AI_DONE\x10\x05\x12\x1e\n" +
+	"\x1aEVENT_TYPE_PROJECT_CREATED\x10\x06\x12\x1e\n" +
+	"\x1aEVENT_TYPE_PROJECT_UPDATED\x10\a\x12\x1e\n" +
+	"\x1aEVENT_TYPE_PROJECT_DELETED\x10\b\x12#\n" +
+	"\x1fEVENT_TYPE_PROJECT_MEMBER_ADDED\x10\t\x12%\n" +
+	"!EVENT_TYPE_PROJECT_MEMBER_UPDATED\x10\n" +
+	"\x12%\n" +
+	"!EVENT_TYPE_PROJECT_MEMBER_REMOVED\x10\v\x12\x1b\n" +
+	"\x17EVENT_TYPE_USER_UPDATED\x10\f\x12\x1b\n" +
+	"\x17EVENT_TYPE_USER_CREATED\x10\r\x12\x1b\n" +
+	"\x17EVENT_TYPE_USER_DELETED\x10\x0e\x12\x1f\n" +
+	"\x1bEVENT_TYPE_PROJECT_RESTORED\x10\x0f*\xa8\x01\n" +
+	"\x12SlowConsumerPolicy\x12$\n" +
+	" SLOW_CONSUMER_POLICY_UNSPECIFIED\x10\x00\x12#\n" +
+	"\x1fSLOW_CONSUMER_POLICY_DISCONNECT\x10\x01\x12$\n" +
+	" SLOW_CONSUMER_POLICY_DROP_OLDEST\x10\x02\x12!\n" +
+	"\x1dSLOW_CONSUMER_POLICY_COALESCE\x10\x032R\n" +
 	"\x10StreamingService\x12>\n" +
 	"\tSubscribe\x12\x1c.gateway.v1.SubscribeRequest\x1a\x11.gateway.v1.Event0\x01BJZHgithub.com/ApeironFoundation/axle/contracts/go/gateway/v1;gen_gateway_v1b\x06proto3"
 
@@ -254,25 +407,27 @@ func file_gateway_v1_streaming_proto_rawDescGZIP() []byte {
 	return file_gateway_v1_streaming_proto_rawDescData
 }
 
-var file_gateway_v1_streaming_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_gateway_v1_streaming_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
 var file_gateway_v1_streaming_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
 var file_gateway_v1_streaming_proto_goTypes = []any{
 	(EventType)(0),                // 0: gateway.v1.EventType
-	(*Event)(nil),                 // 1: gateway.v1.Event
-	(*SubscribeRequest)(nil),      // 2: gateway.v1.SubscribeRequest
-	(*timestamppb.Timestamp)(nil), // 3: google.protobuf.Timestamp
+	(SlowConsumerPolicy)(0),       // 1: gateway.v1.SlowConsumerPolicy
+	(*Event)(nil),                 // 2: gateway.v1.Event
+	(*SubscribeRequest)(nil),      // 3: gateway.v1.SubscribeRequest
+	(*timestamppb.Timestamp)(nil), // 4: google.protobuf.Timestamp
 }
 var file_gateway_v1_streaming_proto_depIdxs = []int32{
 	0, // 0: gateway.v1.Event.type:type_name -> gateway.v1.EventType
-	3, // 1: gateway.v1.Event.occurred_at:type_name -> google.protobuf.Timestamp
+	4, // 1: gateway.v1.Event.occurred_at:type_name -> google.protobuf.Timestamp
 	0, // 2: gateway.v1.SubscribeRequest.event_types:type_name -> gateway.v1.EventType
-	2, // 3: gateway.v1.StreamingService.Subscribe:input_type -> gateway.v1.SubscribeRequest
-	1, // 4: gateway.v1.StreamingService.Subscribe:output_type -> gateway.v1.Event
-	4, // [4:5] is the sub-list for method output_type
-	3, // [3:4] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	1, // 3: gateway.v1.SubscribeRequest.slow_consumer_policy:type_name -> gateway.v1.SlowConsumerPolicy
+	3, // 4: gateway.v1.StreamingService.Subscribe:input_type -> gateway.v1.SubscribeRequest
+	2, // 5: gateway.v1.StreamingService.Subscribe:output_type -> gateway.v1.Event
+	5, // [5:6] is the sub-list for method output_type
+	4, // [4:5] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_gateway_v1_streaming_proto_init() }
@@ -285,7 +440,7 @@ func file_gateway_v1_streaming_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_gateway_v1_streaming_proto_rawDesc), len(file_gateway_v1_streaming_proto_rawDesc)),
-			NumEnums:      1,
+			NumEnums:      2,
 			NumMessages:   2,
 			NumExtensions: 0,
 			NumServices:   1,
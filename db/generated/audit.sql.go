@@ -0,0 +1,174 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: audit.sql
+
+package gen_db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const exportAuditEvents = `-- name: ExportAuditEvents :many
+SELECT id, occurred_at, actor_id, actor_token_id, procedure, entity_type, entity_id, changes, request_id, client_ip FROM audit_log
+WHERE ($1::uuid IS NULL OR actor_id = $1::uuid)
+  AND ($2::text IS NULL OR entity_type = $2::text)
+  AND ($3::uuid IS NULL OR entity_id = $3::uuid)
+  AND ($4::timestamptz IS NULL OR occurred_at >= $4::timestamptz)
+  AND ($5::timestamptz IS NULL OR occurred_at < $5::timestamptz)
+  AND ($6::timestamptz IS NULL
+       OR (occurred_at, id) > ($6::timestamptz, $7::uuid))
+ORDER BY occurred_at, id
+LIMIT $8
+`
+
+type ExportAuditEventsParams struct {
+	ActorID         pgtype.UUID        `json:"actor_id"`
+	EntityType      *string            `json:"entity_type"`
+	EntityID        pgtype.UUID        `json:"entity_id"`
+	Since           pgtype.Timestamptz `json:"since"`
+	Until           pgtype.Timestamptz `json:"until"`
+	AfterOccurredAt pgtype.Timestamptz `json:"after_occurred_at"`
+	AfterID         pgtype.UUID        `json:"after_id"`
+	Limit           int32              `json:"limit"`
+}
+
+// Oldest first, for incremental pulls. The after_* cursor continues above the
+// last row of the previous batch.
+func (q *Queries) ExportAuditEvents(ctx context.Context, arg ExportAuditEventsParams) ([]AuditLog, error) {
+	rows, err := q.db.Query(ctx, exportAuditEvents,
+		arg.ActorID,
+		arg.EntityType,
+		arg.EntityID,
+		arg.Since,
+		arg.Until,
+		arg.AfterOccurredAt,
+		arg.AfterID,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AuditLog
+	for rows.Next() {
+		var i AuditLog
+		if err := rows.Scan(
+			&i.ID,
+			&i.OccurredAt,
+			&i.ActorID,
+			&i.ActorTokenID,
+			&i.Procedure,
+			&i.EntityType,
+			&i.EntityID,
+			&i.Changes,
+			&i.RequestID,
+			&i.ClientIP,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const insertAuditEvent = `-- name: InsertAuditEvent :exec
+INSERT INTO audit_log (actor_id, actor_token_id, procedure, entity_type, entity_id, changes, request_id, client_ip)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+`
+
+type InsertAuditEventParams struct {
+	ActorID      pgtype.UUID `json:"actor_id"`
+	ActorTokenID pgtype.UUID `json:"actor_token_id"`
+	Procedure    string      `json:"procedure"`
+	EntityType   string      `json:"entity_type"`
+	EntityID     pgtype.UUID `json:"entity_id"`
+	Changes      []byte      `json:"changes"`
+	RequestID    string      `json:"request_id"`
+	ClientIP     string      `json:"client_ip"`
+}
+
+func (q *Queries) InsertAuditEvent(ctx context.Context, arg InsertAuditEventParams) error {
+	_, err := q.db.Exec(ctx, insertAuditEvent,
+		arg.ActorID,
+		arg.ActorTokenID,
+		arg.Procedure,
+		arg.EntityType,
+		arg.EntityID,
+		arg.Changes,
+		arg.RequestID,
+		arg.ClientIP,
+	)
+	return err
+}
+
+const listAuditEvents = `-- name: ListAuditEvents :many
+SELECT id, occurred_at, actor_id, actor_token_id, procedure, entity_type, entity_id, changes, request_id, client_ip FROM audit_log
+WHERE ($1::uuid IS NULL OR actor_id = $1::uuid)
+  AND ($2::text IS NULL OR entity_type = $2::text)
+  AND ($3::uuid IS NULL OR entity_id = $3::uuid)
+  AND ($4::timestamptz IS NULL OR occurred_at >= $4::timestamptz)
+  AND ($5::timestamptz IS NULL OR occurred_at < $5::timestamptz)
+  AND ($6::timestamptz IS NULL
+       OR (occurred_at, id) < ($6::timestamptz, $7::uuid))
+ORDER BY occurred_at DESC, id DESC
+LIMIT $8
+`
+
+type ListAuditEventsParams struct {
+	ActorID         pgtype.UUID        `json:"actor_id"`
+	EntityType      *string            `json:"entity_type"`
+	EntityID        pgtype.UUID        `json:"entity_id"`
+	Since           pgtype.Timestamptz `json:"since"`
+	Until           pgtype.Timestamptz `json:"until"`
+	AfterOccurredAt pgtype.Timestamptz `json:"after_occurred_at"`
+	AfterID         pgtype.UUID        `json:"after_id"`
+	Limit           int32              `json:"limit"`
+}
+
+// Newest first. The after_* cursor continues below the last row of the
+// previous page.
+func (q *Queries) ListAuditEvents(ctx context.Context, arg ListAuditEventsParams) ([]AuditLog, error) {
+	rows, err := q.db.Query(ctx, listAuditEvents,
+		arg.ActorID,
+		arg.EntityType,
+		arg.EntityID,
+		arg.Since,
+		arg.Until,
+		arg.AfterOccurredAt,
+		arg.AfterID,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AuditLog
+	for rows.Next() {
+		var i AuditLog
+		if err := rows.Scan(
+			&i.ID,
+			&i.OccurredAt,
+			&i.ActorID,
+			&i.ActorTokenID,
+			&i.Procedure,
+			&i.EntityType,
+			&i.EntityID,
+			&i.Changes,
+			&i.RequestID,
+			&i.ClientIP,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -39,10 +39,35 @@ func (q *Queries) AddProjectMember(ctx context.Context, arg AddProjectMemberPara
 
 const countProjects = `-- name: CountProjects :one
 SELECT COUNT(*) FROM projects
+WHERE deleted_at IS NULL
+  AND ($1::text IS NULL
+       OR project_search_vector(name, description) @@ websearch_to_tsquery('english', $1::text))
+  AND ($2::project_status IS NULL OR status = $2::project_status)
+  AND ($3::text IS NULL OR name ILIKE $3::text)
+  AND ($4::uuid IS NULL OR EXISTS (
+        SELECT 1 FROM project_members pm
+        WHERE pm.project_id = projects.id AND pm.user_id = $4::uuid))
+  AND ($5::timestamptz IS NULL OR updated_at >= $5::timestamptz)
 `
 
-func (q *Queries) CountProjects(ctx context.Context) (int64, error) {
-	row := q.db.QueryRow(ctx, countProjects)
+type CountProjectsParams struct {
+	Query        *string            `json:"query"`
+	Status       NullProjectStatus  `json:"status"`
+	NamePattern  *string            `json:"name_pattern"`
+	MemberUserID pgtype.UUID        `json:"member_user_id"`
+	UpdatedSince pgtype.Timestamptz `json:"updated_since"`
+}
+
+// Accepts the ListProjects filters plus an optional full-text query, so it also
+// totals SearchProjects results.
+func (q *Queries) CountProjects(ctx context.Context, arg CountProjectsParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countProjects,
+		arg.Query,
+		arg.Status,
+		arg.NamePattern,
+		arg.MemberUserID,
+		arg.UpdatedSince,
+	)
 	var count int64
 	err := row.Scan(&count)
 	return count, err
@@ -51,7 +76,7 @@ func (q *Queries) CountProjects(ctx context.Context) (int64, error) {
 const createProject = `-- name: CreateProject :one
 INSERT INTO projects (name, description)
 VALUES ($1, $2)
-RETURNING id, name, description, status, settings, created_at, updated_at
+RETURNING id, name, description, status, settings, created_at, updated_at, version, deleted_at
 `
 
 type CreateProjectParams struct {
@@ -70,23 +95,19 @@ func (q *Queries) CreateProject(ctx context.Context, arg CreateProjectParams) (P
 		&i.Settings,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Version,
+		&i.DeletedAt,
 	)
 	return i, err
 }
 
-const deleteProject = `-- name: DeleteProject :exec
-DELETE FROM projects WHERE id = $1
-`
-
-func (q *Queries) DeleteProject(ctx context.Context, id pgtype.UUID) error {
-	_, err := q.db.Exec(ctx, deleteProject, id)
-	return err
-}
-
 const getProject = `-- name: GetProject :one
-SELECT id, name, description, status, settings, created_at, updated_at FROM projects WHERE id = $1 LIMIT 1
+
+SELECT id, name, description, status, settings, created_at, updated_at, version, deleted_at FROM projects WHERE id = $1 AND deleted_at IS NULL LIMIT 1
 `
 
+// Trashed projects (deleted_at set) are invisible to every query that does not
+// mention the trash.
 func (q *Queries) GetProject(ctx context.Context, id pgtype.UUID) (Project, error) {
 	row := q.db.QueryRow(ctx, getProject, id)
 	var i Project
@@ -98,15 +119,111 @@ func (q *Queries) GetProject(ctx context.Context, id pgtype.UUID) (Project, erro
 		&i.Settings,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Version,
+		&i.DeletedAt,
 	)
 	return i, err
 }
 
+const getProjectMember = `-- name: GetProjectMember :one
+SELECT pm.id, pm.project_id, pm.user_id, pm.role, pm.joined_at, u.name AS user_name, u.email AS user_email
+FROM project_members pm
+JOIN users u ON u.id = pm.user_id
+WHERE pm.project_id = $1 AND pm.user_id = $2
+LIMIT 1
+`
+
+type GetProjectMemberParams struct {
+	ProjectID pgtype.UUID `json:"project_id"`
+	UserID    pgtype.UUID `json:"user_id"`
+}
+
+type GetProjectMemberRow struct {
+	ID        pgtype.UUID        `json:"id"`
+	ProjectID pgtype.UUID        `json:"project_id"`
+	UserID    pgtype.UUID        `json:"user_id"`
+	Role      UserRole           `json:"role"`
+	JoinedAt  pgtype.Timestamptz `json:"joined_at"`
+	UserName  string             `json:"user_name"`
+	UserEmail string             `json:"user_email"`
+}
+
+func (q *Queries) GetProjectMember(ctx context.Context, arg GetProjectMemberParams) (GetProjectMemberRow, error) {
+	row := q.db.QueryRow(ctx, getProjectMember, arg.ProjectID, arg.UserID)
+	var i GetProjectMemberRow
+	err := row.Scan(
+		&i.ID,
+		&i.ProjectID,
+		&i.UserID,
+		&i.Role,
+		&i.JoinedAt,
+		&i.UserName,
+		&i.UserEmail,
+	)
+	return i, err
+}
+
+const getProjectMemberRole = `-- name: GetProjectMemberRole :one
+SELECT role FROM project_members
+WHERE project_id = $1 AND user_id = $2
+`
+
+type GetProjectMemberRoleParams struct {
+	ProjectID pgtype.UUID `json:"project_id"`
+	UserID    pgtype.UUID `json:"user_id"`
+}
+
+func (q *Queries) GetProjectMemberRole(ctx context.Context, arg GetProjectMemberRoleParams) (UserRole, error) {
+	row := q.db.QueryRow(ctx, getProjectMemberRole, arg.ProjectID, arg.UserID)
+	var role UserRole
+	err := row.Scan(&role)
+	return role, err
+}
+
+const getProjectVersion = `-- name: GetProjectVersion :one
+SELECT version FROM projects WHERE id = $1
+`
+
+func (q *Queries) GetProjectVersion(ctx context.Context, id pgtype.UUID) (int64, error) {
+	row := q.db.QueryRow(ctx, getProjectVersion, id)
+	var version int64
+	err := row.Scan(&version)
+	return version, err
+}
+
+const listMemberProjectIDs = `-- name: ListMemberProjectIDs :many
+SELECT pm.project_id FROM project_members pm
+JOIN projects p ON p.id = pm.project_id
+WHERE pm.user_id = $1 AND p.deleted_at IS NULL
+ORDER BY pm.project_id
+`
+
+func (q *Queries) ListMemberProjectIDs(ctx context.Context, userID pgtype.UUID) ([]pgtype.UUID, error) {
+	rows, err := q.db.Query(ctx, listMemberProjectIDs, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []pgtype.UUID
+	for rows.Next() {
+		var project_id pgtype.UUID
+		if err := rows.Scan(&project_id); err != nil {
+			return nil, err
+		}
+		items = append(items, project_id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listProjectMembers = `-- name: ListProjectMembers :many
 SELECT pm.id, pm.project_id, pm.user_id, pm.role, pm.joined_at, u.name AS user_name, u.email AS user_email
 FROM project_members pm
 JOIN users u ON u.id = pm.user_id
 WHERE pm.project_id = $1
+ORDER BY pm.joined_at, pm.id
 `
 
 type ListProjectMembersRow struct {
@@ -148,18 +265,248 @@ func (q *Queries) ListProjectMembers(ctx context.Context, projectID pgtype.UUID)
 }
 
 const listProjects = `-- name: ListProjects :many
-SELECT id, name, description, status, settings, created_at, updated_at FROM projects
-ORDER BY created_at DESC
-LIMIT $1 OFFSET $2
+SELECT id, name, description, status, settings, created_at, updated_at, version, deleted_at FROM projects
+WHERE deleted_at IS NULL
+  AND ($1::project_status IS NULL OR status = $1::project_status)
+  AND ($2::text IS NULL OR name ILIKE $2::text)
+  AND ($3::uuid IS NULL OR EXISTS (
+        SELECT 1 FROM project_members pm
+        WHERE pm.project_id = projects.id AND pm.user_id = $3::uuid))
+  AND ($4::timestamptz IS NULL OR updated_at >= $4::timestamptz)
+  AND ($5::timestamptz IS NULL
+       OR (created_at, id) < ($5::timestamptz, $6::uuid))
+ORDER BY created_at DESC, id DESC
+LIMIT $8 OFFSET $7
 `
 
 type ListProjectsParams struct {
-	Limit  int32 `json:"limit"`
-	Offset int32 `json:"offset"`
+	Status         NullProjectStatus  `json:"status"`
+	NamePattern    *string            `json:"name_pattern"`
+	MemberUserID   pgtype.UUID        `json:"member_user_id"`
+	UpdatedSince   pgtype.Timestamptz `json:"updated_since"`
+	AfterCreatedAt pgtype.Timestamptz `json:"after_created_at"`
+	AfterID        pgtype.UUID        `json:"after_id"`
+	Offset         int32              `json:"offset"`
+	Limit          int32              `json:"limit"`
 }
 
+// Keyset pagination over (created_at, id); the after_* cursor is NULL on the
+// first page. OFFSET is only non-zero for legacy page-number requests.
+// Every filter is optional: a NULL argument disables it.
 func (q *Queries) ListProjects(ctx context.Context, arg ListProjectsParams) ([]Project, error) {
-	rows, err := q.db.Query(ctx, listProjects, arg.Limit, arg.Offset)
+	rows, err := q.db.Query(ctx, listProjects,
+		arg.Status,
+		arg.NamePattern,
+		arg.MemberUserID,
+		arg.UpdatedSince,
+		arg.AfterCreatedAt,
+		arg.AfterID,
+		arg.Offset,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Project
+	for rows.Next() {
+		var i Project
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Description,
+			&i.Status,
+			&i.Settings,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Version,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTrashedProjects = `-- name: ListTrashedProjects :many
+SELECT id, name, description, status, settings, created_at, updated_at, version, deleted_at FROM projects
+WHERE deleted_at IS NOT NULL
+  AND ($1::uuid IS NULL OR EXISTS (
+        SELECT 1 FROM project_members pm
+        WHERE pm.project_id = projects.id AND pm.user_id = $1::uuid AND pm.role = 'admin'))
+  AND ($2::timestamptz IS NULL
+       OR (deleted_at, id) < ($2::timestamptz, $3::uuid))
+ORDER BY deleted_at DESC, id DESC
+LIMIT $4
+`
+
+type ListTrashedProjectsParams struct {
+	AdminUserID    pgtype.UUID        `json:"admin_user_id"`
+	AfterDeletedAt pgtype.Timestamptz `json:"after_deleted_at"`
+	AfterID        pgtype.UUID        `json:"after_id"`
+	Limit          int32              `json:"limit"`
+}
+
+// Most recently trashed first, keyset-paginated over (deleted_at, id). A
+// non-NULL admin_user_id limits the list to projects that user administers.
+func (q *Queries) ListTrashedProjects(ctx context.Context, arg ListTrashedProjectsParams) ([]Project, error) {
+	rows, err := q.db.Query(ctx, listTrashedProjects,
+		arg.AdminUserID,
+		arg.AfterDeletedAt,
+		arg.AfterID,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Project
+	for rows.Next() {
+		var i Project
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Description,
+			&i.Status,
+			&i.Settings,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Version,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const lockProject = `-- name: LockProject :one
+SELECT id, name, description, status, settings, created_at, updated_at, version, deleted_at FROM projects WHERE id = $1 AND deleted_at IS NULL FOR UPDATE
+`
+
+func (q *Queries) LockProject(ctx context.Context, id pgtype.UUID) (Project, error) {
+	row := q.db.QueryRow(ctx, lockProject, id)
+	var i Project
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Description,
+		&i.Status,
+		&i.Settings,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Version,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const lockProjectAdmins = `-- name: LockProjectAdmins :many
+SELECT user_id FROM project_members
+WHERE project_id = $1 AND role = 'admin'
+FOR UPDATE
+`
+
+// Locks the admin memberships of a project so that concurrent demotions or
+// removals cannot leave it without an admin.
+func (q *Queries) LockProjectAdmins(ctx context.Context, projectID pgtype.UUID) ([]pgtype.UUID, error) {
+	rows, err := q.db.Query(ctx, lockProjectAdmins, projectID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []pgtype.UUID
+	for rows.Next() {
+		var user_id pgtype.UUID
+		if err := rows.Scan(&user_id); err != nil {
+			return nil, err
+		}
+		items = append(items, user_id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const lockProjectForShare = `-- name: LockProjectForShare :one
+SELECT id FROM projects WHERE id = $1 AND deleted_at IS NULL FOR SHARE
+`
+
+// Keeps the project from being trashed until the transaction ends, while
+// other changes to its members go ahead.
+func (q *Queries) LockProjectForShare(ctx context.Context, id pgtype.UUID) (pgtype.UUID, error) {
+	row := q.db.QueryRow(ctx, lockProjectForShare, id)
+	err := row.Scan(&id)
+	return id, err
+}
+
+const lockProjectSettings = `-- name: LockProjectSettings :one
+SELECT settings, version FROM projects WHERE id = $1 AND deleted_at IS NULL FOR UPDATE
+`
+
+type LockProjectSettingsRow struct {
+	Settings []byte `json:"settings"`
+	Version  int64  `json:"version"`
+}
+
+func (q *Queries) LockProjectSettings(ctx context.Context, id pgtype.UUID) (LockProjectSettingsRow, error) {
+	row := q.db.QueryRow(ctx, lockProjectSettings, id)
+	var i LockProjectSettingsRow
+	err := row.Scan(&i.Settings, &i.Version)
+	return i, err
+}
+
+const lockTrashedProject = `-- name: LockTrashedProject :one
+SELECT id, name, description, status, settings, created_at, updated_at, version, deleted_at FROM projects WHERE id = $1 AND deleted_at IS NOT NULL FOR UPDATE
+`
+
+func (q *Queries) LockTrashedProject(ctx context.Context, id pgtype.UUID) (Project, error) {
+	row := q.db.QueryRow(ctx, lockTrashedProject, id)
+	var i Project
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Description,
+		&i.Status,
+		&i.Settings,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Version,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const purgeTrashedProjects = `-- name: PurgeTrashedProjects :many
+DELETE FROM projects
+WHERE id IN (
+    SELECT t.id FROM projects t
+    WHERE t.deleted_at < $1::timestamptz
+    ORDER BY t.deleted_at, t.id
+    LIMIT $2
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING id, name, description, status, settings, created_at, updated_at, version, deleted_at
+`
+
+type PurgeTrashedProjectsParams struct {
+	TrashedBefore pgtype.Timestamptz `json:"trashed_before"`
+	Limit         int32              `json:"limit"`
+}
+
+// Hard-deletes up to limit projects trashed before the cutoff. SKIP LOCKED
+// leaves projects being restored alone and lets several purgers share the work.
+func (q *Queries) PurgeTrashedProjects(ctx context.Context, arg PurgeTrashedProjectsParams) ([]Project, error) {
+	rows, err := q.db.Query(ctx, purgeTrashedProjects, arg.TrashedBefore, arg.Limit)
 	if err != nil {
 		return nil, err
 	}
@@ -175,6 +522,8 @@ func (q *Queries) ListProjects(ctx context.Context, arg ListProjectsParams) ([]P
 			&i.Settings,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.Version,
+			&i.DeletedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -201,30 +550,224 @@ func (q *Queries) RemoveProjectMember(ctx context.Context, arg RemoveProjectMemb
 	return err
 }
 
+const restoreProject = `-- name: RestoreProject :one
+UPDATE projects
+SET deleted_at = NULL, version = version + 1, updated_at = NOW()
+WHERE id = $1
+RETURNING id, name, description, status, settings, created_at, updated_at, version, deleted_at
+`
+
+func (q *Queries) RestoreProject(ctx context.Context, id pgtype.UUID) (Project, error) {
+	row := q.db.QueryRow(ctx, restoreProject, id)
+	var i Project
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Description,
+		&i.Status,
+		&i.Settings,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Version,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const searchProjects = `-- name: SearchProjects :many
+WITH matches AS (
+    SELECT projects.id, projects.name, projects.description, projects.status, projects.settings, projects.created_at, projects.updated_at, projects.version, projects.deleted_at,
+           ts_rank(project_search_vector(name, description), websearch_to_tsquery('english', $1))::real AS rank
+    FROM projects
+    WHERE deleted_at IS NULL
+      AND project_search_vector(name, description) @@ websearch_to_tsquery('english', $1)
+      AND ($2::project_status IS NULL OR status = $2::project_status)
+      AND ($3::text IS NULL OR name ILIKE $3::text)
+      AND ($4::uuid IS NULL OR EXISTS (
+            SELECT 1 FROM project_members pm
+            WHERE pm.project_id = projects.id AND pm.user_id = $4::uuid))
+      AND ($5::timestamptz IS NULL OR updated_at >= $5::timestamptz)
+), page AS (
+    SELECT id, name, description, status, settings, created_at, updated_at, version, deleted_at, rank FROM matches
+    WHERE $6::real IS NULL
+       OR (rank, created_at, id) < ($6::real, $7::timestamptz, $8::uuid)
+    ORDER BY rank DESC, created_at DESC, id DESC
+    LIMIT $10 OFFSET $9
+)
+SELECT page.id, page.name, page.description, page.status, page.settings, page.created_at, page.updated_at, page.version, page.rank,
+       ts_headline('english', page.name, websearch_to_tsquery('english', $1),
+                   'HighlightAll=true, StartSel=' || chr(2) || ', StopSel=' || chr(3))::text AS name_highlight,
+       ts_headline('english', page.description, websearch_to_tsquery('english', $1),
+                   'MaxFragments=2, MaxWords=30, MinWords=10, StartSel=' || chr(2) || ', StopSel=' || chr(3))::text AS description_highlight
+FROM page
+ORDER BY page.rank DESC, page.created_at DESC, page.id DESC
+`
+
+type SearchProjectsParams struct {
+	Query          string             `json:"query"`
+	Status         NullProjectStatus  `json:"status"`
+	NamePattern    *string            `json:"name_pattern"`
+	MemberUserID   pgtype.UUID        `json:"member_user_id"`
+	UpdatedSince   pgtype.Timestamptz `json:"updated_since"`
+	AfterRank      *float32           `json:"after_rank"`
+	AfterCreatedAt pgtype.Timestamptz `json:"after_created_at"`
+	AfterID        pgtype.UUID        `json:"after_id"`
+	Offset         int32              `json:"offset"`
+	Limit          int32              `json:"limit"`
+}
+
+type SearchProjectsRow struct {
+	ID                   pgtype.UUID        `json:"id"`
+	Name                 string             `json:"name"`
+	Description          string             `json:"description"`
+	Status               ProjectStatus      `json:"status"`
+	Settings             []byte             `json:"settings"`
+	CreatedAt            pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt            pgtype.Timestamptz `json:"updated_at"`
+	Version              int64              `json:"version"`
+	Rank                 float32            `json:"rank"`
+	NameHighlight        string             `json:"name_highlight"`
+	DescriptionHighlight string             `json:"description_highlight"`
+}
+
+// Full-text search ranked by relevance, paginated over (rank, created_at, id).
+// Highlights wrap matches in \x02…\x03 so the caller can escape the text
+// before turning the markers into markup.
+func (q *Queries) SearchProjects(ctx context.Context, arg SearchProjectsParams) ([]SearchProjectsRow, error) {
+	rows, err := q.db.Query(ctx, searchProjects,
+		arg.Query,
+		arg.Status,
+		arg.NamePattern,
+		arg.MemberUserID,
+		arg.UpdatedSince,
+		arg.AfterRank,
+		arg.AfterCreatedAt,
+		arg.AfterID,
+		arg.Offset,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SearchProjectsRow
+	for rows.Next() {
+		var i SearchProjectsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Description,
+			&i.Status,
+			&i.Settings,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Version,
+			&i.Rank,
+			&i.NameHighlight,
+			&i.DescriptionHighlight,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const setProjectSettings = `-- name: SetProjectSettings :one
+UPDATE projects
+SET settings = $2, version = version + 1, updated_at = NOW()
+WHERE id = $1
+RETURNING settings, version
+`
+
+type SetProjectSettingsParams struct {
+	ID       pgtype.UUID `json:"id"`
+	Settings []byte      `json:"settings"`
+}
+
+type SetProjectSettingsRow struct {
+	Settings []byte `json:"settings"`
+	Version  int64  `json:"version"`
+}
+
+func (q *Queries) SetProjectSettings(ctx context.Context, arg SetProjectSettingsParams) (SetProjectSettingsRow, error) {
+	row := q.db.QueryRow(ctx, setProjectSettings, arg.ID, arg.Settings)
+	var i SetProjectSettingsRow
+	err := row.Scan(&i.Settings, &i.Version)
+	return i, err
+}
+
+const trashProject = `-- name: TrashProject :one
+UPDATE projects
+SET deleted_at = NOW(), version = version + 1, updated_at = NOW()
+WHERE id = $1 AND deleted_at IS NULL
+  AND ($2::bigint IS NULL OR version = $2::bigint)
+RETURNING id, name, description, status, settings, created_at, updated_at, version, deleted_at
+`
+
+type TrashProjectParams struct {
+	ID              pgtype.UUID `json:"id"`
+	ExpectedVersion *int64      `json:"expected_version"`
+}
+
+// Like UpdateProject, returns no row when expected_version is stale.
+func (q *Queries) TrashProject(ctx context.Context, arg TrashProjectParams) (Project, error) {
+	row := q.db.QueryRow(ctx, trashProject, arg.ID, arg.ExpectedVersion)
+	var i Project
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Description,
+		&i.Status,
+		&i.Settings,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Version,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
 const updateProject = `-- name: UpdateProject :one
 UPDATE projects
 SET
-    name        = COALESCE($2, name),
-    description = COALESCE($3, description),
-    status      = COALESCE($4::project_status, status),
+    name        = CASE WHEN $1::boolean THEN $2::text ELSE name END,
+    description = CASE WHEN $3::boolean THEN $4::text ELSE description END,
+    status      = CASE WHEN $5::boolean THEN $6::project_status ELSE status END,
+    version     = version + 1,
     updated_at  = NOW()
-WHERE id = $1
-RETURNING id, name, description, status, settings, created_at, updated_at
+WHERE id = $7 AND deleted_at IS NULL
+  AND ($8::bigint IS NULL OR version = $8::bigint)
+RETURNING id, name, description, status, settings, created_at, updated_at, version, deleted_at
 `
 
 type UpdateProjectParams struct {
-	ID          pgtype.UUID       `json:"id"`
-	Name        *string           `json:"name"`
-	Description *string           `json:"description"`
-	Status      NullProjectStatus `json:"status"`
+	SetName         bool              `json:"set_name"`
+	Name            string            `json:"name"`
+	SetDescription  bool              `json:"set_description"`
+	Description     string            `json:"description"`
+	SetStatus       bool              `json:"set_status"`
+	Status          NullProjectStatus `json:"status"`
+	ID              pgtype.UUID       `json:"id"`
+	ExpectedVersion *int64            `json:"expected_version"`
 }
 
+// Only columns whose set_* flag is true are written, so a field mask can
+// clear a value as well as change it. A non-NULL expected_version turns the
+// update into a compare-and-set; no row is returned when it is stale.
 func (q *Queries) UpdateProject(ctx context.Context, arg UpdateProjectParams) (Project, error) {
 	row := q.db.QueryRow(ctx, updateProject,
-		arg.ID,
+		arg.SetName,
 		arg.Name,
+		arg.SetDescription,
 		arg.Description,
+		arg.SetStatus,
 		arg.Status,
+		arg.ID,
+		arg.ExpectedVersion,
 	)
 	var i Project
 	err := row.Scan(
@@ -235,6 +778,34 @@ func (q *Queries) UpdateProject(ctx context.Context, arg UpdateProjectParams) (P
 		&i.Settings,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Version,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const updateProjectMemberRole = `-- name: UpdateProjectMemberRole :one
+UPDATE project_members
+SET role = $3
+WHERE project_id = $1 AND user_id = $2
+RETURNING id, project_id, user_id, role, joined_at
+`
+
+type UpdateProjectMemberRoleParams struct {
+	ProjectID pgtype.UUID `json:"project_id"`
+	UserID    pgtype.UUID `json:"user_id"`
+	Role      UserRole    `json:"role"`
+}
+
+func (q *Queries) UpdateProjectMemberRole(ctx context.Context, arg UpdateProjectMemberRoleParams) (ProjectMember, error) {
+	row := q.db.QueryRow(ctx, updateProjectMemberRole, arg.ProjectID, arg.UserID, arg.Role)
+	var i ProjectMember
+	err := row.Scan(
+		&i.ID,
+		&i.ProjectID,
+		&i.UserID,
+		&i.Role,
+		&i.JoinedAt,
 	)
 	return i, err
 }
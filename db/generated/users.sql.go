@@ -11,6 +11,24 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const countSoleAdminProjects = `-- name: CountSoleAdminProjects :one
+SELECT COUNT(*) FROM project_members m
+WHERE m.user_id = $1
+  AND m.role = 'admin'
+  AND NOT EXISTS (
+      SELECT 1 FROM project_members o
+      WHERE o.project_id = m.project_id AND o.role = 'admin' AND o.user_id <> m.user_id
+  )
+`
+
+// Counts the projects whose only admin is the given user.
+func (q *Queries) CountSoleAdminProjects(ctx context.Context, userID pgtype.UUID) (int64, error) {
+	row := q.db.QueryRow(ctx, countSoleAdminProjects, userID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const countUsers = `-- name: CountUsers :one
 SELECT COUNT(*) FROM users
 `
@@ -25,7 +43,7 @@ func (q *Queries) CountUsers(ctx context.Context) (int64, error) {
 const createUser = `-- name: CreateUser :one
 INSERT INTO users (name, email, role, locale, preferences)
 VALUES ($1, $2, $3, $4, $5)
-RETURNING id, name, email, role, locale, preferences, created_at, updated_at
+RETURNING id, name, email, role, locale, preferences, created_at, updated_at, version, status, oidc_issuer, oidc_subject
 `
 
 type CreateUserParams struct {
@@ -54,21 +72,72 @@ func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (User, e
 		&i.Preferences,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Version,
+		&i.Status,
+		&i.OidcIssuer,
+		&i.OidcSubject,
+	)
+	return i, err
+}
+
+const createUserWithIdentity = `-- name: CreateUserWithIdentity :one
+INSERT INTO users (name, email, role, locale, preferences, oidc_issuer, oidc_subject)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING id, name, email, role, locale, preferences, created_at, updated_at, version, status, oidc_issuer, oidc_subject
+`
+
+type CreateUserWithIdentityParams struct {
+	Name        string   `json:"name"`
+	Email       string   `json:"email"`
+	Role        UserRole `json:"role"`
+	Locale      string   `json:"locale"`
+	Preferences []byte   `json:"preferences"`
+	OidcIssuer  *string  `json:"oidc_issuer"`
+	OidcSubject *string  `json:"oidc_subject"`
+}
+
+func (q *Queries) CreateUserWithIdentity(ctx context.Context, arg CreateUserWithIdentityParams) (User, error) {
+	row := q.db.QueryRow(ctx, createUserWithIdentity,
+		arg.Name,
+		arg.Email,
+		arg.Role,
+		arg.Locale,
+		arg.Preferences,
+		arg.OidcIssuer,
+		arg.OidcSubject,
+	)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Email,
+		&i.Role,
+		&i.Locale,
+		&i.Preferences,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Version,
+		&i.Status,
+		&i.OidcIssuer,
+		&i.OidcSubject,
 	)
 	return i, err
 }
 
-const deleteUser = `-- name: DeleteUser :exec
+const deleteUser = `-- name: DeleteUser :execrows
 DELETE FROM users WHERE id = $1
 `
 
-func (q *Queries) DeleteUser(ctx context.Context, id pgtype.UUID) error {
-	_, err := q.db.Exec(ctx, deleteUser, id)
-	return err
+func (q *Queries) DeleteUser(ctx context.Context, id pgtype.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteUser, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
 }
 
 const getUser = `-- name: GetUser :one
-SELECT id, name, email, role, locale, preferences, created_at, updated_at FROM users WHERE id = $1 LIMIT 1
+SELECT id, name, email, role, locale, preferences, created_at, updated_at, version, status, oidc_issuer, oidc_subject FROM users WHERE id = $1 LIMIT 1
 `
 
 func (q *Queries) GetUser(ctx context.Context, id pgtype.UUID) (User, error) {
@@ -83,12 +152,16 @@ func (q *Queries) GetUser(ctx context.Context, id pgtype.UUID) (User, error) {
 		&i.Preferences,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Version,
+		&i.Status,
+		&i.OidcIssuer,
+		&i.OidcSubject,
 	)
 	return i, err
 }
 
 const getUserByEmail = `-- name: GetUserByEmail :one
-SELECT id, name, email, role, locale, preferences, created_at, updated_at FROM users WHERE email = $1 LIMIT 1
+SELECT id, name, email, role, locale, preferences, created_at, updated_at, version, status, oidc_issuer, oidc_subject FROM users WHERE email = $1 LIMIT 1
 `
 
 func (q *Queries) GetUserByEmail(ctx context.Context, email string) (User, error) {
@@ -103,23 +176,114 @@ func (q *Queries) GetUserByEmail(ctx context.Context, email string) (User, error
 		&i.Preferences,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Version,
+		&i.Status,
+		&i.OidcIssuer,
+		&i.OidcSubject,
+	)
+	return i, err
+}
+
+const getUserByIdentity = `-- name: GetUserByIdentity :one
+SELECT id, name, email, role, locale, preferences, created_at, updated_at, version, status, oidc_issuer, oidc_subject FROM users WHERE oidc_issuer = $1 AND oidc_subject = $2 LIMIT 1
+`
+
+type GetUserByIdentityParams struct {
+	OidcIssuer  *string `json:"oidc_issuer"`
+	OidcSubject *string `json:"oidc_subject"`
+}
+
+func (q *Queries) GetUserByIdentity(ctx context.Context, arg GetUserByIdentityParams) (User, error) {
+	row := q.db.QueryRow(ctx, getUserByIdentity, arg.OidcIssuer, arg.OidcSubject)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Email,
+		&i.Role,
+		&i.Locale,
+		&i.Preferences,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Version,
+		&i.Status,
+		&i.OidcIssuer,
+		&i.OidcSubject,
+	)
+	return i, err
+}
+
+const getUserVersion = `-- name: GetUserVersion :one
+SELECT version FROM users WHERE id = $1
+`
+
+func (q *Queries) GetUserVersion(ctx context.Context, id pgtype.UUID) (int64, error) {
+	row := q.db.QueryRow(ctx, getUserVersion, id)
+	var version int64
+	err := row.Scan(&version)
+	return version, err
+}
+
+const linkUserIdentity = `-- name: LinkUserIdentity :one
+UPDATE users
+SET oidc_issuer = $2, oidc_subject = $3
+WHERE id = $1 AND oidc_subject IS NULL
+RETURNING id, name, email, role, locale, preferences, created_at, updated_at, version, status, oidc_issuer, oidc_subject
+`
+
+type LinkUserIdentityParams struct {
+	ID          pgtype.UUID `json:"id"`
+	OidcIssuer  *string     `json:"oidc_issuer"`
+	OidcSubject *string     `json:"oidc_subject"`
+}
+
+// Binds an OIDC identity to a user that has none yet. No row is returned when
+// the user is already linked, so an identity is never replaced. The identity
+// is not part of the user's API representation, so version is left alone.
+func (q *Queries) LinkUserIdentity(ctx context.Context, arg LinkUserIdentityParams) (User, error) {
+	row := q.db.QueryRow(ctx, linkUserIdentity, arg.ID, arg.OidcIssuer, arg.OidcSubject)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Email,
+		&i.Role,
+		&i.Locale,
+		&i.Preferences,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Version,
+		&i.Status,
+		&i.OidcIssuer,
+		&i.OidcSubject,
 	)
 	return i, err
 }
 
 const listUsers = `-- name: ListUsers :many
-SELECT id, name, email, role, locale, preferences, created_at, updated_at FROM users
-ORDER BY created_at DESC
-LIMIT $1 OFFSET $2
+SELECT id, name, email, role, locale, preferences, created_at, updated_at, version, status, oidc_issuer, oidc_subject FROM users
+WHERE $1::timestamptz IS NULL
+   OR (created_at, id) < ($1::timestamptz, $2::uuid)
+ORDER BY created_at DESC, id DESC
+LIMIT $4 OFFSET $3
 `
 
 type ListUsersParams struct {
-	Limit  int32 `json:"limit"`
-	Offset int32 `json:"offset"`
+	AfterCreatedAt pgtype.Timestamptz `json:"after_created_at"`
+	AfterID        pgtype.UUID        `json:"after_id"`
+	Offset         int32              `json:"offset"`
+	Limit          int32              `json:"limit"`
 }
 
+// Keyset pagination over (created_at, id); the after_* cursor is NULL on the
+// first page. OFFSET is only non-zero for legacy page-number requests.
 func (q *Queries) ListUsers(ctx context.Context, arg ListUsersParams) ([]User, error) {
-	rows, err := q.db.Query(ctx, listUsers, arg.Limit, arg.Offset)
+	rows, err := q.db.Query(ctx, listUsers,
+		arg.AfterCreatedAt,
+		arg.AfterID,
+		arg.Offset,
+		arg.Limit,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -136,6 +300,10 @@ func (q *Queries) ListUsers(ctx context.Context, arg ListUsersParams) ([]User, e
 			&i.Preferences,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.Version,
+			&i.Status,
+			&i.OidcIssuer,
+			&i.OidcSubject,
 		); err != nil {
 			return nil, err
 		}
@@ -147,24 +315,195 @@ func (q *Queries) ListUsers(ctx context.Context, arg ListUsersParams) ([]User, e
 	return items, nil
 }
 
+const lockActiveAdmins = `-- name: LockActiveAdmins :many
+SELECT id FROM users
+WHERE role = 'admin' AND status = 'active'
+ORDER BY id
+FOR UPDATE
+`
+
+// Locks the active global admins until the transaction ends, so concurrent
+// demotions cannot both pass the last-admin check.
+func (q *Queries) LockActiveAdmins(ctx context.Context) ([]pgtype.UUID, error) {
+	rows, err := q.db.Query(ctx, lockActiveAdmins)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []pgtype.UUID
+	for rows.Next() {
+		var id pgtype.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const lockUser = `-- name: LockUser :one
+SELECT id, name, email, role, locale, preferences, created_at, updated_at, version, status, oidc_issuer, oidc_subject FROM users WHERE id = $1 FOR UPDATE
+`
+
+func (q *Queries) LockUser(ctx context.Context, id pgtype.UUID) (User, error) {
+	row := q.db.QueryRow(ctx, lockUser, id)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Email,
+		&i.Role,
+		&i.Locale,
+		&i.Preferences,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Version,
+		&i.Status,
+		&i.OidcIssuer,
+		&i.OidcSubject,
+	)
+	return i, err
+}
+
+const lockUserPreferences = `-- name: LockUserPreferences :one
+SELECT preferences, version FROM users WHERE id = $1 FOR UPDATE
+`
+
+type LockUserPreferencesRow struct {
+	Preferences []byte `json:"preferences"`
+	Version     int64  `json:"version"`
+}
+
+func (q *Queries) LockUserPreferences(ctx context.Context, id pgtype.UUID) (LockUserPreferencesRow, error) {
+	row := q.db.QueryRow(ctx, lockUserPreferences, id)
+	var i LockUserPreferencesRow
+	err := row.Scan(&i.Preferences, &i.Version)
+	return i, err
+}
+
+const setUserPreferences = `-- name: SetUserPreferences :one
+UPDATE users
+SET preferences = $2, version = version + 1, updated_at = NOW()
+WHERE id = $1
+RETURNING preferences, version
+`
+
+type SetUserPreferencesParams struct {
+	ID          pgtype.UUID `json:"id"`
+	Preferences []byte      `json:"preferences"`
+}
+
+type SetUserPreferencesRow struct {
+	Preferences []byte `json:"preferences"`
+	Version     int64  `json:"version"`
+}
+
+func (q *Queries) SetUserPreferences(ctx context.Context, arg SetUserPreferencesParams) (SetUserPreferencesRow, error) {
+	row := q.db.QueryRow(ctx, setUserPreferences, arg.ID, arg.Preferences)
+	var i SetUserPreferencesRow
+	err := row.Scan(&i.Preferences, &i.Version)
+	return i, err
+}
+
+const setUserRole = `-- name: SetUserRole :one
+UPDATE users
+SET role = $2, version = version + 1, updated_at = NOW()
+WHERE id = $1
+RETURNING id, name, email, role, locale, preferences, created_at, updated_at, version, status, oidc_issuer, oidc_subject
+`
+
+type SetUserRoleParams struct {
+	ID   pgtype.UUID `json:"id"`
+	Role UserRole    `json:"role"`
+}
+
+func (q *Queries) SetUserRole(ctx context.Context, arg SetUserRoleParams) (User, error) {
+	row := q.db.QueryRow(ctx, setUserRole, arg.ID, arg.Role)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Email,
+		&i.Role,
+		&i.Locale,
+		&i.Preferences,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Version,
+		&i.Status,
+		&i.OidcIssuer,
+		&i.OidcSubject,
+	)
+	return i, err
+}
+
+const setUserStatus = `-- name: SetUserStatus :one
+UPDATE users
+SET status = $2, version = version + 1, updated_at = NOW()
+WHERE id = $1
+RETURNING id, name, email, role, locale, preferences, created_at, updated_at, version, status, oidc_issuer, oidc_subject
+`
+
+type SetUserStatusParams struct {
+	ID     pgtype.UUID `json:"id"`
+	Status UserStatus  `json:"status"`
+}
+
+func (q *Queries) SetUserStatus(ctx context.Context, arg SetUserStatusParams) (User, error) {
+	row := q.db.QueryRow(ctx, setUserStatus, arg.ID, arg.Status)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Email,
+		&i.Role,
+		&i.Locale,
+		&i.Preferences,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Version,
+		&i.Status,
+		&i.OidcIssuer,
+		&i.OidcSubject,
+	)
+	return i, err
+}
+
 const updateUser = `-- name: UpdateUser :one
 UPDATE users
 SET
-    name       = COALESCE($2, name),
-    locale     = COALESCE($3, locale),
+    name       = CASE WHEN $1::boolean THEN $2::text ELSE name END,
+    locale     = CASE WHEN $3::boolean THEN $4::text ELSE locale END,
+    version    = version + 1,
     updated_at = NOW()
-WHERE id = $1
-RETURNING id, name, email, role, locale, preferences, created_at, updated_at
+WHERE id = $5
+  AND ($6::bigint IS NULL OR version = $6::bigint)
+RETURNING id, name, email, role, locale, preferences, created_at, updated_at, version, status, oidc_issuer, oidc_subject
 `
 
 type UpdateUserParams struct {
-	ID     pgtype.UUID `json:"id"`
-	Name   *string     `json:"name"`
-	Locale *string     `json:"locale"`
+	SetName         bool        `json:"set_name"`
+	Name            string      `json:"name"`
+	SetLocale       bool        `json:"set_locale"`
+	Locale          string      `json:"locale"`
+	ID              pgtype.UUID `json:"id"`
+	ExpectedVersion *int64      `json:"expected_version"`
 }
 
+// Only columns whose set_* flag is true are written. A non-NULL
+// expected_version makes the update a compare-and-set.
 func (q *Queries) UpdateUser(ctx context.Context, arg UpdateUserParams) (User, error) {
-	row := q.db.QueryRow(ctx, updateUser, arg.ID, arg.Name, arg.Locale)
+	row := q.db.QueryRow(ctx, updateUser,
+		arg.SetName,
+		arg.Name,
+		arg.SetLocale,
+		arg.Locale,
+		arg.ID,
+		arg.ExpectedVersion,
+	)
 	var i User
 	err := row.Scan(
 		&i.ID,
@@ -175,6 +514,10 @@ func (q *Queries) UpdateUser(ctx context.Context, arg UpdateUserParams) (User, e
 		&i.Preferences,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Version,
+		&i.Status,
+		&i.OidcIssuer,
+		&i.OidcSubject,
 	)
 	return i, err
 }
@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE INDEX idx_projects_created_at_id ON projects(created_at DESC, id DESC);
+CREATE INDEX idx_users_created_at_id    ON users(created_at DESC, id DESC);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_users_created_at_id;
+DROP INDEX IF EXISTS idx_projects_created_at_id;
+-- +goose StatementEnd
@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+-- project_search_vector is the single definition of what ListProjects searches.
+-- Queries must call it with the same arguments so the GIN index below is used.
+CREATE FUNCTION project_search_vector(name TEXT, description TEXT) RETURNS tsvector
+    LANGUAGE sql IMMUTABLE PARALLEL SAFE
+    AS $$
+        SELECT setweight(to_tsvector('english', name), 'A') ||
+               setweight(to_tsvector('english', description), 'B')
+    $$;
+
+CREATE INDEX idx_projects_search ON projects USING GIN (project_search_vector(name, description));
+CREATE INDEX idx_projects_updated_at ON projects(updated_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_projects_updated_at;
+DROP INDEX IF EXISTS idx_projects_search;
+DROP FUNCTION IF EXISTS project_search_vector(TEXT, TEXT);
+-- +goose StatementEnd
@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+-- version is bumped on every write and exposed to clients as an etag for
+-- optimistic concurrency control.
+ALTER TABLE projects ADD COLUMN version BIGINT NOT NULL DEFAULT 1;
+ALTER TABLE users    ADD COLUMN version BIGINT NOT NULL DEFAULT 1;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE users    DROP COLUMN IF EXISTS version;
+ALTER TABLE projects DROP COLUMN IF EXISTS version;
+-- +goose StatementEnd
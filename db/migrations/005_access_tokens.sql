@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+-- access_tokens are personal access tokens for non-browser clients. Only a
+-- SHA-256 hash of the secret is stored; prefix is kept so users can tell
+-- their tokens apart.
+CREATE TABLE access_tokens (
+    id           UUID        PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id      UUID        NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    name         TEXT        NOT NULL,
+    prefix       TEXT        NOT NULL,
+    token_hash   BYTEA       NOT NULL UNIQUE,
+    scopes       TEXT[]      NOT NULL DEFAULT '{}',
+    expires_at   TIMESTAMPTZ,
+    last_used_at TIMESTAMPTZ,
+    revoked_at   TIMESTAMPTZ,
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_access_tokens_user_id ON access_tokens(user_id, created_at DESC);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS access_tokens;
+-- +goose StatementEnd
@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+-- outbox holds domain events written in the same transaction as the change
+-- they describe. The BFF relay publishes them to JetStream in seq order and
+-- deletes them once the stream has acknowledged them, so every row here is
+-- still waiting to go out.
+CREATE TABLE outbox (
+    seq        BIGINT      GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    id         UUID        NOT NULL UNIQUE,
+    subject    TEXT        NOT NULL,
+    event      BYTEA       NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS outbox;
+-- +goose StatementEnd
@@ -0,0 +1,39 @@
+-- +goose Up
+-- +goose StatementBegin
+-- audit_log records every mutating BFF call: who made it, what it touched and
+-- which fields changed. Actor and entity IDs are not foreign keys, so entries
+-- outlive the rows they describe.
+CREATE TABLE audit_log (
+    id             UUID        PRIMARY KEY DEFAULT uuid_generate_v4(),
+    occurred_at    TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    actor_id       UUID,
+    actor_token_id UUID,
+    procedure      TEXT        NOT NULL,
+    entity_type    TEXT        NOT NULL,
+    entity_id      UUID        NOT NULL,
+    -- changes maps each changed field to {"before": ..., "after": ...}.
+    changes        JSONB       NOT NULL DEFAULT '{}',
+    request_id     TEXT        NOT NULL DEFAULT '',
+    client_ip      TEXT        NOT NULL DEFAULT ''
+);
+
+CREATE INDEX idx_audit_log_occurred_at ON audit_log(occurred_at DESC, id DESC);
+CREATE INDEX idx_audit_log_actor ON audit_log(actor_id, occurred_at DESC);
+CREATE INDEX idx_audit_log_entity ON audit_log(entity_type, entity_id, occurred_at DESC);
+
+CREATE FUNCTION audit_log_append_only() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'audit_log is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER audit_log_append_only
+    BEFORE UPDATE OR DELETE OR TRUNCATE ON audit_log
+    FOR EACH STATEMENT EXECUTE FUNCTION audit_log_append_only();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS audit_log;
+DROP FUNCTION IF EXISTS audit_log_append_only();
+-- +goose StatementEnd
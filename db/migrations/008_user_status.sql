@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Deactivated users cannot sign in or use their access tokens, but keep their
+-- rows, memberships and history.
+CREATE TYPE user_status AS ENUM ('active', 'deactivated');
+
+ALTER TABLE users ADD COLUMN status user_status NOT NULL DEFAULT 'active';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE users DROP COLUMN IF EXISTS status;
+DROP TYPE IF EXISTS user_status;
+-- +goose StatementEnd
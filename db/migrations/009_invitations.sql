@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+-- invitations let project admins add people by email before they have an
+-- account. The emailed token is signed and names the row by id; the row
+-- decides whether the invitation can still be used.
+CREATE TYPE invitation_status AS ENUM ('pending', 'accepted', 'declined', 'revoked');
+
+CREATE TABLE invitations (
+    id           UUID              PRIMARY KEY DEFAULT uuid_generate_v4(),
+    project_id   UUID              NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+    email        TEXT              NOT NULL,
+    role         user_role         NOT NULL DEFAULT 'member',
+    status       invitation_status NOT NULL DEFAULT 'pending',
+    invited_by   UUID              REFERENCES users(id) ON DELETE SET NULL,
+    expires_at   TIMESTAMPTZ       NOT NULL,
+    responded_at TIMESTAMPTZ,
+    created_at   TIMESTAMPTZ       NOT NULL DEFAULT NOW()
+);
+
+-- One open invitation per address and project; inviting again replaces it.
+CREATE UNIQUE INDEX idx_invitations_pending
+    ON invitations(project_id, lower(email)) WHERE status = 'pending';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS invitations;
+DROP TYPE IF EXISTS invitation_status;
+-- +goose StatementEnd
@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Deleting a project moves it to the trash by setting deleted_at. Trashed
+-- projects are hidden everywhere but the trash, keep their members and
+-- history, and are hard-deleted by the BFF's purger once retention has passed.
+ALTER TABLE projects ADD COLUMN deleted_at TIMESTAMPTZ;
+
+CREATE INDEX idx_projects_deleted_at ON projects(deleted_at, id) WHERE deleted_at IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_projects_deleted_at;
+-- Trashed projects would reappear as live ones.
+DELETE FROM projects WHERE deleted_at IS NOT NULL;
+ALTER TABLE projects DROP COLUMN IF EXISTS deleted_at;
+-- +goose StatementEnd
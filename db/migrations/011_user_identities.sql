@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+-- OIDC sign-ins are matched on the token's (iss, sub), which the issuer never
+-- reassigns, rather than on the email address, which it may. A user created
+-- by an admin has no identity until its first sign-in links one.
+ALTER TABLE users ADD COLUMN oidc_issuer  TEXT;
+ALTER TABLE users ADD COLUMN oidc_subject TEXT;
+ALTER TABLE users ADD CONSTRAINT users_oidc_identity_pair
+    CHECK ((oidc_issuer IS NULL) = (oidc_subject IS NULL));
+
+CREATE UNIQUE INDEX idx_users_oidc_identity ON users(oidc_issuer, oidc_subject)
+    WHERE oidc_subject IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_users_oidc_identity;
+ALTER TABLE users DROP CONSTRAINT IF EXISTS users_oidc_identity_pair;
+ALTER TABLE users DROP COLUMN IF EXISTS oidc_subject;
+ALTER TABLE users DROP COLUMN IF EXISTS oidc_issuer;
+-- +goose StatementEnd
@@ -0,0 +1,35 @@
+-- name: CreateAccessToken :one
+INSERT INTO access_tokens (user_id, name, prefix, token_hash, scopes, expires_at)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING *;
+
+-- name: ListAccessTokens :many
+SELECT * FROM access_tokens
+WHERE user_id = $1
+ORDER BY created_at DESC, id;
+
+-- name: GetActiveAccessToken :one
+-- Resolves a presented token to its owner. Revoked and expired tokens match
+-- no row.
+SELECT t.id, t.scopes, t.expires_at, u.id AS user_id, u.email, u.role, u.status
+FROM access_tokens t
+JOIN users u ON u.id = t.user_id
+WHERE t.token_hash = $1
+  AND t.revoked_at IS NULL
+  AND (t.expires_at IS NULL OR t.expires_at > NOW());
+
+-- name: TouchAccessToken :exec
+UPDATE access_tokens SET last_used_at = NOW() WHERE id = $1;
+
+-- name: RevokeAccessToken :one
+UPDATE access_tokens
+SET revoked_at = NOW()
+WHERE id = $1 AND user_id = $2 AND revoked_at IS NULL
+RETURNING *;
+
+-- name: RevokeUserAccessTokens :many
+-- Revokes every token of a user who is deactivated or deleted.
+UPDATE access_tokens
+SET revoked_at = NOW()
+WHERE user_id = $1 AND revoked_at IS NULL
+RETURNING *;
@@ -0,0 +1,31 @@
+-- name: InsertAuditEvent :exec
+INSERT INTO audit_log (actor_id, actor_token_id, procedure, entity_type, entity_id, changes, request_id, client_ip)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8);
+
+-- name: ListAuditEvents :many
+-- Newest first. The after_* cursor continues below the last row of the
+-- previous page.
+SELECT * FROM audit_log
+WHERE (sqlc.narg(actor_id)::uuid IS NULL OR actor_id = sqlc.narg(actor_id)::uuid)
+  AND (sqlc.narg(entity_type)::text IS NULL OR entity_type = sqlc.narg(entity_type)::text)
+  AND (sqlc.narg(entity_id)::uuid IS NULL OR entity_id = sqlc.narg(entity_id)::uuid)
+  AND (sqlc.narg(since)::timestamptz IS NULL OR occurred_at >= sqlc.narg(since)::timestamptz)
+  AND (sqlc.narg(until)::timestamptz IS NULL OR occurred_at < sqlc.narg(until)::timestamptz)
+  AND (sqlc.narg(after_occurred_at)::timestamptz IS NULL
+       OR (occurred_at, id) < (sqlc.narg(after_occurred_at)::timestamptz, sqlc.narg(after_id)::uuid))
+ORDER BY occurred_at DESC, id DESC
+LIMIT sqlc.arg('limit');
+
+-- name: ExportAuditEvents :many
+-- Oldest first, for incremental pulls. The after_* cursor continues above the
+-- last row of the previous batch.
+SELECT * FROM audit_log
+WHERE (sqlc.narg(actor_id)::uuid IS NULL OR actor_id = sqlc.narg(actor_id)::uuid)
+  AND (sqlc.narg(entity_type)::text IS NULL OR entity_type = sqlc.narg(entity_type)::text)
+  AND (sqlc.narg(entity_id)::uuid IS NULL OR entity_id = sqlc.narg(entity_id)::uuid)
+  AND (sqlc.narg(since)::timestamptz IS NULL OR occurred_at >= sqlc.narg(since)::timestamptz)
+  AND (sqlc.narg(until)::timestamptz IS NULL OR occurred_at < sqlc.narg(until)::timestamptz)
+  AND (sqlc.narg(after_occurred_at)::timestamptz IS NULL
+       OR (occurred_at, id) > (sqlc.narg(after_occurred_at)::timestamptz, sqlc.narg(after_id)::uuid))
+ORDER BY occurred_at, id
+LIMIT sqlc.arg('limit');
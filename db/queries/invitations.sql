@@ -0,0 +1,25 @@
+-- name: CreateInvitation :one
+INSERT INTO invitations (project_id, email, role, invited_by, expires_at)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING *;
+
+-- name: RevokePendingInvitationsForEmail :many
+-- Closes the open invitation of an address before a new one is sent.
+UPDATE invitations
+SET status = 'revoked', responded_at = NOW()
+WHERE project_id = $1 AND lower(email) = lower(sqlc.arg(email)) AND status = 'pending'
+RETURNING *;
+
+-- name: ListPendingInvitations :many
+SELECT * FROM invitations
+WHERE project_id = $1 AND status = 'pending'
+ORDER BY created_at DESC, id;
+
+-- name: LockInvitation :one
+SELECT * FROM invitations WHERE id = $1 FOR UPDATE;
+
+-- name: SetInvitationStatus :one
+UPDATE invitations
+SET status = $2, responded_at = NOW()
+WHERE id = $1
+RETURNING *;
@@ -0,0 +1,16 @@
+-- name: InsertOutboxEvent :exec
+INSERT INTO outbox (id, subject, event)
+VALUES ($1, $2, $3);
+
+-- name: TryLockOutbox :one
+-- Takes the relay lock until the transaction ends, so only one BFF replica
+-- publishes at a time and events go out in order.
+SELECT pg_try_advisory_xact_lock(sqlc.arg(key)::bigint)::boolean AS locked;
+
+-- name: ListOutboxEvents :many
+SELECT seq, id, subject, event FROM outbox
+ORDER BY seq
+LIMIT $1;
+
+-- name: DeleteOutboxEvents :exec
+DELETE FROM outbox WHERE seq = ANY(sqlc.arg(seqs)::bigint[]);
@@ -1,13 +1,70 @@
+-- Trashed projects (deleted_at set) are invisible to every query that does not
+-- mention the trash.
+
 -- name: GetProject :one
-SELECT * FROM projects WHERE id = $1 LIMIT 1;
+SELECT * FROM projects WHERE id = $1 AND deleted_at IS NULL LIMIT 1;
 
 -- name: ListProjects :many
+-- Keyset pagination over (created_at, id); the after_* cursor is NULL on the
+-- first page. OFFSET is only non-zero for legacy page-number requests.
+-- Every filter is optional: a NULL argument disables it.
 SELECT * FROM projects
-ORDER BY created_at DESC
-LIMIT $1 OFFSET $2;
+WHERE deleted_at IS NULL
+  AND (sqlc.narg(status)::project_status IS NULL OR status = sqlc.narg(status)::project_status)
+  AND (sqlc.narg(name_pattern)::text IS NULL OR name ILIKE sqlc.narg(name_pattern)::text)
+  AND (sqlc.narg(member_user_id)::uuid IS NULL OR EXISTS (
+        SELECT 1 FROM project_members pm
+        WHERE pm.project_id = projects.id AND pm.user_id = sqlc.narg(member_user_id)::uuid))
+  AND (sqlc.narg(updated_since)::timestamptz IS NULL OR updated_at >= sqlc.narg(updated_since)::timestamptz)
+  AND (sqlc.narg(after_created_at)::timestamptz IS NULL
+       OR (created_at, id) < (sqlc.narg(after_created_at)::timestamptz, sqlc.narg(after_id)::uuid))
+ORDER BY created_at DESC, id DESC
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
 
 -- name: CountProjects :one
-SELECT COUNT(*) FROM projects;
+-- Accepts the ListProjects filters plus an optional full-text query, so it also
+-- totals SearchProjects results.
+SELECT COUNT(*) FROM projects
+WHERE deleted_at IS NULL
+  AND (sqlc.narg(query)::text IS NULL
+       OR project_search_vector(name, description) @@ websearch_to_tsquery('english', sqlc.narg(query)::text))
+  AND (sqlc.narg(status)::project_status IS NULL OR status = sqlc.narg(status)::project_status)
+  AND (sqlc.narg(name_pattern)::text IS NULL OR name ILIKE sqlc.narg(name_pattern)::text)
+  AND (sqlc.narg(member_user_id)::uuid IS NULL OR EXISTS (
+        SELECT 1 FROM project_members pm
+        WHERE pm.project_id = projects.id AND pm.user_id = sqlc.narg(member_user_id)::uuid))
+  AND (sqlc.narg(updated_since)::timestamptz IS NULL OR updated_at >= sqlc.narg(updated_since)::timestamptz);
+
+-- name: SearchProjects :many
+-- Full-text search ranked by relevance, paginated over (rank, created_at, id).
+-- Highlights wrap matches in \x02…\x03 so the caller can escape the text
+-- before turning the markers into markup.
+WITH matches AS (
+    SELECT projects.*,
+           ts_rank(project_search_vector(name, description), websearch_to_tsquery('english', sqlc.arg(query)))::real AS rank
+    FROM projects
+    WHERE deleted_at IS NULL
+      AND project_search_vector(name, description) @@ websearch_to_tsquery('english', sqlc.arg(query))
+      AND (sqlc.narg(status)::project_status IS NULL OR status = sqlc.narg(status)::project_status)
+      AND (sqlc.narg(name_pattern)::text IS NULL OR name ILIKE sqlc.narg(name_pattern)::text)
+      AND (sqlc.narg(member_user_id)::uuid IS NULL OR EXISTS (
+            SELECT 1 FROM project_members pm
+            WHERE pm.project_id = projects.id AND pm.user_id = sqlc.narg(member_user_id)::uuid))
+      AND (sqlc.narg(updated_since)::timestamptz IS NULL OR updated_at >= sqlc.narg(updated_since)::timestamptz)
+), page AS (
+    SELECT * FROM matches
+    WHERE sqlc.narg(after_rank)::real IS NULL
+       OR (rank, created_at, id) < (sqlc.narg(after_rank)::real, sqlc.narg(after_created_at)::timestamptz, sqlc.narg(after_id)::uuid)
+    ORDER BY rank DESC, created_at DESC, id DESC
+    LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset')
+)
+SELECT page.id, page.name, page.description, page.status, page.settings, page.created_at, page.updated_at, page.version, page.rank,
+       ts_headline('english', page.name, websearch_to_tsquery('english', sqlc.arg(query)),
+                   'HighlightAll=true, StartSel=' || chr(2) || ', StopSel=' || chr(3))::text AS name_highlight,
+       ts_headline('english', page.description, websearch_to_tsquery('english', sqlc.arg(query)),
+                   'MaxFragments=2, MaxWords=30, MinWords=10, StartSel=' || chr(2) || ', StopSel=' || chr(3))::text AS description_highlight
+FROM page
+ORDER BY page.rank DESC, page.created_at DESC, page.id DESC;
 
 -- name: CreateProject :one
 INSERT INTO projects (name, description)
@@ -15,23 +72,106 @@ VALUES ($1, $2)
 RETURNING *;
 
 -- name: UpdateProject :one
+-- Only columns whose set_* flag is true are written, so a field mask can
+-- clear a value as well as change it. A non-NULL expected_version turns the
+-- update into a compare-and-set; no row is returned when it is stale.
 UPDATE projects
 SET
-    name        = COALESCE(sqlc.narg(name), name),
-    description = COALESCE(sqlc.narg(description), description),
-    status      = COALESCE(sqlc.narg(status)::project_status, status),
+    name        = CASE WHEN sqlc.arg(set_name)::boolean THEN sqlc.arg(name)::text ELSE name END,
+    description = CASE WHEN sqlc.arg(set_description)::boolean THEN sqlc.arg(description)::text ELSE description END,
+    status      = CASE WHEN sqlc.arg(set_status)::boolean THEN sqlc.narg(status)::project_status ELSE status END,
+    version     = version + 1,
     updated_at  = NOW()
+WHERE id = sqlc.arg(id) AND deleted_at IS NULL
+  AND (sqlc.narg(expected_version)::bigint IS NULL OR version = sqlc.narg(expected_version)::bigint)
+RETURNING *;
+
+-- name: TrashProject :one
+-- Like UpdateProject, returns no row when expected_version is stale.
+UPDATE projects
+SET deleted_at = NOW(), version = version + 1, updated_at = NOW()
+WHERE id = sqlc.arg(id) AND deleted_at IS NULL
+  AND (sqlc.narg(expected_version)::bigint IS NULL OR version = sqlc.narg(expected_version)::bigint)
+RETURNING *;
+
+-- name: ListTrashedProjects :many
+-- Most recently trashed first, keyset-paginated over (deleted_at, id). A
+-- non-NULL admin_user_id limits the list to projects that user administers.
+SELECT * FROM projects
+WHERE deleted_at IS NOT NULL
+  AND (sqlc.narg(admin_user_id)::uuid IS NULL OR EXISTS (
+        SELECT 1 FROM project_members pm
+        WHERE pm.project_id = projects.id AND pm.user_id = sqlc.narg(admin_user_id)::uuid AND pm.role = 'admin'))
+  AND (sqlc.narg(after_deleted_at)::timestamptz IS NULL
+       OR (deleted_at, id) < (sqlc.narg(after_deleted_at)::timestamptz, sqlc.narg(after_id)::uuid))
+ORDER BY deleted_at DESC, id DESC
+LIMIT sqlc.arg('limit');
+
+-- name: LockTrashedProject :one
+SELECT * FROM projects WHERE id = $1 AND deleted_at IS NOT NULL FOR UPDATE;
+
+-- name: RestoreProject :one
+UPDATE projects
+SET deleted_at = NULL, version = version + 1, updated_at = NOW()
 WHERE id = $1
 RETURNING *;
 
--- name: DeleteProject :exec
-DELETE FROM projects WHERE id = $1;
+-- name: PurgeTrashedProjects :many
+-- Hard-deletes up to limit projects trashed before the cutoff. SKIP LOCKED
+-- leaves projects being restored alone and lets several purgers share the work.
+DELETE FROM projects
+WHERE id IN (
+    SELECT t.id FROM projects t
+    WHERE t.deleted_at < sqlc.arg(trashed_before)::timestamptz
+    ORDER BY t.deleted_at, t.id
+    LIMIT sqlc.arg('limit')
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING *;
+
+-- name: LockProject :one
+SELECT * FROM projects WHERE id = $1 AND deleted_at IS NULL FOR UPDATE;
+
+-- name: LockProjectForShare :one
+-- Keeps the project from being trashed until the transaction ends, while
+-- other changes to its members go ahead.
+SELECT id FROM projects WHERE id = $1 AND deleted_at IS NULL FOR SHARE;
+
+-- name: LockProjectSettings :one
+SELECT settings, version FROM projects WHERE id = $1 AND deleted_at IS NULL FOR UPDATE;
+
+-- name: SetProjectSettings :one
+UPDATE projects
+SET settings = $2, version = version + 1, updated_at = NOW()
+WHERE id = $1
+RETURNING settings, version;
+
+-- name: GetProjectVersion :one
+SELECT version FROM projects WHERE id = $1;
 
 -- name: ListProjectMembers :many
 SELECT pm.*, u.name AS user_name, u.email AS user_email
 FROM project_members pm
 JOIN users u ON u.id = pm.user_id
-WHERE pm.project_id = $1;
+WHERE pm.project_id = $1
+ORDER BY pm.joined_at, pm.id;
+
+-- name: GetProjectMember :one
+SELECT pm.*, u.name AS user_name, u.email AS user_email
+FROM project_members pm
+JOIN users u ON u.id = pm.user_id
+WHERE pm.project_id = $1 AND pm.user_id = $2
+LIMIT 1;
+
+-- name: ListMemberProjectIDs :many
+SELECT pm.project_id FROM project_members pm
+JOIN projects p ON p.id = pm.project_id
+WHERE pm.user_id = $1 AND p.deleted_at IS NULL
+ORDER BY pm.project_id;
+
+-- name: GetProjectMemberRole :one
+SELECT role FROM project_members
+WHERE project_id = $1 AND user_id = $2;
 
 -- name: AddProjectMember :one
 INSERT INTO project_members (project_id, user_id, role)
@@ -39,6 +179,19 @@ VALUES ($1, $2, $3)
 ON CONFLICT (project_id, user_id) DO UPDATE SET role = EXCLUDED.role
 RETURNING *;
 
+-- name: UpdateProjectMemberRole :one
+UPDATE project_members
+SET role = $3
+WHERE project_id = $1 AND user_id = $2
+RETURNING *;
+
+-- name: LockProjectAdmins :many
+-- Locks the admin memberships of a project so that concurrent demotions or
+-- removals cannot leave it without an admin.
+SELECT user_id FROM project_members
+WHERE project_id = $1 AND role = 'admin'
+FOR UPDATE;
+
 -- name: RemoveProjectMember :exec
 DELETE FROM project_members
 WHERE project_id = $1 AND user_id = $2;
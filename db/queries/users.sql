@@ -4,10 +4,17 @@ SELECT * FROM users WHERE id = $1 LIMIT 1;
 -- name: GetUserByEmail :one
 SELECT * FROM users WHERE email = $1 LIMIT 1;
 
+-- name: GetUserByIdentity :one
+SELECT * FROM users WHERE oidc_issuer = $1 AND oidc_subject = $2 LIMIT 1;
+
 -- name: ListUsers :many
+-- Keyset pagination over (created_at, id); the after_* cursor is NULL on the
+-- first page. OFFSET is only non-zero for legacy page-number requests.
 SELECT * FROM users
-ORDER BY created_at DESC
-LIMIT $1 OFFSET $2;
+WHERE sqlc.narg(after_created_at)::timestamptz IS NULL
+   OR (created_at, id) < (sqlc.narg(after_created_at)::timestamptz, sqlc.narg(after_id)::uuid)
+ORDER BY created_at DESC, id DESC
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
 
 -- name: CountUsers :one
 SELECT COUNT(*) FROM users;
@@ -17,14 +24,77 @@ INSERT INTO users (name, email, role, locale, preferences)
 VALUES ($1, $2, $3, $4, $5)
 RETURNING *;
 
+-- name: CreateUserWithIdentity :one
+INSERT INTO users (name, email, role, locale, preferences, oidc_issuer, oidc_subject)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING *;
+
+-- name: LinkUserIdentity :one
+-- Binds an OIDC identity to a user that has none yet. No row is returned when
+-- the user is already linked, so an identity is never replaced. The identity
+-- is not part of the user's API representation, so version is left alone.
+UPDATE users
+SET oidc_issuer = $2, oidc_subject = $3
+WHERE id = $1 AND oidc_subject IS NULL
+RETURNING *;
+
 -- name: UpdateUser :one
+-- Only columns whose set_* flag is true are written. A non-NULL
+-- expected_version makes the update a compare-and-set.
 UPDATE users
 SET
-    name       = COALESCE(sqlc.narg(name), name),
-    locale     = COALESCE(sqlc.narg(locale), locale),
+    name       = CASE WHEN sqlc.arg(set_name)::boolean THEN sqlc.arg(name)::text ELSE name END,
+    locale     = CASE WHEN sqlc.arg(set_locale)::boolean THEN sqlc.arg(locale)::text ELSE locale END,
+    version    = version + 1,
     updated_at = NOW()
+WHERE id = sqlc.arg(id)
+  AND (sqlc.narg(expected_version)::bigint IS NULL OR version = sqlc.narg(expected_version)::bigint)
+RETURNING *;
+
+-- name: LockUser :one
+SELECT * FROM users WHERE id = $1 FOR UPDATE;
+
+-- name: LockUserPreferences :one
+SELECT preferences, version FROM users WHERE id = $1 FOR UPDATE;
+
+-- name: SetUserPreferences :one
+UPDATE users
+SET preferences = $2, version = version + 1, updated_at = NOW()
+WHERE id = $1
+RETURNING preferences, version;
+
+-- name: GetUserVersion :one
+SELECT version FROM users WHERE id = $1;
+
+-- name: SetUserRole :one
+UPDATE users
+SET role = $2, version = version + 1, updated_at = NOW()
+WHERE id = $1
+RETURNING *;
+
+-- name: SetUserStatus :one
+UPDATE users
+SET status = $2, version = version + 1, updated_at = NOW()
 WHERE id = $1
 RETURNING *;
 
--- name: DeleteUser :exec
+-- name: LockActiveAdmins :many
+-- Locks the active global admins until the transaction ends, so concurrent
+-- demotions cannot both pass the last-admin check.
+SELECT id FROM users
+WHERE role = 'admin' AND status = 'active'
+ORDER BY id
+FOR UPDATE;
+
+-- name: CountSoleAdminProjects :one
+-- Counts the projects whose only admin is the given user.
+SELECT COUNT(*) FROM project_members m
+WHERE m.user_id = $1
+  AND m.role = 'admin'
+  AND NOT EXISTS (
+      SELECT 1 FROM project_members o
+      WHERE o.project_id = m.project_id AND o.role = 'admin' AND o.user_id <> m.user_id
+  );
+
+-- name: DeleteUser :execrows
 DELETE FROM users WHERE id = $1;
@@ -2,11 +2,14 @@ package main
 
 import (
 	"context"
+	"crypto/rand"
 	"errors"
+	"expvar"
 	"fmt"
 	"net/http"
 	"os"
 	"os/signal"
+	"sync"
 	"syscall"
 	"time"
 
@@ -17,14 +20,25 @@ import (
 	"golang.org/x/net/http2"
 	"golang.org/x/net/http2/h2c"
 
+	"github.com/ApeironFoundation/axle/bff/internal/auth"
+	"github.com/ApeironFoundation/axle/bff/internal/authz"
+	"github.com/ApeironFoundation/axle/bff/internal/cache"
 	"github.com/ApeironFoundation/axle/bff/internal/config"
 	"github.com/ApeironFoundation/axle/bff/internal/db"
 	"github.com/ApeironFoundation/axle/bff/internal/enterprise"
 	"github.com/ApeironFoundation/axle/bff/internal/handler"
 	"github.com/ApeironFoundation/axle/bff/internal/health"
+	"github.com/ApeironFoundation/axle/bff/internal/idempotency"
+	"github.com/ApeironFoundation/axle/bff/internal/interceptor"
+	"github.com/ApeironFoundation/axle/bff/internal/invitation"
+	"github.com/ApeironFoundation/axle/bff/internal/mailer"
 	"github.com/ApeironFoundation/axle/bff/internal/middleware"
 	"github.com/ApeironFoundation/axle/bff/internal/natsclient"
+	"github.com/ApeironFoundation/axle/bff/internal/outbox"
+	"github.com/ApeironFoundation/axle/bff/internal/ratelimit"
 	"github.com/ApeironFoundation/axle/bff/internal/redisclient"
+	"github.com/ApeironFoundation/axle/bff/internal/trash"
+	"github.com/ApeironFoundation/axle/contracts/go/ai/v1/gen_ai_v1connect"
 	"github.com/ApeironFoundation/axle/contracts/go/bff/v1/gen_bff_v1connect"
 	"github.com/ApeironFoundation/axle/contracts/go/test/v1/gen_test_v1connect"
 )
@@ -33,6 +47,7 @@ func main() {
 	// ── Structured logging ───────────────────────────────────────────────────
 	log.Logger = log.Output(zerolog.ConsoleWriter{Out: os.Stderr, TimeFormat: time.RFC3339})
 	zerolog.TimeFieldFormat = zerolog.TimeFormatUnix
+	zerolog.DefaultContextLogger = &log.Logger
 
 	// ── Config ───────────────────────────────────────────────────────────────
 	cfg, err := config.Load()
@@ -76,58 +91,213 @@ func main() {
 	defer natsConns.NC.Drain() //nolint:errcheck
 	log.Info().Msg("nats connected")
 
+	// ── Event stream, cache ──────────────────────────────────────────────────
+	if err := outbox.EnsureStream(ctx, natsConns.JS); err != nil {
+		log.Fatal().Err(err).Msg("jetstream setup failed")
+	}
+	rowCache := &cache.Cache{Redis: rdb, TTL: cfg.CacheTTL}
+	cacheEvents, err := rowCache.Follow(ctx, natsConns.JS)
+	if err != nil {
+		log.Fatal().Err(err).Msg("cache invalidation follow failed")
+	}
+	defer cacheEvents.Stop()
+
+	// ── Background workers: event outbox relay, trash purger ─────────────────
+	workerCtx, stopWorkers := context.WithCancel(context.Background())
+	var workers sync.WaitGroup
+	workers.Go(func() {
+		(&outbox.Relay{Pool: pool, JS: natsConns.JS, Interval: cfg.OutboxPollInterval}).Run(workerCtx)
+	})
+	workers.Go(func() {
+		(&trash.Purger{Pool: pool, Retention: cfg.TrashRetention, Interval: cfg.TrashPurgeInterval}).Run(workerCtx)
+	})
+
 	// ── Enterprise registry (OSS no-op) ──────────────────────────────────────
 	_ = enterprise.NewRegistry()
 
+	// ── Authentication & authorization ───────────────────────────────────────
+	// Personal access tokens are accepted alongside OIDC sessions.
+	revocations := &auth.RevocationCache{Redis: rdb}
+	var (
+		authn *auth.Authenticator
+		az    *authz.Authorizer
+	)
+	switch {
+	case cfg.OIDCIssuerURL != "":
+		verifier, err := auth.NewVerifier(ctx, auth.OIDCConfig{
+			IssuerURL: cfg.OIDCIssuerURL,
+			Audience:  cfg.OIDCAudience,
+			JWKSURL:   cfg.OIDCJWKSURL,
+		})
+		if err != nil {
+			log.Fatal().Err(err).Msg("oidc setup failed")
+		}
+		authn = &auth.Authenticator{
+			Verifier:                  verifier,
+			Pool:                      pool,
+			Revocations:               revocations,
+			Users:                     rowCache,
+			AllowMissingEmailVerified: cfg.OIDCAllowMissingEmailVerified,
+		}
+		az = &authz.Authorizer{Pool: pool, Policy: authz.DefaultPolicy}
+		log.Info().Str("issuer", cfg.OIDCIssuerURL).Str("audience", cfg.OIDCAudience).Msg("oidc auth enabled")
+	case cfg.EnableDev:
+		log.Warn().Msg("DEV-ONLY: OIDC_ISSUER_URL is empty, RPCs are served without authentication")
+	default:
+		log.Fatal().Msg("OIDC_ISSUER_URL is required")
+	}
+
+	// ── Invitations & mail ───────────────────────────────────────────────────
+	var signer *invitation.Signer
+	switch {
+	case len(cfg.InvitationSecret) >= 32:
+		signer = &invitation.Signer{Key: []byte(cfg.InvitationSecret)}
+	case cfg.InvitationSecret == "" && cfg.EnableDev:
+		// Links from a previous run stop verifying after a restart.
+		key := make([]byte, 32)
+		_, _ = rand.Read(key)
+		signer = &invitation.Signer{Key: key}
+		log.Warn().Msg("DEV-ONLY: INVITATION_SECRET is empty, invitation links are signed with a random key")
+	default:
+		log.Fatal().Msg("INVITATION_SECRET must be at least 32 characters")
+	}
+	var mail mailer.Mailer
+	switch {
+	case cfg.SMTPAddr != "":
+		mail = &mailer.SMTP{
+			Addr:     cfg.SMTPAddr,
+			From:     cfg.SMTPFrom,
+			Username: cfg.SMTPUsername,
+			Password: cfg.SMTPPassword,
+		}
+		log.Info().Str("addr", cfg.SMTPAddr).Msg("smtp mailer enabled")
+	case cfg.EnableDev:
+		mail = mailer.Log{}
+		log.Warn().Msg("DEV-ONLY: SMTP_ADDR is empty, emails are logged instead of sent")
+	default:
+		log.Fatal().Msg("SMTP_ADDR is required")
+	}
+
+	// ── Rate limiting ────────────────────────────────────────────────────────
+	defaultLimits, err := ratelimit.ParseRoleLimits(cfg.RateLimits)
+	if err != nil {
+		log.Fatal().Err(err).Msg("invalid RATE_LIMITS")
+	}
+	expensiveLimits, err := ratelimit.ParseRoleLimits(cfg.RateLimitsExpensive)
+	if err != nil {
+		log.Fatal().Err(err).Msg("invalid RATE_LIMITS_EXPENSIVE")
+	}
+	limiter := &ratelimit.Limiter{
+		Redis: rdb,
+		Limits: map[ratelimit.Class]ratelimit.RoleLimits{
+			ratelimit.ClassDefault:   defaultLimits,
+			ratelimit.ClassExpensive: expensiveLimits,
+		},
+		Classes: ratelimit.DefaultClasses,
+	}
+
 	// ── Health checker ───────────────────────────────────────────────────────
 	checker := health.NewChecker(pool, rdb, natsConns.NC)
 
 	// ── Router ───────────────────────────────────────────────────────────────
 	r := chi.NewRouter()
 
-	// Global middleware
+	// Global middleware. RPCs are logged by the interceptor chain instead of
+	// middleware.Logger, which would see a whole stream as one request.
 	r.Use(middleware.Recovery)
 	r.Use(middleware.RequestID)
-	r.Use(middleware.Logger)
 	r.Use(cors.New(cors.Options{
 		AllowedOrigins: []string{"*"},
 		AllowedMethods: []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"},
 		AllowedHeaders: []string{
 			"Authorization", "Content-Type", "Connect-Protocol-Version",
-			"Connect-Timeout-Ms", "Grpc-Timeout", "X-Request-Id",
+			"Connect-Timeout-Ms", "Grpc-Timeout", "X-Request-Id", "Idempotency-Key",
+		},
+		ExposedHeaders: []string{
+			"Grpc-Status", "Grpc-Message", "Connect-Status", "Retry-After", "Idempotent-Replayed",
 		},
-		ExposedHeaders: []string{"Grpc-Status", "Grpc-Message", "Connect-Status"},
 	}).Handler)
 
-	// Infrastructure endpoints (no auth)
-	r.Get("/health", checker.HealthHandler)
-	r.Get("/ready", checker.ReadyHandler)
+	// Infrastructure endpoints (no auth).
+	r.Group(func(r chi.Router) {
+		r.Use(middleware.Logger)
+		r.Get("/health", checker.HealthHandler)
+		r.Get("/ready", checker.ReadyHandler)
+	})
 
-	// ConnectRPC handlers (served over HTTP/2 h2c, no auth middleware for smoke test)
+	// ConnectRPC handlers (served over HTTP/2 h2c). bff.v1 and ai.v1 require a
+	// bearer token, are rate limited, enforce authz.DefaultPolicy and honour
+	// Idempotency-Key; the dev-only test.v1 service does none of that.
+	rpcOpts := interceptor.HandlerOptions(interceptor.Chain{
+		Authn:       authn,
+		Authz:       az,
+		RateLimit:   limiter,
+		Idempotency: &idempotency.Store{Redis: rdb, TTL: cfg.IdempotencyTTL},
+		Replayable:  idempotency.DefaultReplayable,
+	})
 	connectMux := http.NewServeMux()
 	connectMux.Handle(gen_bff_v1connect.NewProjectServiceHandler(
-		&handler.ProjectsHandler{Pool: pool},
+		&handler.ProjectsHandler{Pool: pool, Cache: rowCache, TrashRetention: cfg.TrashRetention}, rpcOpts,
+	))
+	connectMux.Handle(gen_bff_v1connect.NewProjectMemberServiceHandler(
+		&handler.ProjectMembersHandler{Pool: pool}, rpcOpts,
 	))
 	connectMux.Handle(gen_bff_v1connect.NewUserServiceHandler(
-		&handler.UsersHandler{},
+		&handler.UsersHandler{Pool: pool, Cache: rowCache, Revocations: revocations}, rpcOpts,
 	))
+	connectMux.Handle(gen_bff_v1connect.NewAccessTokenServiceHandler(
+		&handler.AccessTokensHandler{Pool: pool, Revocations: revocations}, rpcOpts,
+	))
+	connectMux.Handle(gen_bff_v1connect.NewInvitationServiceHandler(
+		&handler.InvitationsHandler{
+			Pool:   pool,
+			Signer: signer,
+			Mailer: mail,
+			TTL:    cfg.InvitationTTL,
+			AppURL: cfg.AppURL,
+		}, rpcOpts,
+	))
+	auditHandler := &handler.AuditHandler{Pool: pool}
+	connectMux.Handle(gen_bff_v1connect.NewAuditServiceHandler(auditHandler, rpcOpts))
+	if cfg.LLMURL != "" {
+		// AI runs take the expensive rate-limit class; the LLM service only
+		// accepts them from here.
+		connectMux.Handle(gen_ai_v1connect.NewAITaskServiceHandler(
+			&handler.AITasksHandler{
+				LLM:   gen_ai_v1connect.NewAITaskServiceClient(http.DefaultClient, cfg.LLMURL),
+				Token: cfg.LLMInternalToken,
+			}, rpcOpts,
+		))
+		log.Info().Str("url", cfg.LLMURL).Msg("ai tasks are forwarded to the llm service")
+	} else {
+		log.Warn().Msg("LLM_URL is empty, ai.v1 is not served")
+	}
 	if cfg.EnableDev {
 		log.Warn().Msg("DEV-ONLY endpoint enabled: test.v1.TestService/Ping")
 		connectMux.Handle(gen_test_v1connect.NewTestServiceHandler(
-			&handler.TestPingHandler{NATS: natsConns.NC},
+			&handler.TestPingHandler{NATS: natsConns.NC}, interceptor.HandlerOptions(interceptor.Chain{}),
 		))
 	}
 
 	// Route all ConnectRPC traffic: /bff.v1.<Service>/<Method>
 	r.HandleFunc("/bff.v1.*", connectMux.ServeHTTP)
 	r.HandleFunc("/test.v1.*", connectMux.ServeHTTP)
-
-	// API routes (auth middleware applied)
-	r.Group(func(r chi.Router) {
-		r.Use(middleware.Auth)
-		// TODO: authenticated REST endpoints
+	// AI runs stream for as long as the model takes, past the server's
+	// WriteTimeout.
+	r.HandleFunc("/ai.v1.*", func(w http.ResponseWriter, req *http.Request) {
+		_ = http.NewResponseController(w).SetWriteDeadline(time.Time{})
+		connectMux.ServeHTTP(w, req)
 	})
 
+	// NDJSON export of the audit log for SIEM ingestion, guarded by the same
+	// policy table as the RPCs.
+	r.With(middleware.Logger, middleware.Authorize(authn, az, authz.AuditExportRoute)).
+		Get(authz.AuditExportRoute, auditHandler.Export)
+
+	// Expvars such as the bff_cache hit and miss counters, for global admins.
+	r.With(middleware.Logger, middleware.Authorize(authn, az, authz.DebugVarsRoute)).
+		Get(authz.DebugVarsRoute, expvar.Handler().ServeHTTP)
+
 	// ── HTTP server ──────────────────────────────────────────────────────────
 	addr := fmt.Sprintf(":%d", cfg.Port)
 	srv := &http.Server{
@@ -156,5 +326,9 @@ func main() {
 	if err := srv.Shutdown(shutCtx); err != nil {
 		log.Error().Err(err).Msg("graceful shutdown failed")
 	}
+	// Stop the workers after the last handler has committed; whatever the
+	// relay has not published yet stays in the outbox for the next start.
+	stopWorkers()
+	workers.Wait()
 	log.Info().Msg("bff stopped")
 }
@@ -0,0 +1,153 @@
+// Package audit appends entries to the audit_log table. Handlers call Record
+// inside the transaction that makes a change, so the log holds exactly the
+// changes that committed.
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"reflect"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/ApeironFoundation/axle/bff/internal/auth"
+	"github.com/ApeironFoundation/axle/bff/internal/middleware"
+	gendb "github.com/ApeironFoundation/axle/db/generated"
+)
+
+// Entity types.
+const (
+	EntityProject       = "project"
+	EntityProjectMember = "project_member"
+	EntityUser          = "user"
+	EntityAccessToken   = "access_token"
+	EntityInvitation    = "invitation"
+)
+
+// Change holds a field's value before and after a call. A nil side means the
+// field was absent, as on a create or delete.
+type Change struct {
+	Before any `json:"before"`
+	After  any `json:"after"`
+}
+
+// ignored fields move on every write and would only add noise; the entry's
+// own timestamp says when the change happened.
+var ignored = map[string]bool{
+	"created_at":   true,
+	"updated_at":   true,
+	"etag":         true,
+	"last_used_at": true,
+}
+
+// Record appends an entry for the change the current RPC made to an entity.
+// before and after are its state around the change, nil for the missing side
+// of a create or delete. Proto messages are compared by their JSON fields,
+// anything else by its encoding/json form.
+func Record(ctx context.Context, q *gendb.Queries, entity string, id pgtype.UUID, before, after any) error {
+	changes, err := Diff(before, after)
+	if err != nil {
+		return fmt.Errorf("audit %s: %w", entity, err)
+	}
+	b, err := json.Marshal(changes)
+	if err != nil {
+		return fmt.Errorf("audit %s: %w", entity, err)
+	}
+
+	params := gendb.InsertAuditEventParams{
+		EntityType: entity,
+		EntityID:   id,
+		Changes:    b,
+		RequestID:  middleware.RequestIDFromContext(ctx),
+	}
+	if p, ok := auth.PrincipalFromContext(ctx); ok {
+		params.ActorID = pgtype.UUID{Bytes: p.UserID, Valid: true}
+		if p.IsToken() {
+			params.ActorTokenID = pgtype.UUID{Bytes: p.TokenID, Valid: true}
+		}
+	}
+	if call, ok := connect.CallInfoForHandlerContext(ctx); ok {
+		params.Procedure = call.Spec().Procedure
+		params.ClientIP = clientIP(call.Peer().Addr)
+	}
+	return q.InsertAuditEvent(ctx, params)
+}
+
+// Diff returns the fields that differ between before and after. Nested
+// objects are flattened into dotted paths, so a single changed setting shows
+// up as "settings.<key>" rather than the whole settings document.
+func Diff(before, after any) (map[string]Change, error) {
+	b, err := flatten(before)
+	if err != nil {
+		return nil, err
+	}
+	a, err := flatten(after)
+	if err != nil {
+		return nil, err
+	}
+	changes := map[string]Change{}
+	for k, bv := range b {
+		if av, ok := a[k]; !ok || !reflect.DeepEqual(av, bv) {
+			changes[k] = Change{Before: bv, After: av}
+		}
+	}
+	for k, av := range a {
+		if _, ok := b[k]; !ok {
+			changes[k] = Change{After: av}
+		}
+	}
+	return changes, nil
+}
+
+var snapshotJSON = protojson.MarshalOptions{UseProtoNames: true, EmitUnpopulated: true}
+
+func flatten(v any) (map[string]any, error) {
+	flat := map[string]any{}
+	if v == nil {
+		return flat, nil
+	}
+	var (
+		raw []byte
+		err error
+	)
+	if m, ok := v.(proto.Message); ok {
+		raw, err = snapshotJSON.Marshal(m)
+	} else {
+		raw, err = json.Marshal(v)
+	}
+	if err != nil {
+		return nil, err
+	}
+	var doc map[string]any
+	if err := json.Unmarshal(raw, &doc); err != nil {
+		return nil, err
+	}
+	flattenInto(flat, "", doc)
+	return flat, nil
+}
+
+func flattenInto(flat map[string]any, prefix string, doc map[string]any) {
+	for k, v := range doc {
+		if prefix == "" && ignored[k] {
+			continue
+		}
+		if nested, ok := v.(map[string]any); ok && len(nested) > 0 {
+			flattenInto(flat, prefix+k+".", nested)
+			continue
+		}
+		flat[prefix+k] = v
+	}
+}
+
+// clientIP strips the port from a peer address.
+func clientIP(addr string) string {
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		return host
+	}
+	return addr
+}
@@ -0,0 +1,193 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog/log"
+
+	"github.com/ApeironFoundation/axle/bff/internal/cache"
+	gendb "github.com/ApeironFoundation/axle/db/generated"
+)
+
+// pgUniqueViolation is the SQLSTATE for unique_violation.
+const pgUniqueViolation = "23505"
+
+// errIdentityConflict means the token's email address belongs to a user that
+// is linked to a different OIDC identity.
+var errIdentityConflict = errors.New("email address is linked to another identity")
+
+// deactivatedError rejects every credential of a deactivated user.
+func deactivatedError() error {
+	return connect.NewError(connect.CodePermissionDenied, errors.New("account is deactivated"))
+}
+
+// Authenticator turns a bearer token into a Principal. OIDC tokens are matched
+// to users by their (iss, sub) identity and provision a users row the first
+// time an identity signs in; tokens starting with PATPrefix are personal
+// access tokens.
+type Authenticator struct {
+	Verifier *Verifier
+	Pool     *pgxpool.Pool
+	// Revocations, when set, is consulted before trusting a cached token.
+	Revocations *RevocationCache
+	// Users resolves the role and status of a cached token's owner on every
+	// request. A nil cache reads them from Postgres.
+	Users *cache.Cache
+	// AllowMissingEmailVerified accepts tokens without an email_verified
+	// claim, for issuers that only hand out verified addresses but never say
+	// so. Off by default: a first sign-in adopts the user an admin created for
+	// the address, so trusting an unverified one would let its holder take
+	// over that account.
+	AllowMissingEmailVerified bool
+
+	tokens patCache
+	// users overrides the Pool-backed queries used for provisioning.
+	users userStore
+}
+
+// userStore is the part of gendb.Queries that provisioning needs.
+type userStore interface {
+	GetUserByIdentity(ctx context.Context, arg gendb.GetUserByIdentityParams) (gendb.User, error)
+	GetUserByEmail(ctx context.Context, email string) (gendb.User, error)
+	CreateUserWithIdentity(ctx context.Context, arg gendb.CreateUserWithIdentityParams) (gendb.User, error)
+	LinkUserIdentity(ctx context.Context, arg gendb.LinkUserIdentityParams) (gendb.User, error)
+}
+
+// Authenticate verifies the token from an Authorization header value.
+// Failures are returned as ConnectRPC errors ready to send to the client.
+func (a *Authenticator) Authenticate(ctx context.Context, header string) (Principal, error) {
+	raw, ok := bearerToken(header)
+	if !ok {
+		return Principal{}, connect.NewError(connect.CodeUnauthenticated, errors.New("missing bearer token"))
+	}
+	if strings.HasPrefix(raw, PATPrefix) {
+		return a.authenticatePAT(ctx, raw)
+	}
+	claims, err := a.Verifier.Verify(ctx, raw)
+	if err != nil {
+		log.Ctx(ctx).Debug().Err(err).Msg("bearer token rejected")
+		return Principal{}, connect.NewError(connect.CodeUnauthenticated, errors.New("invalid bearer token"))
+	}
+	if !a.emailVerified(claims) {
+		return Principal{}, connect.NewError(connect.CodeUnauthenticated, errors.New("token has no verified email"))
+	}
+
+	u, err := a.provision(ctx, claims)
+	if errors.Is(err, errIdentityConflict) {
+		log.Ctx(ctx).Warn().Str("iss", claims.Issuer).Str("sub", claims.Subject).Msg("email address is linked to another identity")
+		return Principal{}, connect.NewError(connect.CodePermissionDenied, errIdentityConflict)
+	}
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Str("sub", claims.Subject).Msg("user provisioning failed")
+		return Principal{}, connect.NewError(connect.CodeInternal, errors.New("user provisioning failed"))
+	}
+	if u.Status != gendb.UserStatusActive {
+		return Principal{}, deactivatedError()
+	}
+	return Principal{
+		UserID:  u.ID.Bytes,
+		Subject: claims.Subject,
+		Email:   u.Email,
+		Role:    u.Role,
+	}, nil
+}
+
+// emailVerified reports whether the token vouches for its email address.
+func (a *Authenticator) emailVerified(c Claims) bool {
+	switch {
+	case c.Email == "":
+		return false
+	case c.EmailVerified == nil:
+		return a.AllowMissingEmailVerified
+	default:
+		return *c.EmailVerified
+	}
+}
+
+// provision returns the user linked to the token's identity. On the
+// identity's first sign-in it adopts an unlinked user with the token's email,
+// as created by an admin, or creates a new one.
+func (a *Authenticator) provision(ctx context.Context, c Claims) (gendb.User, error) {
+	var q userStore = a.users
+	if q == nil {
+		q = gendb.New(a.Pool)
+	}
+	u, err := q.GetUserByIdentity(ctx, gendb.GetUserByIdentityParams{OidcIssuer: &c.Issuer, OidcSubject: &c.Subject})
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return u, err
+	}
+
+	u, err = q.GetUserByEmail(ctx, c.Email)
+	if err == nil {
+		return link(ctx, q, u, c)
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return u, err
+	}
+
+	name := strings.TrimSpace(c.Name)
+	if name == "" {
+		name, _, _ = strings.Cut(c.Email, "@")
+	}
+	u, err = q.CreateUserWithIdentity(ctx, gendb.CreateUserWithIdentityParams{
+		Name:        name,
+		Email:       c.Email,
+		Role:        gendb.UserRoleMember,
+		Locale:      "en",
+		Preferences: []byte("{}"),
+		OidcIssuer:  &c.Issuer,
+		OidcSubject: &c.Subject,
+	})
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == pgUniqueViolation {
+		// A concurrent request created a user for the identity or the address.
+		return relink(ctx, q, c)
+	}
+	if err == nil {
+		log.Ctx(ctx).Info().Str("user_id", u.ID.String()).Str("sub", c.Subject).Msg("provisioned user")
+	}
+	return u, err
+}
+
+// link binds the token's identity to u, which has the token's email address.
+func link(ctx context.Context, q userStore, u gendb.User, c Claims) (gendb.User, error) {
+	if u.OidcSubject != nil {
+		// Linked, and not to this identity, or the first lookup would have found it.
+		return gendb.User{}, errIdentityConflict
+	}
+	linked, err := q.LinkUserIdentity(ctx, gendb.LinkUserIdentityParams{ID: u.ID, OidcIssuer: &c.Issuer, OidcSubject: &c.Subject})
+	var pgErr *pgconn.PgError
+	if errors.Is(err, pgx.ErrNoRows) || (errors.As(err, &pgErr) && pgErr.Code == pgUniqueViolation) {
+		// A concurrent first sign-in linked the user or the identity first.
+		return relink(ctx, q, c)
+	}
+	if err == nil {
+		log.Ctx(ctx).Info().Str("user_id", linked.ID.String()).Str("sub", c.Subject).Msg("linked identity to existing user")
+	}
+	return linked, err
+}
+
+// relink resolves a lost race on the identity or the address: the identity
+// now has a user, or the address went to another one.
+func relink(ctx context.Context, q userStore, c Claims) (gendb.User, error) {
+	u, err := q.GetUserByIdentity(ctx, gendb.GetUserByIdentityParams{OidcIssuer: &c.Issuer, OidcSubject: &c.Subject})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return gendb.User{}, errIdentityConflict
+	}
+	return u, err
+}
+
+func bearerToken(header string) (string, bool) {
+	scheme, token, ok := strings.Cut(header, " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") {
+		return "", false
+	}
+	token = strings.TrimSpace(token)
+	return token, token != ""
+}
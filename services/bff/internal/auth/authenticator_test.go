@@ -0,0 +1,298 @@
+package auth
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	gendb "github.com/ApeironFoundation/axle/db/generated"
+)
+
+// fakeUsers is an in-memory userStore keyed by email, like the unique index
+// on users.email. Identities are looked up by scanning, like the partial
+// unique index on (oidc_issuer, oidc_subject).
+type fakeUsers struct {
+	mu      sync.Mutex
+	byEmail map[string]gendb.User
+	created []gendb.CreateUserWithIdentityParams
+	linked  []gendb.LinkUserIdentityParams
+	// race, when set, is inserted just before a create or link runs, as if a
+	// concurrent first request had won.
+	race *gendb.User
+}
+
+func newFakeUsers(users ...gendb.User) *fakeUsers {
+	f := &fakeUsers{byEmail: make(map[string]gendb.User)}
+	for _, u := range users {
+		f.byEmail[u.Email] = u
+	}
+	return f
+}
+
+func (f *fakeUsers) GetUserByIdentity(_ context.Context, arg gendb.GetUserByIdentityParams) (gendb.User, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for _, u := range f.byEmail {
+		if u.OidcSubject != nil && *u.OidcIssuer == *arg.OidcIssuer && *u.OidcSubject == *arg.OidcSubject {
+			return u, nil
+		}
+	}
+	return gendb.User{}, pgx.ErrNoRows
+}
+
+func (f *fakeUsers) GetUserByEmail(_ context.Context, email string) (gendb.User, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	u, ok := f.byEmail[email]
+	if !ok {
+		return gendb.User{}, pgx.ErrNoRows
+	}
+	return u, nil
+}
+
+func (f *fakeUsers) CreateUserWithIdentity(_ context.Context, arg gendb.CreateUserWithIdentityParams) (gendb.User, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.lose()
+	if _, ok := f.byEmail[arg.Email]; ok {
+		return gendb.User{}, &pgconn.PgError{Code: pgUniqueViolation}
+	}
+	f.created = append(f.created, arg)
+	u := testUser(arg.Email, arg.Role, gendb.UserStatusActive)
+	u.Name = arg.Name
+	u.OidcIssuer, u.OidcSubject = arg.OidcIssuer, arg.OidcSubject
+	f.byEmail[arg.Email] = u
+	return u, nil
+}
+
+func (f *fakeUsers) LinkUserIdentity(_ context.Context, arg gendb.LinkUserIdentityParams) (gendb.User, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.lose()
+	for email, u := range f.byEmail {
+		if u.ID != arg.ID {
+			continue
+		}
+		if u.OidcSubject != nil {
+			return gendb.User{}, pgx.ErrNoRows
+		}
+		f.linked = append(f.linked, arg)
+		u.OidcIssuer, u.OidcSubject = arg.OidcIssuer, arg.OidcSubject
+		f.byEmail[email] = u
+		return u, nil
+	}
+	return gendb.User{}, pgx.ErrNoRows
+}
+
+// lose applies the pending race, if any. f.mu must be held.
+func (f *fakeUsers) lose() {
+	if f.race != nil {
+		f.byEmail[f.race.Email] = *f.race
+		f.race = nil
+	}
+}
+
+func testUser(email string, role gendb.UserRole, status gendb.UserStatus) gendb.User {
+	return gendb.User{
+		ID:     pgtype.UUID{Bytes: uuid.New(), Valid: true},
+		Email:  email,
+		Name:   "existing",
+		Role:   role,
+		Status: status,
+	}
+}
+
+// withIdentity returns u linked to the OIDC identity (iss, sub).
+func withIdentity(u gendb.User, iss, sub string) gendb.User {
+	u.OidcIssuer, u.OidcSubject = &iss, &sub
+	return u
+}
+
+func newTestAuthenticator(t *testing.T, users *fakeUsers) (*Authenticator, *testIssuer) {
+	t.Helper()
+	iss := newTestIssuer(t)
+	return &Authenticator{Verifier: iss.verifier(t.Context()), users: users}, iss
+}
+
+func TestAuthenticateProvisionsNewUser(t *testing.T) {
+	users := newFakeUsers()
+	a, iss := newTestAuthenticator(t, users)
+
+	p, err := a.Authenticate(t.Context(), "Bearer "+iss.sign("k1", map[string]any{"name": "  "}))
+	if err != nil {
+		t.Fatalf("Authenticate: %v", err)
+	}
+	if len(users.created) != 1 {
+		t.Fatalf("created %d users, want 1", len(users.created))
+	}
+	got := users.created[0]
+	if got.Email != "alice@example.com" || got.Name != "alice" || got.Role != gendb.UserRoleMember {
+		t.Errorf("created %+v, want member alice@example.com named after the address", got)
+	}
+	if *got.OidcIssuer != iss.url() || *got.OidcSubject != "subject-1" {
+		t.Errorf("created with identity (%s, %s), want (%s, subject-1)", *got.OidcIssuer, *got.OidcSubject, iss.url())
+	}
+	if p.Email != "alice@example.com" || p.Subject != "subject-1" || p.Role != gendb.UserRoleMember || p.IsToken() {
+		t.Errorf("principal = %+v", p)
+	}
+
+	// The next sign-in finds the same user.
+	p2, err := a.Authenticate(t.Context(), "Bearer "+iss.sign("k1", nil))
+	if err != nil {
+		t.Fatalf("second Authenticate: %v", err)
+	}
+	if p2.UserID != p.UserID || len(users.created) != 1 {
+		t.Errorf("second sign-in got user %s after %d creates, want %s after 1", p2.UserID, len(users.created), p.UserID)
+	}
+}
+
+func TestAuthenticateLinksExistingUser(t *testing.T) {
+	admin := testUser("alice@example.com", gendb.UserRoleAdmin, gendb.UserStatusActive)
+	users := newFakeUsers(admin)
+	a, iss := newTestAuthenticator(t, users)
+
+	p, err := a.Authenticate(t.Context(), "Bearer "+iss.sign("k1", nil))
+	if err != nil {
+		t.Fatalf("Authenticate: %v", err)
+	}
+	if p.UserID != admin.ID.Bytes || p.Role != gendb.UserRoleAdmin {
+		t.Errorf("principal = %+v, want the existing admin", p)
+	}
+	if len(users.created) != 0 || len(users.linked) != 1 {
+		t.Errorf("created %d and linked %d users, want 0 and 1", len(users.created), len(users.linked))
+	}
+}
+
+func TestAuthenticateMatchesIdentity(t *testing.T) {
+	users := newFakeUsers()
+	a, iss := newTestAuthenticator(t, users)
+	alice := withIdentity(testUser("alice@example.com", gendb.UserRoleAdmin, gendb.UserStatusActive), iss.url(), "subject-1")
+	users.byEmail[alice.Email] = alice
+
+	// The issuer changed the address; the identity still names the same user.
+	p, err := a.Authenticate(t.Context(), "Bearer "+iss.sign("k1", map[string]any{"email": "alice@new.example.com"}))
+	if err != nil {
+		t.Fatalf("Authenticate: %v", err)
+	}
+	if p.UserID != alice.ID.Bytes {
+		t.Errorf("principal user = %s, want %s", p.UserID, uuid.UUID(alice.ID.Bytes))
+	}
+	if len(users.created) != 0 || len(users.linked) != 0 {
+		t.Errorf("created %d and linked %d users, want none", len(users.created), len(users.linked))
+	}
+}
+
+func TestAuthenticateRefusesAddressOfAnotherIdentity(t *testing.T) {
+	users := newFakeUsers()
+	a, iss := newTestAuthenticator(t, users)
+	tests := []struct {
+		name string
+		user gendb.User
+	}{
+		{"other subject", withIdentity(testUser("alice@example.com", gendb.UserRoleAdmin, gendb.UserStatusActive), iss.url(), "subject-2")},
+		{"other issuer", withIdentity(testUser("alice@example.com", gendb.UserRoleAdmin, gendb.UserStatusActive), "https://other.example.com", "subject-1")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			users.byEmail[tt.user.Email] = tt.user
+			_, err := a.Authenticate(t.Context(), "Bearer "+iss.sign("k1", nil))
+			if connect.CodeOf(err) != connect.CodePermissionDenied {
+				t.Fatalf("err = %v, want PermissionDenied", err)
+			}
+			if len(users.created) != 0 || len(users.linked) != 0 {
+				t.Errorf("created %d and linked %d users, want none", len(users.created), len(users.linked))
+			}
+		})
+	}
+}
+
+func TestAuthenticateConcurrentFirstSignIn(t *testing.T) {
+	users := newFakeUsers()
+	a, iss := newTestAuthenticator(t, users)
+	winner := withIdentity(testUser("alice@example.com", gendb.UserRoleMember, gendb.UserStatusActive), iss.url(), "subject-1")
+	users.race = &winner
+
+	p, err := a.Authenticate(t.Context(), "Bearer "+iss.sign("k1", nil))
+	if err != nil {
+		t.Fatalf("Authenticate: %v", err)
+	}
+	if p.UserID != winner.ID.Bytes {
+		t.Errorf("principal user = %s, want the concurrently created %s", p.UserID, uuid.UUID(winner.ID.Bytes))
+	}
+}
+
+func TestAuthenticateConcurrentLink(t *testing.T) {
+	alice := testUser("alice@example.com", gendb.UserRoleMember, gendb.UserStatusActive)
+	users := newFakeUsers(alice)
+	a, iss := newTestAuthenticator(t, users)
+	linked := withIdentity(alice, iss.url(), "subject-1")
+	users.race = &linked
+
+	p, err := a.Authenticate(t.Context(), "Bearer "+iss.sign("k1", nil))
+	if err != nil {
+		t.Fatalf("Authenticate: %v", err)
+	}
+	if p.UserID != alice.ID.Bytes || len(users.linked) != 0 {
+		t.Errorf("principal user = %s after %d links, want %s linked by the other request", p.UserID, len(users.linked), uuid.UUID(alice.ID.Bytes))
+	}
+}
+
+func TestAuthenticateRejects(t *testing.T) {
+	deactivated := testUser("bob@example.com", gendb.UserRoleMember, gendb.UserStatusDeactivated)
+	tests := []struct {
+		name   string
+		header func(iss *testIssuer) string
+		code   connect.Code
+	}{
+		{"no header", func(*testIssuer) string { return "" }, connect.CodeUnauthenticated},
+		{"not bearer", func(iss *testIssuer) string { return "Basic " + iss.sign("k1", nil) }, connect.CodeUnauthenticated},
+		{"invalid token", func(iss *testIssuer) string {
+			return "Bearer " + iss.sign("k1", map[string]any{"aud": "other"})
+		}, connect.CodeUnauthenticated},
+		{"no email", func(iss *testIssuer) string {
+			return "Bearer " + iss.sign("k1", map[string]any{"email": nil})
+		}, connect.CodeUnauthenticated},
+		{"unverified email", func(iss *testIssuer) string {
+			return "Bearer " + iss.sign("k1", map[string]any{"email_verified": false})
+		}, connect.CodeUnauthenticated},
+		{"missing email_verified", func(iss *testIssuer) string {
+			return "Bearer " + iss.sign("k1", map[string]any{"email_verified": nil})
+		}, connect.CodeUnauthenticated},
+		{"deactivated", func(iss *testIssuer) string {
+			return "Bearer " + iss.sign("k1", map[string]any{"email": "bob@example.com"})
+		}, connect.CodePermissionDenied},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			users := newFakeUsers(deactivated)
+			a, iss := newTestAuthenticator(t, users)
+			_, err := a.Authenticate(t.Context(), tt.header(iss))
+			if got := connect.CodeOf(err); err == nil || got != tt.code {
+				t.Fatalf("err = %v, want code %v", err, tt.code)
+			}
+			if len(users.created) != 0 {
+				t.Errorf("provisioned %d users for a rejected token", len(users.created))
+			}
+		})
+	}
+}
+
+func TestAuthenticateMissingEmailVerifiedOptIn(t *testing.T) {
+	users := newFakeUsers()
+	a, iss := newTestAuthenticator(t, users)
+	a.AllowMissingEmailVerified = true
+
+	if _, err := a.Authenticate(t.Context(), "Bearer "+iss.sign("k1", map[string]any{"email_verified": nil})); err != nil {
+		t.Fatalf("Authenticate without email_verified: %v", err)
+	}
+	_, err := a.Authenticate(t.Context(), "Bearer "+iss.sign("k1", map[string]any{"email_verified": false}))
+	if connect.CodeOf(err) != connect.CodeUnauthenticated {
+		t.Errorf("email_verified=false: err = %v, want Unauthenticated even with the opt-in", err)
+	}
+}
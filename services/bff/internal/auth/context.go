@@ -0,0 +1,42 @@
+// Package auth authenticates callers and carries them through request contexts.
+package auth
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	gendb "github.com/ApeironFoundation/axle/db/generated"
+)
+
+// Principal is the authenticated caller of a request.
+type Principal struct {
+	UserID  uuid.UUID
+	Subject string // "sub" claim of the verified token
+	Email   string
+	Role    gendb.UserRole
+
+	// TokenID and Scopes are set when the caller used a personal access
+	// token; the token only grants the listed scopes.
+	TokenID uuid.UUID
+	Scopes  []string
+}
+
+// IsToken reports whether the caller authenticated with a personal access
+// token rather than an OIDC session.
+func (p Principal) IsToken() bool {
+	return p.TokenID != uuid.Nil
+}
+
+type principalKey struct{}
+
+// WithPrincipal returns a copy of ctx carrying p.
+func WithPrincipal(ctx context.Context, p Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, p)
+}
+
+// PrincipalFromContext returns the caller stored by WithPrincipal, if any.
+func PrincipalFromContext(ctx context.Context) (Principal, bool) {
+	p, ok := ctx.Value(principalKey{}).(Principal)
+	return p, ok
+}
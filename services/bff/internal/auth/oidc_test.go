@@ -0,0 +1,190 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+)
+
+const testAudience = "axle"
+
+// testIssuer is a local stand-in for an OIDC provider: it serves a JWKS and
+// signs tokens with the keys in it.
+type testIssuer struct {
+	t   *testing.T
+	srv *httptest.Server
+
+	mu   sync.Mutex
+	keys map[string]*rsa.PrivateKey
+	hits int
+}
+
+func newTestIssuer(t *testing.T) *testIssuer {
+	t.Helper()
+	iss := &testIssuer{t: t, keys: make(map[string]*rsa.PrivateKey)}
+	iss.addKey("k1")
+	iss.srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		iss.mu.Lock()
+		defer iss.mu.Unlock()
+		iss.hits++
+		var set jose.JSONWebKeySet
+		for kid, k := range iss.keys {
+			set.Keys = append(set.Keys, jose.JSONWebKey{Key: &k.PublicKey, KeyID: kid, Algorithm: "RS256", Use: "sig"})
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(set)
+	}))
+	t.Cleanup(iss.srv.Close)
+	return iss
+}
+
+func (iss *testIssuer) addKey(kid string) *rsa.PrivateKey {
+	iss.t.Helper()
+	k, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		iss.t.Fatal(err)
+	}
+	iss.mu.Lock()
+	iss.keys[kid] = k
+	iss.mu.Unlock()
+	return k
+}
+
+func (iss *testIssuer) url() string { return iss.srv.URL }
+
+func (iss *testIssuer) jwksHits() int {
+	iss.mu.Lock()
+	defer iss.mu.Unlock()
+	return iss.hits
+}
+
+func (iss *testIssuer) verifier(ctx context.Context) *Verifier {
+	iss.t.Helper()
+	v, err := NewVerifier(ctx, OIDCConfig{IssuerURL: iss.url(), Audience: testAudience, JWKSURL: iss.url()})
+	if err != nil {
+		iss.t.Fatal(err)
+	}
+	return v
+}
+
+// sign returns a token signed with key kid. extra is merged into the claims,
+// overriding the valid defaults.
+func (iss *testIssuer) sign(kid string, extra map[string]any) string {
+	iss.t.Helper()
+	iss.mu.Lock()
+	key := iss.keys[kid]
+	iss.mu.Unlock()
+	if key == nil {
+		// Unknown to the JWKS: sign with a key the verifier cannot find.
+		k, err := rsa.GenerateKey(rand.Reader, 2048)
+		if err != nil {
+			iss.t.Fatal(err)
+		}
+		key = k
+	}
+	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.RS256, Key: key},
+		(&jose.SignerOptions{}).WithType("JWT").WithHeader("kid", kid))
+	if err != nil {
+		iss.t.Fatal(err)
+	}
+	now := time.Now()
+	claims := map[string]any{
+		"iss":            iss.url(),
+		"aud":            testAudience,
+		"sub":            "subject-1",
+		"iat":            now.Unix(),
+		"exp":            now.Add(time.Hour).Unix(),
+		"email":          "Alice@Example.com ",
+		"email_verified": true,
+		"name":           "Alice",
+	}
+	for k, v := range extra {
+		if v == nil {
+			delete(claims, k)
+			continue
+		}
+		claims[k] = v
+	}
+	raw, err := jwt.Signed(signer).Claims(claims).Serialize()
+	if err != nil {
+		iss.t.Fatal(err)
+	}
+	return raw
+}
+
+func TestVerifierAcceptsValidToken(t *testing.T) {
+	ctx := t.Context()
+	iss := newTestIssuer(t)
+	c, err := iss.verifier(ctx).Verify(ctx, iss.sign("k1", nil))
+	if err != nil {
+		t.Fatalf("Verify: %v", err)
+	}
+	if c.Subject != "subject-1" || c.Name != "Alice" {
+		t.Errorf("claims = %+v", c)
+	}
+	if c.Email != "alice@example.com" {
+		t.Errorf("email = %q, want it trimmed and lowercased", c.Email)
+	}
+	if c.EmailVerified == nil || !*c.EmailVerified {
+		t.Errorf("email_verified = %v, want true", c.EmailVerified)
+	}
+}
+
+func TestVerifierRejectsBadTokens(t *testing.T) {
+	ctx := t.Context()
+	iss := newTestIssuer(t)
+	v := iss.verifier(ctx)
+	tests := map[string]string{
+		"expired":      iss.sign("k1", map[string]any{"exp": time.Now().Add(-time.Minute).Unix()}),
+		"wrong aud":    iss.sign("k1", map[string]any{"aud": "someone-else"}),
+		"wrong issuer": iss.sign("k1", map[string]any{"iss": "https://evil.example"}),
+		"unknown key":  iss.sign("stranger", nil),
+		"garbage":      "not.a.jwt",
+	}
+	for name, raw := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := v.Verify(ctx, raw); err == nil {
+				t.Fatal("Verify succeeded, want error")
+			}
+		})
+	}
+	tampered := iss.sign("k1", nil)
+	tampered = tampered[:len(tampered)-4] + strings.Repeat("A", 4)
+	if _, err := v.Verify(ctx, tampered); err == nil {
+		t.Error("tampered signature: Verify succeeded, want error")
+	}
+}
+
+func TestVerifierRefetchesKeysOnRotation(t *testing.T) {
+	ctx := t.Context()
+	iss := newTestIssuer(t)
+	v := iss.verifier(ctx)
+	if _, err := v.Verify(ctx, iss.sign("k1", nil)); err != nil {
+		t.Fatalf("Verify with k1: %v", err)
+	}
+	hits := iss.jwksHits()
+	if _, err := v.Verify(ctx, iss.sign("k1", nil)); err != nil {
+		t.Fatalf("Verify with k1 again: %v", err)
+	}
+	if got := iss.jwksHits(); got != hits {
+		t.Errorf("JWKS fetched %d more times for a cached key", got-hits)
+	}
+
+	iss.addKey("k2")
+	if _, err := v.Verify(ctx, iss.sign("k2", nil)); err != nil {
+		t.Fatalf("Verify with rotated key: %v", err)
+	}
+	if got := iss.jwksHits(); got == hits {
+		t.Error("JWKS not refetched for an unknown key ID")
+	}
+}
@@ -0,0 +1,186 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/redis/go-redis/v9"
+	"github.com/rs/zerolog/log"
+
+	gendb "github.com/ApeironFoundation/axle/db/generated"
+)
+
+// Personal access tokens are "axle_pat_" followed by 32 random bytes in
+// unpadded base64url. Postgres only stores their SHA-256 hash; the secret has
+// enough entropy that a slow password hash buys nothing.
+const (
+	PATPrefix = "axle_pat_"
+
+	patSecretBytes = 32
+	// patDisplayLen is how much of the token is kept as its visible prefix.
+	patDisplayLen = len(PATPrefix) + 4
+
+	// patCacheTTL bounds how long a verified token is trusted without going
+	// back to Postgres. It is also the granularity of last_used_at. The
+	// owner's role and status are not cached with it; see Authenticator.Users.
+	patCacheTTL = time.Minute
+	// patCacheMax caps the in-process cache; it is flushed when full.
+	patCacheMax = 10_000
+)
+
+// NewPAT returns a fresh token, its display prefix and the hash to store.
+func NewPAT() (token, prefix string, hash []byte, err error) {
+	b := make([]byte, patSecretBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", "", nil, fmt.Errorf("generate token: %w", err)
+	}
+	token = PATPrefix + base64.RawURLEncoding.EncodeToString(b)
+	return token, token[:patDisplayLen], HashPAT(token), nil
+}
+
+// HashPAT returns the stored form of a token.
+func HashPAT(token string) []byte {
+	sum := sha256.Sum256([]byte(token))
+	return sum[:]
+}
+
+// ── Revocation ────────────────────────────────────────────────────────────────
+
+// revocationTTL is how long a revocation marker lives. Markers only need to
+// outlive the in-process token caches of every replica; after that the
+// revoked_at column in Postgres rejects the token.
+const revocationTTL = 24 * time.Hour
+
+// RevocationCache shares token revocations between BFF replicas through Redis,
+// so a revoked token is refused right away even where it is still cached.
+type RevocationCache struct {
+	Redis *redis.Client
+}
+
+// Revoke marks the token with the given hash as revoked.
+func (c *RevocationCache) Revoke(ctx context.Context, hash []byte) error {
+	return c.Redis.Set(ctx, revocationKey(hash), 1, revocationTTL).Err()
+}
+
+// IsRevoked reports whether Revoke was called for the token recently.
+func (c *RevocationCache) IsRevoked(ctx context.Context, hash []byte) (bool, error) {
+	n, err := c.Redis.Exists(ctx, revocationKey(hash)).Result()
+	return n > 0, err
+}
+
+func revocationKey(hash []byte) string {
+	return "axle:pat:revoked:" + hex.EncodeToString(hash)
+}
+
+// ── Authentication ────────────────────────────────────────────────────────────
+
+type cachedPAT struct {
+	principal Principal
+	until     time.Time
+}
+
+// patCache holds recently verified tokens, keyed by hash. Entries leave Role
+// unset: a role change must apply to the next request, not the next minute.
+type patCache struct {
+	mu      sync.Mutex
+	entries map[string]cachedPAT
+}
+
+func (c *patCache) get(key string, now time.Time) (Principal, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.entries[key]
+	if !ok || now.After(e.until) {
+		delete(c.entries, key)
+		return Principal{}, false
+	}
+	return e.principal, true
+}
+
+func (c *patCache) put(key string, p Principal, until time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.entries == nil || len(c.entries) >= patCacheMax {
+		c.entries = make(map[string]cachedPAT)
+	}
+	c.entries[key] = cachedPAT{principal: p, until: until}
+}
+
+// authenticatePAT resolves a personal access token to its owner.
+func (a *Authenticator) authenticatePAT(ctx context.Context, token string) (Principal, error) {
+	hash := HashPAT(token)
+	if a.Revocations != nil {
+		revoked, err := a.Revocations.IsRevoked(ctx, hash)
+		if err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("token revocation check failed")
+			return Principal{}, connect.NewError(connect.CodeUnavailable, errors.New("token revocation check failed"))
+		}
+		if revoked {
+			return Principal{}, connect.NewError(connect.CodeUnauthenticated, errors.New("access token has been revoked"))
+		}
+	}
+
+	now := time.Now()
+	key := string(hash)
+	q := gendb.New(a.Pool)
+	if p, ok := a.tokens.get(key, now); ok {
+		return a.withOwner(ctx, q, p)
+	}
+
+	t, err := q.GetActiveAccessToken(ctx, hash)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return Principal{}, connect.NewError(connect.CodeUnauthenticated, errors.New("invalid or expired access token"))
+	}
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("access token lookup failed")
+		return Principal{}, connect.NewError(connect.CodeInternal, errors.New("access token lookup failed"))
+	}
+	if t.Status != gendb.UserStatusActive {
+		return Principal{}, deactivatedError()
+	}
+	if err := q.TouchAccessToken(ctx, t.ID); err != nil {
+		log.Ctx(ctx).Warn().Err(err).Msg("recording access token use failed")
+	}
+
+	p := Principal{
+		UserID:  t.UserID.Bytes,
+		Email:   t.Email,
+		TokenID: t.ID.Bytes,
+		Scopes:  t.Scopes,
+	}
+	until := now.Add(patCacheTTL)
+	if t.ExpiresAt.Valid && t.ExpiresAt.Time.Before(until) {
+		until = t.ExpiresAt.Time
+	}
+	a.tokens.put(key, p, until)
+	p.Role = t.Role
+	return p, nil
+}
+
+// withOwner fills in the role of a cached token's owner, which is read
+// through the versioned user cache so that role changes apply at once.
+func (a *Authenticator) withOwner(ctx context.Context, q *gendb.Queries, p Principal) (Principal, error) {
+	u, err := a.Users.User(ctx, q, pgtype.UUID{Bytes: p.UserID, Valid: true})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return Principal{}, connect.NewError(connect.CodeUnauthenticated, errors.New("invalid or expired access token"))
+	}
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("access token owner lookup failed")
+		return Principal{}, connect.NewError(connect.CodeInternal, errors.New("access token lookup failed"))
+	}
+	if u.Status != gendb.UserStatusActive {
+		return Principal{}, deactivatedError()
+	}
+	p.Role = u.Role
+	return p, nil
+}
@@ -0,0 +1,165 @@
+// Package authz decides whether the authenticated caller may make an RPC,
+// based on the declarative Policy table and the caller's global and
+// per-project roles.
+package authz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/ApeironFoundation/axle/bff/internal/auth"
+	gendb "github.com/ApeironFoundation/axle/db/generated"
+)
+
+// errorDomain is the ErrorInfo domain of permission errors.
+const errorDomain = "axle"
+
+// Authorizer enforces a Policy. Project roles are read from project_members
+// on every check, so membership changes apply to the next call.
+type Authorizer struct {
+	Pool   *pgxpool.Pool
+	Policy Policy
+}
+
+// Authorize checks the caller in ctx against the rule for procedure. Denials
+// are ConnectRPC errors ready to send to the client.
+func (a *Authorizer) Authorize(ctx context.Context, procedure string, req proto.Message) error {
+	p, ok := auth.PrincipalFromContext(ctx)
+	if !ok {
+		return connect.NewError(connect.CodeUnauthenticated, errors.New("authentication required"))
+	}
+	rule, ok := a.Policy[procedure]
+	if !ok {
+		return denied("NO_POLICY", "no access rule is defined for "+procedure, nil)
+	}
+	if p.IsToken() {
+		if rule.Token == "" {
+			return denied("TOKEN_NOT_ALLOWED", "access tokens cannot call "+procedure+"; sign in instead", nil)
+		}
+		if !slices.Contains(p.Scopes, rule.Token) {
+			return denied("TOKEN_SCOPE", "access token lacks the "+rule.Token+" scope",
+				map[string]string{"required_scope": rule.Token})
+		}
+	}
+
+	switch rule.Scope {
+	case ScopeAuthenticated:
+		return nil
+
+	case ScopeGlobal:
+		if !Allows(p.Role, rule.Min) {
+			return denied("INSUFFICIENT_ROLE",
+				fmt.Sprintf("requires the %s role; your account is %s", rule.Min, p.Role),
+				map[string]string{"required_role": string(rule.Min), "role": string(p.Role)})
+		}
+		return nil
+
+	case ScopeSelf:
+		id, err := requestID(req, rule.Field)
+		if err != nil {
+			return err
+		}
+		if id != p.UserID && p.Role != gendb.UserRoleAdmin {
+			return denied("NOT_SELF", "only admins can act on other users", nil)
+		}
+		return nil
+
+	case ScopeProject:
+		id, err := requestID(req, rule.Field)
+		if err != nil {
+			return err
+		}
+		role, ok, err := a.ProjectRole(ctx, p, id)
+		if err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("resolving project role failed")
+			return connect.NewError(connect.CodeInternal, errors.New("internal error"))
+		}
+		if !ok {
+			return denied("NOT_A_MEMBER", "you are not a member of this project",
+				map[string]string{"project_id": id.String()})
+		}
+		if !Allows(role, rule.Min) {
+			return denied("INSUFFICIENT_PROJECT_ROLE",
+				fmt.Sprintf("requires the %s role in this project; you are %s", rule.Min, role),
+				map[string]string{"project_id": id.String(), "required_role": string(rule.Min), "role": string(role)})
+		}
+		return nil
+	}
+	return denied("NO_POLICY", "unsupported access rule for "+procedure, nil)
+}
+
+// ProjectRole returns the caller's effective role in a project: admin for
+// global admins, otherwise their membership role. ok is false when the caller
+// is not a member.
+func (a *Authorizer) ProjectRole(ctx context.Context, p auth.Principal, projectID uuid.UUID) (role gendb.UserRole, ok bool, err error) {
+	if p.Role == gendb.UserRoleAdmin {
+		return gendb.UserRoleAdmin, true, nil
+	}
+	role, err = gendb.New(a.Pool).GetProjectMemberRole(ctx, gendb.GetProjectMemberRoleParams{
+		ProjectID: pgtype.UUID{Bytes: projectID, Valid: true},
+		UserID:    pgtype.UUID{Bytes: p.UserID, Valid: true},
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", false, nil
+	}
+	if err != nil {
+		return "", false, err
+	}
+	return role, true, nil
+}
+
+// Allows reports whether role is at least min (viewer < member < admin).
+func Allows(role, min gendb.UserRole) bool {
+	return rank(role) >= rank(min)
+}
+
+func rank(r gendb.UserRole) int {
+	switch r {
+	case gendb.UserRoleViewer:
+		return 1
+	case gendb.UserRoleMember:
+		return 2
+	case gendb.UserRoleAdmin:
+		return 3
+	}
+	return 0
+}
+
+// requestID reads the UUID in the named string field of req.
+func requestID(req proto.Message, field string) (uuid.UUID, error) {
+	fd := req.ProtoReflect().Descriptor().Fields().ByName(protoreflect.Name(field))
+	if fd == nil || fd.Kind() != protoreflect.StringKind {
+		return uuid.UUID{}, connect.NewError(connect.CodeInternal,
+			fmt.Errorf("access rule names unknown field %q", field))
+	}
+	raw := req.ProtoReflect().Get(fd).String()
+	id, err := uuid.Parse(raw)
+	if err != nil {
+		return uuid.UUID{}, connect.NewError(connect.CodeInvalidArgument,
+			fmt.Errorf("%s: invalid uuid %q", field, raw))
+	}
+	return id, nil
+}
+
+// denied builds a CodePermissionDenied error whose message explains why, with
+// a machine-readable ErrorInfo detail.
+func denied(reason, msg string, metadata map[string]string) error {
+	err := connect.NewError(connect.CodePermissionDenied, errors.New(msg))
+	info := &errdetails.ErrorInfo{Reason: reason, Domain: errorDomain, Metadata: metadata}
+	if detail, derr := connect.NewErrorDetail(info); derr == nil {
+		err.AddDetail(detail)
+	}
+	return err
+}
@@ -0,0 +1,79 @@
+package authz
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+
+	"github.com/ApeironFoundation/axle/bff/internal/auth"
+	aiv1 "github.com/ApeironFoundation/axle/contracts/go/ai/v1"
+	"github.com/ApeironFoundation/axle/contracts/go/ai/v1/gen_ai_v1connect"
+	gendb "github.com/ApeironFoundation/axle/db/generated"
+)
+
+// Global admins pass project rules without a membership lookup, so these
+// tests need no database.
+func TestRunAITaskTokenScope(t *testing.T) {
+	a := &Authorizer{Policy: DefaultPolicy}
+	req := &aiv1.RunAITaskRequest{ProjectId: uuid.NewString(), Type: "summarize"}
+	session := auth.Principal{UserID: uuid.New(), Role: gendb.UserRoleAdmin}
+	token := func(scopes ...string) auth.Principal {
+		p := session
+		p.TokenID = uuid.New()
+		p.Scopes = scopes
+		return p
+	}
+
+	tests := []struct {
+		name   string
+		p      auth.Principal
+		reason string // ErrorInfo reason of the denial; empty when allowed
+	}{
+		{"session", session, ""},
+		{"token with ai:run", token(TokenProjectsRead, TokenAIRun), ""},
+		{"token without ai:run", token(TokenProjectsRead, TokenProjectsWrite), "TOKEN_SCOPE"},
+		{"token without scopes", token(), "TOKEN_SCOPE"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := auth.WithPrincipal(context.Background(), tt.p)
+			err := a.Authorize(ctx, gen_ai_v1connect.AITaskServiceRunAITaskProcedure, req)
+			if tt.reason == "" {
+				if err != nil {
+					t.Fatalf("Authorize: %v", err)
+				}
+				return
+			}
+			if got := denialReason(t, err); got != tt.reason {
+				t.Errorf("denial reason = %q, want %q (err %v)", got, tt.reason, err)
+			}
+		})
+	}
+}
+
+func TestAuthorizeRequiresPrincipal(t *testing.T) {
+	a := &Authorizer{Policy: DefaultPolicy}
+	err := a.Authorize(context.Background(), gen_ai_v1connect.AITaskServiceRunAITaskProcedure, &aiv1.RunAITaskRequest{})
+	if connect.CodeOf(err) != connect.CodeUnauthenticated {
+		t.Errorf("err = %v, want Unauthenticated", err)
+	}
+}
+
+func denialReason(t *testing.T, err error) string {
+	t.Helper()
+	var cerr *connect.Error
+	if !errors.As(err, &cerr) || cerr.Code() != connect.CodePermissionDenied {
+		t.Fatalf("err = %v, want PermissionDenied", err)
+	}
+	for _, d := range cerr.Details() {
+		v, derr := d.Value()
+		if info, ok := v.(*errdetails.ErrorInfo); derr == nil && ok {
+			return info.GetReason()
+		}
+	}
+	return ""
+}
@@ -0,0 +1,110 @@
+package authz
+
+import (
+	"github.com/ApeironFoundation/axle/contracts/go/ai/v1/gen_ai_v1connect"
+	"github.com/ApeironFoundation/axle/contracts/go/bff/v1/gen_bff_v1connect"
+	gendb "github.com/ApeironFoundation/axle/db/generated"
+)
+
+// Scope says what a rule's minimum role is checked against.
+type Scope int
+
+const (
+	// ScopeAuthenticated admits any signed-in caller.
+	ScopeAuthenticated Scope = iota
+	// ScopeGlobal checks the caller's account role (users.role).
+	ScopeGlobal
+	// ScopeProject checks the caller's effective role in the project named by
+	// the request field Field.
+	ScopeProject
+	// ScopeSelf admits the user named by the request field Field, and global
+	// admins acting on anyone.
+	ScopeSelf
+)
+
+// Rule is the access requirement of a single RPC.
+type Rule struct {
+	Scope Scope
+	// Min is the lowest role that passes ScopeGlobal and ScopeProject rules.
+	Min gendb.UserRole
+	// Field is the request field holding the project or user ID.
+	Field string
+	// Token is the personal access token scope that admits the RPC. RPCs
+	// without one are only open to OIDC sessions.
+	Token string
+}
+
+// Scopes a personal access token can be granted.
+const (
+	TokenProjectsRead  = "projects:read"
+	TokenProjectsWrite = "projects:write"
+	TokenUsersRead     = "users:read"
+	TokenAIRun         = "ai:run"
+	TokenAuditRead     = "audit:read"
+)
+
+// AuditExportRoute is the plain HTTP route that exports the audit log as
+// NDJSON. It is not an RPC, but it is guarded by the same table.
+const AuditExportRoute = "/audit/events.ndjson"
+
+// DebugVarsRoute is the plain HTTP route that serves expvars, such as the
+// cache hit and miss counters. Like AuditExportRoute, it is guarded by the
+// table.
+const DebugVarsRoute = "/debug/vars"
+
+// Policy maps a procedure ("/pkg.Service/Method") to its rule. Procedures
+// without an entry are denied.
+type Policy map[string]Rule
+
+// DefaultPolicy is the access table for every RPC the BFF serves. Viewers can
+// read a project, members can change its content and run AI tasks in it, and
+// only project admins manage its membership or delete it. Global admins pass
+// every project rule.
+var DefaultPolicy = Policy{
+	gen_bff_v1connect.ProjectServiceListProjectsProcedure:          {Scope: ScopeAuthenticated, Token: TokenProjectsRead},
+	gen_bff_v1connect.ProjectServiceCreateProjectProcedure:         {Scope: ScopeGlobal, Min: gendb.UserRoleMember, Token: TokenProjectsWrite},
+	gen_bff_v1connect.ProjectServiceGetProjectProcedure:            {Scope: ScopeProject, Min: gendb.UserRoleViewer, Field: "id", Token: TokenProjectsRead},
+	gen_bff_v1connect.ProjectServiceUpdateProjectProcedure:         {Scope: ScopeProject, Min: gendb.UserRoleMember, Field: "id", Token: TokenProjectsWrite},
+	gen_bff_v1connect.ProjectServiceDeleteProjectProcedure:         {Scope: ScopeProject, Min: gendb.UserRoleAdmin, Field: "id", Token: TokenProjectsWrite},
+	gen_bff_v1connect.ProjectServiceListTrashedProjectsProcedure:   {Scope: ScopeAuthenticated, Token: TokenProjectsRead},
+	gen_bff_v1connect.ProjectServiceRestoreProjectProcedure:        {Scope: ScopeProject, Min: gendb.UserRoleAdmin, Field: "id", Token: TokenProjectsWrite},
+	gen_bff_v1connect.ProjectServiceGetProjectSettingsProcedure:    {Scope: ScopeProject, Min: gendb.UserRoleViewer, Field: "project_id", Token: TokenProjectsRead},
+	gen_bff_v1connect.ProjectServiceUpdateProjectSettingsProcedure: {Scope: ScopeProject, Min: gendb.UserRoleMember, Field: "project_id", Token: TokenProjectsWrite},
+
+	gen_bff_v1connect.ProjectMemberServiceListProjectMembersProcedure:      {Scope: ScopeProject, Min: gendb.UserRoleViewer, Field: "project_id", Token: TokenProjectsRead},
+	gen_bff_v1connect.ProjectMemberServiceAddProjectMemberProcedure:        {Scope: ScopeProject, Min: gendb.UserRoleAdmin, Field: "project_id", Token: TokenProjectsWrite},
+	gen_bff_v1connect.ProjectMemberServiceUpdateProjectMemberRoleProcedure: {Scope: ScopeProject, Min: gendb.UserRoleAdmin, Field: "project_id", Token: TokenProjectsWrite},
+	gen_bff_v1connect.ProjectMemberServiceRemoveProjectMemberProcedure:     {Scope: ScopeProject, Min: gendb.UserRoleAdmin, Field: "project_id", Token: TokenProjectsWrite},
+
+	gen_bff_v1connect.UserServiceListUsersProcedure:           {Scope: ScopeAuthenticated, Token: TokenUsersRead},
+	gen_bff_v1connect.UserServiceGetUserProcedure:             {Scope: ScopeAuthenticated, Token: TokenUsersRead},
+	gen_bff_v1connect.UserServiceGetMeProcedure:               {Scope: ScopeAuthenticated, Token: TokenUsersRead},
+	gen_bff_v1connect.UserServiceGetMyAccessProcedure:         {Scope: ScopeAuthenticated, Token: TokenProjectsRead},
+	gen_bff_v1connect.UserServiceUpdateUserProcedure:          {Scope: ScopeSelf, Field: "id"},
+	gen_bff_v1connect.UserServiceGetMyPreferencesProcedure:    {Scope: ScopeAuthenticated},
+	gen_bff_v1connect.UserServiceUpdateMyPreferencesProcedure: {Scope: ScopeAuthenticated},
+	gen_bff_v1connect.UserServiceCreateUserProcedure:          {Scope: ScopeGlobal, Min: gendb.UserRoleAdmin},
+	gen_bff_v1connect.UserServiceUpdateUserRoleProcedure:      {Scope: ScopeGlobal, Min: gendb.UserRoleAdmin},
+	gen_bff_v1connect.UserServiceDeactivateUserProcedure:      {Scope: ScopeGlobal, Min: gendb.UserRoleAdmin},
+	gen_bff_v1connect.UserServiceReactivateUserProcedure:      {Scope: ScopeGlobal, Min: gendb.UserRoleAdmin},
+	gen_bff_v1connect.UserServiceDeleteUserProcedure:          {Scope: ScopeGlobal, Min: gendb.UserRoleAdmin},
+
+	// Invitations are answered by the invitee, who is not a member yet; the
+	// handler checks that the caller's email is the invited one.
+	gen_bff_v1connect.InvitationServiceInviteToProjectProcedure:   {Scope: ScopeProject, Min: gendb.UserRoleAdmin, Field: "project_id", Token: TokenProjectsWrite},
+	gen_bff_v1connect.InvitationServiceListInvitationsProcedure:   {Scope: ScopeProject, Min: gendb.UserRoleAdmin, Field: "project_id", Token: TokenProjectsRead},
+	gen_bff_v1connect.InvitationServiceRevokeInvitationProcedure:  {Scope: ScopeProject, Min: gendb.UserRoleAdmin, Field: "project_id", Token: TokenProjectsWrite},
+	gen_bff_v1connect.InvitationServiceAcceptInvitationProcedure:  {Scope: ScopeAuthenticated},
+	gen_bff_v1connect.InvitationServiceDeclineInvitationProcedure: {Scope: ScopeAuthenticated},
+
+	gen_bff_v1connect.AccessTokenServiceCreateAccessTokenProcedure: {Scope: ScopeAuthenticated},
+	gen_bff_v1connect.AccessTokenServiceListAccessTokensProcedure:  {Scope: ScopeAuthenticated},
+	gen_bff_v1connect.AccessTokenServiceRevokeAccessTokenProcedure: {Scope: ScopeAuthenticated},
+
+	gen_bff_v1connect.AuditServiceListAuditEventsProcedure: {Scope: ScopeGlobal, Min: gendb.UserRoleAdmin, Token: TokenAuditRead},
+	AuditExportRoute: {Scope: ScopeGlobal, Min: gendb.UserRoleAdmin, Token: TokenAuditRead},
+	DebugVarsRoute:   {Scope: ScopeGlobal, Min: gendb.UserRoleAdmin},
+
+	// Forwarded to the LLM service once these rules pass.
+	gen_ai_v1connect.AITaskServiceRunAITaskProcedure: {Scope: ScopeProject, Min: gendb.UserRoleMember, Field: "project_id", Token: TokenAIRun},
+}
@@ -0,0 +1,228 @@
+// Package cache is a Redis read-through cache for hot single-row lookups.
+// Entries carry the version of the row they hold, and invalidations leave the
+// new version behind, so a read that loaded an older row can never store it
+// over a newer change. Invalidations come from the domain events on
+// JetStream, which every replica follows.
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"expvar"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/redis/go-redis/v9"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/ApeironFoundation/axle/bff/internal/outbox"
+	bffv1 "github.com/ApeironFoundation/axle/contracts/go/bff/v1"
+	gatewayv1 "github.com/ApeironFoundation/axle/contracts/go/gateway/v1"
+	gendb "github.com/ApeironFoundation/axle/db/generated"
+)
+
+// Entity kinds.
+const (
+	KindProject = "project"
+	KindUser    = "user"
+)
+
+// Gone is the version of an entity that no longer exists. Invalidating at
+// Gone keeps every load out of the cache until the entry expires.
+const Gone int64 = math.MaxInt64
+
+// eventSubjects are the domain events that change cached rows.
+var eventSubjects = []string{"axle.events.project.*", "axle.events.user.*"}
+
+// stats counts hits and misses per kind, e.g. "project_hits"; they are served
+// with the other expvars on /debug/vars.
+var stats = expvar.NewMap("bff_cache")
+
+// Cache fronts the projects and users tables. A nil *Cache disables caching.
+type Cache struct {
+	Redis *redis.Client
+	// TTL bounds how long an entry can outlive a missed invalidation.
+	TTL time.Duration
+}
+
+// Project returns the project with the given ID, loading it with q on a miss.
+func (c *Cache) Project(ctx context.Context, q *gendb.Queries, id pgtype.UUID) (gendb.Project, error) {
+	return readThrough(ctx, c, KindProject, id, func() (gendb.Project, int64, error) {
+		p, err := q.GetProject(ctx, id)
+		return p, p.Version, err
+	})
+}
+
+// User returns the user with the given ID, loading it with q on a miss.
+func (c *Cache) User(ctx context.Context, q *gendb.Queries, id pgtype.UUID) (gendb.User, error) {
+	return readThrough(ctx, c, KindUser, id, func() (gendb.User, int64, error) {
+		u, err := q.GetUser(ctx, id)
+		return u, u.Version, err
+	})
+}
+
+// Changed drops the cached entity, which is now at version, so the writer
+// reads its own change before the event comes back. Call it after the write
+// has committed; the other replicas catch up from the event.
+func (c *Cache) Changed(ctx context.Context, kind string, id pgtype.UUID, version int64) {
+	if c == nil || !id.Valid {
+		return
+	}
+	c.invalidate(ctx, kind, uuid.UUID(id.Bytes).String(), version)
+}
+
+// Follow invalidates entries as project and user events are stored in the
+// events stream, until the returned ConsumeContext is stopped. The stream
+// must exist (see outbox.EnsureStream).
+func (c *Cache) Follow(ctx context.Context, js jetstream.JetStream) (jetstream.ConsumeContext, error) {
+	cons, err := js.OrderedConsumer(ctx, outbox.StreamName, jetstream.OrderedConsumerConfig{
+		FilterSubjects: eventSubjects,
+		DeliverPolicy:  jetstream.DeliverNewPolicy,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("follow %s: %w", outbox.StreamName, err)
+	}
+	return cons.Consume(func(msg jetstream.Msg) {
+		var event gatewayv1.Event
+		if err := proto.Unmarshal(msg.Data(), &event); err != nil {
+			log.Warn().Err(err).Str("subject", msg.Subject()).Msg("ignoring undecodable event")
+			return
+		}
+		c.apply(context.Background(), &event)
+	})
+}
+
+// apply invalidates the entry event is about. Events carry the row's etag,
+// except for deletions of rows that cannot come back.
+func (c *Cache) apply(ctx context.Context, event *gatewayv1.Event) {
+	var (
+		kind string
+		msg  interface {
+			proto.Message
+			GetId() string
+			GetEtag() string
+		}
+	)
+	switch event.GetType() {
+	case gatewayv1.EventType_EVENT_TYPE_PROJECT_CREATED,
+		gatewayv1.EventType_EVENT_TYPE_PROJECT_UPDATED,
+		gatewayv1.EventType_EVENT_TYPE_PROJECT_DELETED,
+		gatewayv1.EventType_EVENT_TYPE_PROJECT_RESTORED:
+		kind, msg = KindProject, &bffv1.Project{}
+	case gatewayv1.EventType_EVENT_TYPE_USER_CREATED,
+		gatewayv1.EventType_EVENT_TYPE_USER_UPDATED,
+		gatewayv1.EventType_EVENT_TYPE_USER_DELETED:
+		kind, msg = KindUser, &bffv1.User{}
+	default:
+		return
+	}
+	if err := proto.Unmarshal(event.GetPayload(), msg); err != nil {
+		log.Warn().Err(err).Str("event_id", event.GetId()).Msg("ignoring undecodable event payload")
+		return
+	}
+	id, err := uuid.Parse(msg.GetId())
+	if err != nil {
+		return
+	}
+	c.invalidate(ctx, kind, id.String(), etagVersion(msg.GetEtag()))
+}
+
+// etagVersion returns the row version an etag stands for, or Gone when there
+// is none to go by.
+func etagVersion(etag string) int64 {
+	raw, err := strconv.Unquote(etag)
+	if err != nil {
+		return Gone
+	}
+	v, err := strconv.ParseInt(raw, 10, 64)
+	if err != nil {
+		return Gone
+	}
+	return v
+}
+
+func (c *Cache) invalidate(ctx context.Context, kind, id string, version int64) {
+	if err := invalidateScript.Run(ctx, c.Redis, []string{key(kind, id)}, version, c.TTL.Milliseconds()).Err(); err != nil {
+		log.Ctx(ctx).Warn().Err(err).Str("kind", kind).Str("id", id).Msg("cache invalidation failed")
+	}
+}
+
+func key(kind, id string) string {
+	return "axle:cache:" + kind + ":" + id
+}
+
+// An entry is "<version>:<row JSON>"; an invalidated one keeps only
+// "<version>:", so loads of older rows are refused until it expires.
+
+// storeScript sets KEYS[1] to row ARGV[2] at version ARGV[1] for ARGV[3]
+// milliseconds, unless it already holds a newer version.
+var storeScript = redis.NewScript(`
+local cur = redis.call('GET', KEYS[1])
+if cur then
+  local v = string.match(cur, '^(%d+):')
+  if v and tonumber(v) > tonumber(ARGV[1]) then
+    return 0
+  end
+end
+redis.call('SET', KEYS[1], ARGV[1] .. ':' .. ARGV[2], 'PX', ARGV[3])
+return 1
+`)
+
+// invalidateScript empties KEYS[1] at version ARGV[1] for ARGV[2]
+// milliseconds, unless it already holds that version or a newer one.
+var invalidateScript = redis.NewScript(`
+local cur = redis.call('GET', KEYS[1])
+if cur then
+  local v = string.match(cur, '^(%d+):')
+  if v and tonumber(v) >= tonumber(ARGV[1]) then
+    return 0
+  end
+end
+redis.call('SET', KEYS[1], ARGV[1] .. ':', 'PX', ARGV[2])
+return 1
+`)
+
+// readThrough serves v from Redis, or loads it and stores it with the version
+// load reports. Redis failures fall back to load, so the cache can never take
+// reads down with it.
+func readThrough[T any](ctx context.Context, c *Cache, kind string, id pgtype.UUID, load func() (T, int64, error)) (T, error) {
+	if c == nil {
+		v, _, err := load()
+		return v, err
+	}
+	k := key(kind, uuid.UUID(id.Bytes).String())
+
+	raw, err := c.Redis.Get(ctx, k).Result()
+	if err == nil {
+		if _, row, ok := strings.Cut(raw, ":"); ok && row != "" {
+			var v T
+			if err := json.Unmarshal([]byte(row), &v); err == nil {
+				stats.Add(kind+"_hits", 1)
+				return v, nil
+			}
+			log.Ctx(ctx).Warn().Str("key", k).Msg("ignoring undecodable cache entry")
+		}
+	} else if !errors.Is(err, redis.Nil) {
+		log.Ctx(ctx).Warn().Err(err).Str("key", k).Msg("cache read failed")
+	}
+	stats.Add(kind+"_misses", 1)
+
+	v, version, err := load()
+	if err != nil {
+		return v, err
+	}
+	if b, err := json.Marshal(v); err == nil {
+		if err := storeScript.Run(ctx, c.Redis, []string{k}, version, b, c.TTL.Milliseconds()).Err(); err != nil {
+			log.Ctx(ctx).Warn().Err(err).Str("key", k).Msg("cache write failed")
+		}
+	}
+	return v, nil
+}
@@ -0,0 +1,89 @@
+package cache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/redis/go-redis/v9"
+)
+
+type row struct {
+	Name    string `json:"name"`
+	Version int64  `json:"version"`
+}
+
+func newTestCache(t *testing.T) *Cache {
+	t.Helper()
+	mr := miniredis.RunT(t)
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { _ = rdb.Close() })
+	return &Cache{Redis: rdb, TTL: time.Minute}
+}
+
+// read reads id through c, loading r on a miss, and reports whether it loaded.
+func read(t *testing.T, c *Cache, id pgtype.UUID, r row) (row, bool) {
+	t.Helper()
+	loaded := false
+	got, err := readThrough(t.Context(), c, KindProject, id, func() (row, int64, error) {
+		loaded = true
+		return r, r.Version, nil
+	})
+	if err != nil {
+		t.Fatalf("readThrough: %v", err)
+	}
+	return got, loaded
+}
+
+func TestVersionedStore(t *testing.T) {
+	tests := []struct {
+		name string
+		// cached is stored before the invalidation, if its Version is set.
+		cached      row
+		invalidated int64
+		load        row
+		wantStored  bool
+	}{
+		{"older load after invalidation", row{}, 3, row{"stale", 2}, false},
+		{"load at the invalidated version", row{}, 3, row{"current", 3}, true},
+		{"newer load after invalidation", row{}, 3, row{"newer", 4}, true},
+		{"load after deletion", row{}, Gone, row{"deleted", 7}, false},
+		{"invalidation older than the entry", row{"newer", 4}, 3, row{"stale", 2}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newTestCache(t)
+			id := pgtype.UUID{Bytes: uuid.New(), Valid: true}
+			if tt.cached.Version != 0 {
+				read(t, c, id, tt.cached)
+			}
+			c.Changed(t.Context(), KindProject, id, tt.invalidated)
+
+			first, _ := read(t, c, id, tt.load)
+			want := tt.load
+			if tt.cached.Version != 0 {
+				want = tt.cached
+			}
+			if first != want {
+				t.Errorf("first read = %+v, want %+v", first, want)
+			}
+			// A second read hits only if the first one stored its row.
+			_, loaded := read(t, c, id, row{"reloaded", tt.load.Version})
+			if loaded == tt.wantStored {
+				t.Errorf("second read loaded = %v, want stored = %v", loaded, tt.wantStored)
+			}
+		})
+	}
+}
+
+func TestNilCacheLoads(t *testing.T) {
+	var c *Cache
+	c.Changed(t.Context(), KindProject, pgtype.UUID{Bytes: uuid.New(), Valid: true}, 1)
+	for range 2 {
+		if _, loaded := read(t, c, pgtype.UUID{Bytes: uuid.New(), Valid: true}, row{"p", 1}); !loaded {
+			t.Fatal("nil cache served a read without loading")
+		}
+	}
+}
@@ -0,0 +1,170 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog/log"
+
+	"github.com/ApeironFoundation/axle/bff/internal/audit"
+	"github.com/ApeironFoundation/axle/bff/internal/auth"
+	bffv1 "github.com/ApeironFoundation/axle/contracts/go/bff/v1"
+	"github.com/ApeironFoundation/axle/contracts/go/bff/v1/gen_bff_v1connect"
+	gendb "github.com/ApeironFoundation/axle/db/generated"
+)
+
+// Compile-time interface check.
+var _ gen_bff_v1connect.AccessTokenServiceHandler = (*AccessTokensHandler)(nil)
+
+// AccessTokensHandler implements the bff.v1.AccessTokenService ConnectRPC
+// methods. Every call acts on the caller's own tokens.
+type AccessTokensHandler struct {
+	Pool        *pgxpool.Pool
+	Revocations *auth.RevocationCache
+}
+
+func (h *AccessTokensHandler) CreateAccessToken(
+	ctx context.Context,
+	req *bffv1.CreateAccessTokenRequest,
+) (*bffv1.CreateAccessTokenResponse, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	token, prefix, hash, err := auth.NewPAT()
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("access token generation failed")
+		return nil, connect.NewError(connect.CodeInternal, errors.New("internal error"))
+	}
+	var expiresAt pgtype.Timestamptz
+	if ts := req.GetExpiresAt(); ts != nil {
+		expiresAt = pgtype.Timestamptz{Time: ts.AsTime(), Valid: true}
+	}
+
+	var t gendb.AccessToken
+	err = withTx(ctx, h.Pool, func(q *gendb.Queries) error {
+		var err error
+		t, err = q.CreateAccessToken(ctx, gendb.CreateAccessTokenParams{
+			UserID:    userID,
+			Name:      strings.TrimSpace(req.GetName()),
+			Prefix:    prefix,
+			TokenHash: hash,
+			Scopes:    req.GetScopes(),
+			ExpiresAt: expiresAt,
+		})
+		if err != nil {
+			return dbError(err, "access token")
+		}
+		return audited(ctx, q, audit.EntityAccessToken, t.ID, nil, accessTokenToProto(t))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &bffv1.CreateAccessTokenResponse{
+		AccessToken: accessTokenToProto(t),
+		Token:       token,
+	}, nil
+}
+
+func (h *AccessTokensHandler) ListAccessTokens(
+	ctx context.Context,
+	_ *bffv1.ListAccessTokensRequest,
+) (*bffv1.ListAccessTokensResponse, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := gendb.New(h.Pool).ListAccessTokens(ctx, userID)
+	if err != nil {
+		return nil, dbError(err, "access tokens")
+	}
+	tokens := make([]*bffv1.AccessToken, 0, len(rows))
+	for _, t := range rows {
+		tokens = append(tokens, accessTokenToProto(t))
+	}
+	return &bffv1.ListAccessTokensResponse{AccessTokens: tokens}, nil
+}
+
+func (h *AccessTokensHandler) RevokeAccessToken(
+	ctx context.Context,
+	req *bffv1.RevokeAccessTokenRequest,
+) (*bffv1.RevokeAccessTokenResponse, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	id, err := parseUUID("id", req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	var t gendb.AccessToken
+	err = withTx(ctx, h.Pool, func(q *gendb.Queries) error {
+		// Tokens of other users and already revoked tokens both match no row.
+		var err error
+		t, err = q.RevokeAccessToken(ctx, gendb.RevokeAccessTokenParams{
+			ID:     id,
+			UserID: userID,
+		})
+		if err != nil {
+			return dbError(err, "access token")
+		}
+		return auditRevoked(ctx, q, t)
+	})
+	if err != nil {
+		return nil, err
+	}
+	publishRevocations(ctx, h.Revocations, t)
+	return &bffv1.RevokeAccessTokenResponse{}, nil
+}
+
+// revokeUserTokens revokes every token of user id that is still live, for a
+// user who is deactivated or deleted, and records each revocation.
+func revokeUserTokens(ctx context.Context, q *gendb.Queries, id pgtype.UUID) ([]gendb.AccessToken, error) {
+	tokens, err := q.RevokeUserAccessTokens(ctx, id)
+	if err != nil {
+		return nil, dbError(err, "access tokens")
+	}
+	for _, t := range tokens {
+		if err := auditRevoked(ctx, q, t); err != nil {
+			return nil, err
+		}
+	}
+	return tokens, nil
+}
+
+func auditRevoked(ctx context.Context, q *gendb.Queries, t gendb.AccessToken) error {
+	before := accessTokenToProto(t)
+	before.RevokedAt = nil
+	return audited(ctx, q, audit.EntityAccessToken, t.ID, before, accessTokenToProto(t))
+}
+
+// publishRevocations marks tokens revoked in Redis once the revocation has
+// committed. Postgres already rejects them; without the markers, replicas
+// that cached them keep accepting them until their cache entries expire.
+func publishRevocations(ctx context.Context, rc *auth.RevocationCache, tokens ...gendb.AccessToken) {
+	if rc == nil {
+		return
+	}
+	for _, t := range tokens {
+		if err := rc.Revoke(ctx, t.TokenHash); err != nil {
+			log.Ctx(ctx).Error().Err(err).Str("token_id", uuidString(t.ID)).Msg("publishing token revocation failed")
+		}
+	}
+}
+
+func accessTokenToProto(t gendb.AccessToken) *bffv1.AccessToken {
+	return &bffv1.AccessToken{
+		Id:         uuidString(t.ID),
+		Name:       t.Name,
+		Prefix:     t.Prefix,
+		Scopes:     t.Scopes,
+		ExpiresAt:  timestampProto(t.ExpiresAt),
+		LastUsedAt: timestampProto(t.LastUsedAt),
+		RevokedAt:  timestampProto(t.RevokedAt),
+		CreatedAt:  timestampProto(t.CreatedAt),
+	}
+}
@@ -0,0 +1,70 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"connectrpc.com/connect"
+	"github.com/rs/zerolog/log"
+
+	"github.com/ApeironFoundation/axle/bff/internal/auth"
+	aiv1 "github.com/ApeironFoundation/axle/contracts/go/ai/v1"
+	"github.com/ApeironFoundation/axle/contracts/go/ai/v1/gen_ai_v1connect"
+	"github.com/ApeironFoundation/axle/shared/caller"
+)
+
+// Compile-time interface check.
+var _ gen_ai_v1connect.AITaskServiceHandler = (*AITasksHandler)(nil)
+
+// AITasksHandler serves ai.v1.AITaskService by forwarding each call to the LLM
+// service, so AI runs pass the same authentication, access policy and rate
+// limits as every other RPC before they reach it.
+type AITasksHandler struct {
+	LLM gen_ai_v1connect.AITaskServiceClient
+	// Token is the internal token the LLM service expects.
+	Token string
+}
+
+// RunAITask forwards the run for the caller and relays its results.
+func (h *AITasksHandler) RunAITask(
+	ctx context.Context,
+	req *aiv1.RunAITaskRequest,
+	stream *connect.ServerStream[aiv1.RunAITaskResponse],
+) error {
+	p, ok := auth.PrincipalFromContext(ctx)
+	if !ok {
+		return connect.NewError(connect.CodeUnauthenticated, errors.New("authentication required"))
+	}
+	ctx, call := connect.NewClientContext(ctx)
+	caller.Set(call.RequestHeader(), h.Token, caller.Identity{UserID: p.UserID, TokenID: p.TokenID})
+
+	upstream, err := h.LLM.RunAITask(ctx, req)
+	if err != nil {
+		return llmError(ctx, err)
+	}
+	defer upstream.Close() //nolint:errcheck
+	for upstream.Receive() {
+		if err := stream.Send(upstream.Msg()); err != nil {
+			return err
+		}
+	}
+	if err := upstream.Err(); err != nil {
+		return llmError(ctx, err)
+	}
+	return nil
+}
+
+// llmError passes on what the LLM service says about the request, and hides
+// failures of the call itself: those are ours, not the caller's.
+func llmError(ctx context.Context, err error) error {
+	switch connect.CodeOf(err) {
+	case connect.CodeInvalidArgument, connect.CodeFailedPrecondition, connect.CodeResourceExhausted,
+		connect.CodeCanceled, connect.CodeDeadlineExceeded:
+		return err
+	case connect.CodeUnauthenticated, connect.CodePermissionDenied:
+		log.Ctx(ctx).Error().Err(err).Msg("llm service refused the internal token")
+		return connect.NewError(connect.CodeInternal, errors.New("internal error"))
+	}
+	log.Ctx(ctx).Error().Err(err).Msg("llm service call failed")
+	return connect.NewError(connect.CodeUnavailable, errors.New("AI tasks are unavailable right now"))
+}
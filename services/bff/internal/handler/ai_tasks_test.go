@@ -0,0 +1,111 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+
+	"github.com/ApeironFoundation/axle/bff/internal/auth"
+	aiv1 "github.com/ApeironFoundation/axle/contracts/go/ai/v1"
+	"github.com/ApeironFoundation/axle/contracts/go/ai/v1/gen_ai_v1connect"
+	"github.com/ApeironFoundation/axle/shared/caller"
+)
+
+const testInternalToken = "internal-token"
+
+// fakeLLM checks the internal token like the LLM service does and records
+// who each run was forwarded for.
+type fakeLLM struct {
+	got chan caller.Identity
+}
+
+func (f fakeLLM) RunAITask(ctx context.Context, _ *aiv1.RunAITaskRequest, stream *connect.ServerStream[aiv1.RunAITaskResponse]) error {
+	call, _ := connect.CallInfoForHandlerContext(ctx)
+	if caller.Token(call.RequestHeader()) != testInternalToken {
+		return connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+	id, err := caller.Parse(call.RequestHeader())
+	if err != nil {
+		return connect.NewError(connect.CodeUnauthenticated, err)
+	}
+	f.got <- id
+	for _, chunk := range []string{"hel", "lo"} {
+		if err := stream.Send(&aiv1.RunAITaskResponse{Status: aiv1.AITaskStatus_AI_TASK_STATUS_RUNNING, Chunk: chunk}); err != nil {
+			return err
+		}
+	}
+	return stream.Send(&aiv1.RunAITaskResponse{Status: aiv1.AITaskStatus_AI_TASK_STATUS_DONE, Done: true})
+}
+
+// withPrincipal stands in for the BFF's auth interceptor.
+type withPrincipal struct {
+	p auth.Principal
+}
+
+func (w withPrincipal) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return next
+}
+
+func (w withPrincipal) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (w withPrincipal) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		return next(auth.WithPrincipal(ctx, w.p), conn)
+	}
+}
+
+// runThroughProxy runs a task through an AITasksHandler holding token, for p,
+// against a fakeLLM.
+func runThroughProxy(t *testing.T, token string, p auth.Principal) (chunks []string, forwarded chan caller.Identity, err error) {
+	t.Helper()
+	forwarded = make(chan caller.Identity, 1)
+	mux := http.NewServeMux()
+	mux.Handle(gen_ai_v1connect.NewAITaskServiceHandler(fakeLLM{got: forwarded}))
+	llm := httptest.NewServer(mux)
+	t.Cleanup(llm.Close)
+
+	h := &AITasksHandler{LLM: gen_ai_v1connect.NewAITaskServiceClient(llm.Client(), llm.URL), Token: token}
+	mux = http.NewServeMux()
+	mux.Handle(gen_ai_v1connect.NewAITaskServiceHandler(h,
+		connect.WithInterceptors(withPrincipal{p: p})))
+	bff := httptest.NewServer(mux)
+	t.Cleanup(bff.Close)
+
+	client := gen_ai_v1connect.NewAITaskServiceClient(bff.Client(), bff.URL)
+	stream, err := client.RunAITask(t.Context(), &aiv1.RunAITaskRequest{ProjectId: uuid.NewString(), Type: "summarize"})
+	if err != nil {
+		return nil, forwarded, err
+	}
+	defer stream.Close() //nolint:errcheck
+	for stream.Receive() {
+		chunks = append(chunks, stream.Msg().GetChunk())
+	}
+	return chunks, forwarded, stream.Err()
+}
+
+func TestRunAITaskForwardsCaller(t *testing.T) {
+	p := auth.Principal{UserID: uuid.New(), TokenID: uuid.New(), Scopes: []string{"ai:run"}}
+	chunks, forwarded, err := runThroughProxy(t, testInternalToken, p)
+	if err != nil {
+		t.Fatalf("RunAITask: %v", err)
+	}
+	if len(chunks) != 3 || chunks[0]+chunks[1] != "hello" {
+		t.Errorf("chunks = %q, want the LLM's stream relayed", chunks)
+	}
+	if got := <-forwarded; got.UserID != p.UserID || got.TokenID != p.TokenID {
+		t.Errorf("forwarded %+v, want user %s with token %s", got, p.UserID, p.TokenID)
+	}
+}
+
+func TestRunAITaskHidesInternalTokenFailure(t *testing.T) {
+	_, _, err := runThroughProxy(t, "wrong", auth.Principal{UserID: uuid.New()})
+	if connect.CodeOf(err) != connect.CodeInternal {
+		t.Errorf("err = %v, want Internal rather than the LLM's Unauthenticated", err)
+	}
+}
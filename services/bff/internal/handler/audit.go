@@ -0,0 +1,243 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"buf.build/go/protovalidate"
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	bffv1 "github.com/ApeironFoundation/axle/contracts/go/bff/v1"
+	"github.com/ApeironFoundation/axle/contracts/go/bff/v1/gen_bff_v1connect"
+	gendb "github.com/ApeironFoundation/axle/db/generated"
+)
+
+// exportBatchSize is how many audit rows Export reads per query.
+const exportBatchSize = 500
+
+// Compile-time interface check.
+var _ gen_bff_v1connect.AuditServiceHandler = (*AuditHandler)(nil)
+
+// AuditHandler implements the bff.v1.AuditService ConnectRPC methods and the
+// NDJSON export of the audit log.
+type AuditHandler struct {
+	Pool *pgxpool.Pool
+}
+
+func (h *AuditHandler) ListAuditEvents(
+	ctx context.Context,
+	req *bffv1.ListAuditEventsRequest,
+) (*bffv1.ListAuditEventsResponse, error) {
+	pq, err := pageRequest(0, req.GetPageSize(), req.GetPageToken(), false)
+	if err != nil {
+		return nil, err
+	}
+	f, err := auditFilterFromRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := gendb.New(h.Pool).ListAuditEvents(ctx, gendb.ListAuditEventsParams{
+		ActorID:         f.ActorID,
+		EntityType:      f.EntityType,
+		EntityID:        f.EntityID,
+		Since:           f.Since,
+		Until:           f.Until,
+		AfterOccurredAt: pq.AfterCreatedAt,
+		AfterID:         pq.AfterID,
+		Limit:           pq.Limit,
+	})
+	if err != nil {
+		return nil, dbError(err, "audit events")
+	}
+	rows, next := nextPage(rows, pq, func(e gendb.AuditLog) pageCursor {
+		return pageCursor{CreatedAt: e.OccurredAt, ID: e.ID}
+	})
+
+	resp := &bffv1.ListAuditEventsResponse{
+		Events:        make([]*bffv1.AuditEvent, 0, len(rows)),
+		NextPageToken: next,
+	}
+	for _, e := range rows {
+		event, err := auditEventToProto(e)
+		if err != nil {
+			return nil, err
+		}
+		resp.Events = append(resp.Events, event)
+	}
+	return resp, nil
+}
+
+// Export streams the audit log as newline-delimited JSON, oldest first, one
+// AuditEvent per line. It takes the ListAuditEvents filters as query
+// parameters, with since and until in RFC 3339. Mount it behind
+// middleware.Authorize.
+func (h *AuditHandler) Export(w http.ResponseWriter, r *http.Request) {
+	errs := connect.NewErrorWriter()
+	ctx := r.Context()
+	req, err := exportRequest(r.URL.Query())
+	if err != nil {
+		_ = errs.Write(w, r, err)
+		return
+	}
+	if err := protovalidate.Validate(req); err != nil {
+		_ = errs.Write(w, r, connect.NewError(connect.CodeInvalidArgument, err))
+		return
+	}
+	f, err := auditFilterFromRequest(req)
+	if err != nil {
+		_ = errs.Write(w, r, err)
+		return
+	}
+
+	// An export can outlast the server's write timeout.
+	rc := http.NewResponseController(w)
+	_ = rc.SetWriteDeadline(time.Time{})
+
+	q := gendb.New(h.Pool)
+	params := gendb.ExportAuditEventsParams{
+		ActorID:    f.ActorID,
+		EntityType: f.EntityType,
+		EntityID:   f.EntityID,
+		Since:      f.Since,
+		Until:      f.Until,
+		Limit:      exportBatchSize,
+	}
+	started := false
+	for {
+		rows, err := q.ExportAuditEvents(ctx, params)
+		if err != nil {
+			if !started {
+				_ = errs.Write(w, r, dbError(err, "audit events"))
+				return
+			}
+			// The status line is gone; all we can do is cut the stream short.
+			log.Ctx(ctx).Error().Err(err).Msg("audit export aborted")
+			return
+		}
+		if !started {
+			w.Header().Set("Content-Type", "application/x-ndjson")
+			w.WriteHeader(http.StatusOK)
+			started = true
+		}
+		for _, e := range rows {
+			event, err := auditEventToProto(e)
+			if err != nil {
+				log.Ctx(ctx).Error().Err(err).Msg("audit export aborted")
+				return
+			}
+			line, err := protojson.Marshal(event)
+			if err != nil {
+				log.Ctx(ctx).Error().Err(err).Msg("audit export aborted")
+				return
+			}
+			if _, err := w.Write(append(line, '\n')); err != nil {
+				return
+			}
+		}
+		_ = rc.Flush()
+		if len(rows) < exportBatchSize {
+			return
+		}
+		last := rows[len(rows)-1]
+		params.AfterOccurredAt, params.AfterID = last.OccurredAt, last.ID
+	}
+}
+
+// exportRequest reads the export query parameters into the equivalent list
+// request, so both share the same validation rules.
+func exportRequest(v url.Values) (*bffv1.ListAuditEventsRequest, error) {
+	req := &bffv1.ListAuditEventsRequest{
+		ActorId:    v.Get("actor_id"),
+		EntityType: v.Get("entity_type"),
+		EntityId:   v.Get("entity_id"),
+	}
+	for name, dst := range map[string]**timestamppb.Timestamp{"since": &req.Since, "until": &req.Until} {
+		s := v.Get(name)
+		if s == "" {
+			continue
+		}
+		t, err := time.Parse(time.RFC3339Nano, s)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument,
+				fmt.Errorf("%s: want an RFC 3339 timestamp, got %q", name, s))
+		}
+		*dst = timestamppb.New(t)
+	}
+	return req, nil
+}
+
+// auditFilter holds the optional audit log filters in query-parameter form;
+// zero values leave a filter disabled.
+type auditFilter struct {
+	ActorID    pgtype.UUID
+	EntityType *string
+	EntityID   pgtype.UUID
+	Since      pgtype.Timestamptz
+	Until      pgtype.Timestamptz
+}
+
+func auditFilterFromRequest(req *bffv1.ListAuditEventsRequest) (auditFilter, error) {
+	var f auditFilter
+	if id := req.GetActorId(); id != "" {
+		u, err := parseUUID("actor_id", id)
+		if err != nil {
+			return auditFilter{}, err
+		}
+		f.ActorID = u
+	}
+	if t := req.GetEntityType(); t != "" {
+		f.EntityType = &t
+	}
+	if id := req.GetEntityId(); id != "" {
+		u, err := parseUUID("entity_id", id)
+		if err != nil {
+			return auditFilter{}, err
+		}
+		f.EntityID = u
+	}
+	for _, b := range []struct {
+		name string
+		ts   *timestamppb.Timestamp
+		dst  *pgtype.Timestamptz
+	}{
+		{"since", req.GetSince(), &f.Since},
+		{"until", req.GetUntil(), &f.Until},
+	} {
+		if b.ts == nil {
+			continue
+		}
+		if err := b.ts.CheckValid(); err != nil {
+			return auditFilter{}, connect.NewError(connect.CodeInvalidArgument, errors.New(b.name+": invalid timestamp"))
+		}
+		*b.dst = pgtype.Timestamptz{Time: b.ts.AsTime(), Valid: true}
+	}
+	return f, nil
+}
+
+func auditEventToProto(e gendb.AuditLog) (*bffv1.AuditEvent, error) {
+	changes, err := settingsProto(e.Changes, "audit changes")
+	if err != nil {
+		return nil, err
+	}
+	return &bffv1.AuditEvent{
+		Id:           uuidString(e.ID),
+		OccurredAt:   timestampProto(e.OccurredAt),
+		ActorId:      uuidString(e.ActorID),
+		ActorTokenId: uuidString(e.ActorTokenID),
+		Procedure:    e.Procedure,
+		EntityType:   e.EntityType,
+		EntityId:     uuidString(e.EntityID),
+		Changes:      changes,
+		RequestId:    e.RequestID,
+		ClientIp:     e.ClientIP,
+	}, nil
+}
@@ -0,0 +1,21 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/ApeironFoundation/axle/bff/internal/auth"
+)
+
+// callerID returns the authenticated user's ID, or CodeUnauthenticated when the
+// request carries no principal.
+func callerID(ctx context.Context) (pgtype.UUID, error) {
+	p, ok := auth.PrincipalFromContext(ctx)
+	if !ok {
+		return pgtype.UUID{}, connect.NewError(connect.CodeUnauthenticated, errors.New("authentication required"))
+	}
+	return pgtype.UUID{Bytes: p.UserID, Valid: true}, nil
+}
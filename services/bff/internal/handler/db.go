@@ -0,0 +1,115 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/ApeironFoundation/axle/bff/internal/audit"
+	"github.com/ApeironFoundation/axle/bff/internal/outbox"
+	gatewayv1 "github.com/ApeironFoundation/axle/contracts/go/gateway/v1"
+	gendb "github.com/ApeironFoundation/axle/db/generated"
+)
+
+// SQLSTATEs the handlers map to client errors.
+const (
+	pgForeignKeyViolation = "23503"
+	pgUniqueViolation     = "23505"
+)
+
+// withTx runs fn inside a transaction, committing when fn returns nil.
+func withTx(ctx context.Context, pool *pgxpool.Pool, fn func(q *gendb.Queries) error) error {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return dbError(err, "transaction")
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	if err := fn(gendb.New(tx)); err != nil {
+		return err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return dbError(err, "transaction")
+	}
+	return nil
+}
+
+// emit records an event in the outbox as part of the transaction behind q.
+func emit(ctx context.Context, q *gendb.Queries, typ gatewayv1.EventType, projectID pgtype.UUID, payload proto.Message) error {
+	if err := outbox.Enqueue(ctx, q, typ, projectID, payload); err != nil {
+		return dbError(err, "outbox")
+	}
+	return nil
+}
+
+// audited appends an audit_log entry as part of the transaction behind q; see
+// audit.Record.
+func audited(ctx context.Context, q *gendb.Queries, entity string, id pgtype.UUID, before, after any) error {
+	if err := audit.Record(ctx, q, entity, id, before, after); err != nil {
+		return dbError(err, "audit log")
+	}
+	return nil
+}
+
+// parseUUID converts a request ID into a pgtype.UUID, rejecting malformed input.
+func parseUUID(field, id string) (pgtype.UUID, error) {
+	u, err := uuid.Parse(id)
+	if err != nil {
+		return pgtype.UUID{}, connect.NewError(connect.CodeInvalidArgument,
+			fmt.Errorf("%s: invalid uuid %q", field, id))
+	}
+	return pgtype.UUID{Bytes: u, Valid: true}, nil
+}
+
+// uuidString renders a pgtype.UUID in canonical form ("" when NULL).
+func uuidString(u pgtype.UUID) string {
+	if !u.Valid {
+		return ""
+	}
+	return uuid.UUID(u.Bytes).String()
+}
+
+// timestampProto converts a pgtype.Timestamptz into a protobuf Timestamp (nil when NULL).
+func timestampProto(t pgtype.Timestamptz) *timestamppb.Timestamp {
+	if !t.Valid {
+		return nil
+	}
+	return timestamppb.New(t.Time)
+}
+
+// dbError maps a query error to a ConnectRPC error.
+// pgx.ErrNoRows becomes CodeNotFound; everything else is logged and reported as CodeInternal.
+// Errors that are already ConnectRPC errors pass through unchanged.
+func dbError(err error, what string) error {
+	var cerr *connect.Error
+	if errors.As(err, &cerr) {
+		return err
+	}
+	if errors.Is(err, pgx.ErrNoRows) {
+		return connect.NewError(connect.CodeNotFound, errors.New(what+" not found"))
+	}
+	log.Error().Err(err).Str("entity", what).Msg("database query failed")
+	return connect.NewError(connect.CodeInternal, fmt.Errorf("%s: database error", what))
+}
+
+// isForeignKeyViolation reports whether err was caused by a dangling reference.
+func isForeignKeyViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == pgForeignKeyViolation
+}
+
+// isUniqueViolation reports whether err was caused by a duplicate key.
+func isUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == pgUniqueViolation
+}
@@ -0,0 +1,72 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"connectrpc.com/connect"
+)
+
+// Entities with a version column expose it to clients as an opaque etag.
+// Writes that carry an expected_etag are compare-and-set: the query matches no
+// row when the version moved on, and versionConflict explains why.
+
+// formatETag renders a row version as an etag.
+func formatETag(version int64) string {
+	return strconv.Quote(strconv.FormatInt(version, 10))
+}
+
+// parseETag returns the version an expected_etag refers to, or nil when the
+// caller did not send one and the write should be unconditional.
+func parseETag(etag string) (*int64, error) {
+	if etag == "" {
+		return nil, nil
+	}
+	raw, err := strconv.Unquote(strings.TrimPrefix(etag, "W/"))
+	if err != nil {
+		raw = etag
+	}
+	v, err := strconv.ParseInt(raw, 10, 64)
+	if err != nil || v <= 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("expected_etag: malformed etag %q", etag))
+	}
+	return &v, nil
+}
+
+// versionConflict maps a conditional write that affected no row to a
+// ConnectRPC error: CodeNotFound when the row is gone, CodeAborted when its
+// current version no longer matches expected.
+func versionConflict(
+	ctx context.Context,
+	what string,
+	expected *int64,
+	current func(context.Context) (int64, error),
+) error {
+	if expected == nil {
+		return connect.NewError(connect.CodeNotFound, errors.New(what+" not found"))
+	}
+	v, err := current(ctx)
+	if err != nil {
+		return dbError(err, what)
+	}
+	return staleError(what, v, *expected)
+}
+
+// checkVersion fails with CodeAborted when the caller sent an expected version
+// that differs from the row's current one. Use it where the row is already
+// locked, so the comparison cannot race with another writer.
+func checkVersion(what string, expected *int64, current int64) error {
+	if expected != nil && *expected != current {
+		return staleError(what, current, *expected)
+	}
+	return nil
+}
+
+func staleError(what string, current, expected int64) error {
+	return connect.NewError(connect.CodeAborted,
+		fmt.Errorf("%s was modified concurrently (etag %s, expected %s); reload and retry",
+			what, formatETag(current), formatETag(expected)))
+}
@@ -0,0 +1,337 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog/log"
+
+	"github.com/ApeironFoundation/axle/bff/internal/audit"
+	"github.com/ApeironFoundation/axle/bff/internal/auth"
+	"github.com/ApeironFoundation/axle/bff/internal/invitation"
+	"github.com/ApeironFoundation/axle/bff/internal/mailer"
+	bffv1 "github.com/ApeironFoundation/axle/contracts/go/bff/v1"
+	"github.com/ApeironFoundation/axle/contracts/go/bff/v1/gen_bff_v1connect"
+	gatewayv1 "github.com/ApeironFoundation/axle/contracts/go/gateway/v1"
+	gendb "github.com/ApeironFoundation/axle/db/generated"
+)
+
+// Compile-time interface check.
+var _ gen_bff_v1connect.InvitationServiceHandler = (*InvitationsHandler)(nil)
+
+// InvitationsHandler implements the bff.v1.InvitationService ConnectRPC methods.
+type InvitationsHandler struct {
+	Pool   *pgxpool.Pool
+	Signer *invitation.Signer
+	Mailer mailer.Mailer
+	// TTL is how long an invitation can be answered.
+	TTL time.Duration
+	// AppURL is the frontend's base URL; links point at <AppURL>/invitations/<token>.
+	AppURL string
+}
+
+func (h *InvitationsHandler) InviteToProject(
+	ctx context.Context,
+	req *bffv1.InviteToProjectRequest,
+) (*bffv1.InviteToProjectResponse, error) {
+	projectID, err := parseUUID("project_id", req.GetProjectId())
+	if err != nil {
+		return nil, err
+	}
+	role := gendb.UserRoleMember
+	if req.GetRole() != bffv1.UserRole_USER_ROLE_UNSPECIFIED {
+		if role, err = userRoleFromProto(req.GetRole()); err != nil {
+			return nil, err
+		}
+	}
+	email := strings.ToLower(strings.TrimSpace(req.GetEmail()))
+	// Without authentication (dev mode) nobody is recorded as the inviter.
+	var inviterID pgtype.UUID
+	if p, ok := auth.PrincipalFromContext(ctx); ok {
+		inviterID = pgtype.UUID{Bytes: p.UserID, Valid: true}
+	}
+
+	var (
+		inv         gendb.Invitation
+		projectName string
+		inviterName = "A project admin"
+	)
+	err = withTx(ctx, h.Pool, func(q *gendb.Queries) error {
+		project, err := q.GetProject(ctx, projectID)
+		if err != nil {
+			return dbError(err, "project")
+		}
+		projectName = project.Name
+		if inviterID.Valid {
+			u, err := q.GetUser(ctx, inviterID)
+			if err != nil {
+				return dbError(err, "user")
+			}
+			inviterName = u.Name
+		}
+
+		// Inviting again replaces the open invitation, so old links stop working.
+		revoked, err := q.RevokePendingInvitationsForEmail(ctx, gendb.RevokePendingInvitationsForEmailParams{
+			ProjectID: projectID,
+			Email:     email,
+		})
+		if err != nil {
+			return dbError(err, "invitation")
+		}
+		for _, r := range revoked {
+			before := r
+			before.Status, before.RespondedAt = gendb.InvitationStatusPending, pgtype.Timestamptz{}
+			if err := audited(ctx, q, audit.EntityInvitation, r.ID, invitationToProto(before), invitationToProto(r)); err != nil {
+				return err
+			}
+		}
+
+		inv, err = q.CreateInvitation(ctx, gendb.CreateInvitationParams{
+			ProjectID: projectID,
+			Email:     email,
+			Role:      role,
+			InvitedBy: inviterID,
+			ExpiresAt: pgtype.Timestamptz{Time: time.Now().Add(h.TTL), Valid: true},
+		})
+		if isUniqueViolation(err) {
+			return connect.NewError(connect.CodeAborted, errors.New("this address is being invited concurrently; try again"))
+		}
+		if isForeignKeyViolation(err) {
+			return connect.NewError(connect.CodeNotFound, errors.New("project not found"))
+		}
+		if err != nil {
+			return dbError(err, "invitation")
+		}
+		return audited(ctx, q, audit.EntityInvitation, inv.ID, nil, invitationToProto(inv))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The invitation is committed before the email goes out: a lost email can
+	// be resent by inviting again, a sent link to a missing row cannot be taken back.
+	sent := true
+	if err := h.Mailer.Send(ctx, h.invitationEmail(inv, projectName, inviterName)); err != nil {
+		log.Ctx(ctx).Error().Err(err).Str("invitation_id", uuidString(inv.ID)).Msg("invitation email failed")
+		sent = false
+	}
+	return &bffv1.InviteToProjectResponse{Invitation: invitationToProto(inv), EmailSent: sent}, nil
+}
+
+func (h *InvitationsHandler) ListInvitations(
+	ctx context.Context,
+	req *bffv1.ListInvitationsRequest,
+) (*bffv1.ListInvitationsResponse, error) {
+	projectID, err := parseUUID("project_id", req.GetProjectId())
+	if err != nil {
+		return nil, err
+	}
+	q := gendb.New(h.Pool)
+	if _, err := q.GetProject(ctx, projectID); err != nil {
+		return nil, dbError(err, "project")
+	}
+	rows, err := q.ListPendingInvitations(ctx, projectID)
+	if err != nil {
+		return nil, dbError(err, "invitations")
+	}
+	invitations := make([]*bffv1.Invitation, 0, len(rows))
+	for _, inv := range rows {
+		invitations = append(invitations, invitationToProto(inv))
+	}
+	return &bffv1.ListInvitationsResponse{Invitations: invitations}, nil
+}
+
+func (h *InvitationsHandler) RevokeInvitation(
+	ctx context.Context,
+	req *bffv1.RevokeInvitationRequest,
+) (*bffv1.RevokeInvitationResponse, error) {
+	projectID, err := parseUUID("project_id", req.GetProjectId())
+	if err != nil {
+		return nil, err
+	}
+	id, err := parseUUID("id", req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	var inv gendb.Invitation
+	err = withTx(ctx, h.Pool, func(q *gendb.Queries) error {
+		before, err := q.LockInvitation(ctx, id)
+		if err != nil {
+			return dbError(err, "invitation")
+		}
+		// Authorization was checked against project_id, so the invitation
+		// must belong to that project.
+		if before.ProjectID != projectID {
+			return dbError(pgx.ErrNoRows, "invitation")
+		}
+		if before.Status != gendb.InvitationStatusPending {
+			return connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("invitation is already %s", before.Status))
+		}
+		inv, err = q.SetInvitationStatus(ctx, gendb.SetInvitationStatusParams{ID: id, Status: gendb.InvitationStatusRevoked})
+		if err != nil {
+			return dbError(err, "invitation")
+		}
+		return audited(ctx, q, audit.EntityInvitation, id, invitationToProto(before), invitationToProto(inv))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &bffv1.RevokeInvitationResponse{Invitation: invitationToProto(inv)}, nil
+}
+
+func (h *InvitationsHandler) AcceptInvitation(
+	ctx context.Context,
+	req *bffv1.AcceptInvitationRequest,
+) (*bffv1.AcceptInvitationResponse, error) {
+	var member gendb.GetProjectMemberRow
+	err := h.answer(ctx, req.GetToken(), gendb.InvitationStatusAccepted, func(q *gendb.Queries, inv gendb.Invitation, userID pgtype.UUID) error {
+		if err := lockLiveProject(ctx, q, inv.ProjectID); err != nil {
+			return err
+		}
+		key := gendb.GetProjectMemberParams{ProjectID: inv.ProjectID, UserID: userID}
+		m, err := q.GetProjectMember(ctx, key)
+		if err == nil {
+			// Already a member: keep the current role rather than lower it.
+			member = m
+			return nil
+		}
+		if !errors.Is(err, pgx.ErrNoRows) {
+			return dbError(err, "project member")
+		}
+		if _, err := q.AddProjectMember(ctx, gendb.AddProjectMemberParams{
+			ProjectID: inv.ProjectID,
+			UserID:    userID,
+			Role:      inv.Role,
+		}); err != nil {
+			return dbError(err, "project member")
+		}
+		if member, err = q.GetProjectMember(ctx, key); err != nil {
+			return dbError(err, "project member")
+		}
+		if err := audited(ctx, q, audit.EntityProjectMember, member.ID, nil, projectMemberToProto(member)); err != nil {
+			return err
+		}
+		return emit(ctx, q, gatewayv1.EventType_EVENT_TYPE_PROJECT_MEMBER_ADDED, inv.ProjectID, projectMemberToProto(member))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &bffv1.AcceptInvitationResponse{Member: projectMemberToProto(member)}, nil
+}
+
+func (h *InvitationsHandler) DeclineInvitation(
+	ctx context.Context,
+	req *bffv1.DeclineInvitationRequest,
+) (*bffv1.DeclineInvitationResponse, error) {
+	err := h.answer(ctx, req.GetToken(), gendb.InvitationStatusDeclined, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &bffv1.DeclineInvitationResponse{}, nil
+}
+
+// answer closes the invitation named by token with status on behalf of the
+// caller, after running apply (if any) in the same transaction. The caller
+// must be signed in with the invited address and the invitation must still be
+// open.
+func (h *InvitationsHandler) answer(
+	ctx context.Context,
+	token string,
+	status gendb.InvitationStatus,
+	apply func(q *gendb.Queries, inv gendb.Invitation, userID pgtype.UUID) error,
+) error {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	id, err := h.Signer.Verify(token, now)
+	switch {
+	case errors.Is(err, invitation.ErrExpired):
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	case err != nil:
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	return withTx(ctx, h.Pool, func(q *gendb.Queries) error {
+		user, err := q.GetUser(ctx, userID)
+		if err != nil {
+			return dbError(err, "user")
+		}
+		before, err := q.LockInvitation(ctx, pgtype.UUID{Bytes: id, Valid: true})
+		if err != nil {
+			return dbError(err, "invitation")
+		}
+		if !strings.EqualFold(before.Email, user.Email) {
+			return connect.NewError(connect.CodePermissionDenied, errors.New("invitation was sent to a different email address"))
+		}
+		if before.Status != gendb.InvitationStatusPending {
+			return connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("invitation is already %s", before.Status))
+		}
+		if !before.ExpiresAt.Time.After(now) {
+			return connect.NewError(connect.CodeFailedPrecondition, invitation.ErrExpired)
+		}
+		if apply != nil {
+			if err := apply(q, before, userID); err != nil {
+				return err
+			}
+		}
+		inv, err := q.SetInvitationStatus(ctx, gendb.SetInvitationStatusParams{ID: before.ID, Status: status})
+		if err != nil {
+			return dbError(err, "invitation")
+		}
+		return audited(ctx, q, audit.EntityInvitation, inv.ID, invitationToProto(before), invitationToProto(inv))
+	})
+}
+
+// invitationEmail renders the email for inv. The token is only ever part of
+// the link; responses never carry it.
+func (h *InvitationsHandler) invitationEmail(inv gendb.Invitation, projectName, inviterName string) mailer.Message {
+	link := strings.TrimRight(h.AppURL, "/") + "/invitations/" + h.Signer.Sign(inv.ID.Bytes, inv.ExpiresAt.Time)
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s invited you to join the project %q on Axle as %s.\n\n", inviterName, projectName, inv.Role)
+	fmt.Fprintf(&b, "Sign in as %s and accept or decline the invitation here:\n\n%s\n\n", inv.Email, link)
+	fmt.Fprintf(&b, "The link expires on %s. If you were not expecting this invitation, you can ignore this email.\n",
+		inv.ExpiresAt.Time.UTC().Format("January 2, 2006 at 15:04 MST"))
+	return mailer.Message{
+		To:      inv.Email,
+		Subject: fmt.Sprintf("You're invited to %s on Axle", projectName),
+		Body:    b.String(),
+	}
+}
+
+func invitationToProto(inv gendb.Invitation) *bffv1.Invitation {
+	return &bffv1.Invitation{
+		Id:          uuidString(inv.ID),
+		ProjectId:   uuidString(inv.ProjectID),
+		Email:       inv.Email,
+		Role:        userRoleToProto(inv.Role),
+		Status:      invitationStatusToProto(inv.Status),
+		InvitedBy:   uuidString(inv.InvitedBy),
+		ExpiresAt:   timestampProto(inv.ExpiresAt),
+		RespondedAt: timestampProto(inv.RespondedAt),
+		CreatedAt:   timestampProto(inv.CreatedAt),
+	}
+}
+
+func invitationStatusToProto(s gendb.InvitationStatus) bffv1.InvitationStatus {
+	switch s {
+	case gendb.InvitationStatusPending:
+		return bffv1.InvitationStatus_INVITATION_STATUS_PENDING
+	case gendb.InvitationStatusAccepted:
+		return bffv1.InvitationStatus_INVITATION_STATUS_ACCEPTED
+	case gendb.InvitationStatusDeclined:
+		return bffv1.InvitationStatus_INVITATION_STATUS_DECLINED
+	case gendb.InvitationStatusRevoked:
+		return bffv1.InvitationStatus_INVITATION_STATUS_REVOKED
+	default:
+		return bffv1.InvitationStatus_INVITATION_STATUS_UNSPECIFIED
+	}
+}
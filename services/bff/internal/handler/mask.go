@@ -0,0 +1,33 @@
+package handler
+
+import (
+	"fmt"
+	"slices"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// updateMask resolves an update request's field mask against the paths the RPC
+// allows. "*" selects every allowed path. A nil or empty mask returns nil, and
+// callers then fall back to applying only non-empty fields.
+func updateMask(mask *fieldmaskpb.FieldMask, allowed ...string) (map[string]bool, error) {
+	if len(mask.GetPaths()) == 0 {
+		return nil, nil
+	}
+	set := make(map[string]bool, len(allowed))
+	for _, path := range mask.GetPaths() {
+		if path == "*" {
+			for _, a := range allowed {
+				set[a] = true
+			}
+			continue
+		}
+		if !slices.Contains(allowed, path) {
+			return nil, connect.NewError(connect.CodeInvalidArgument,
+				fmt.Errorf("update_mask: unknown or immutable path %q", path))
+		}
+		set[path] = true
+	}
+	return set, nil
+}
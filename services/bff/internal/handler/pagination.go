@@ -0,0 +1,118 @@
+package handler
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"math"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+
+	// Token layouts; bump the tag when a layout changes.
+	pageTokenV1       = 1 // (created_at, id)
+	pageTokenRankedV1 = 2 // (rank, created_at, id) for relevance-ordered results
+)
+
+var errInvalidPageToken = errors.New("invalid page_token")
+
+// pageCursor is the sort key of the last row on a page.
+type pageCursor struct {
+	Rank      *float32 // set only for relevance-ordered results
+	CreatedAt pgtype.Timestamptz
+	ID        pgtype.UUID
+}
+
+// pageQuery is the resolved window for a list request.
+// Limit is one more than the page size so the handler can tell whether another page exists.
+type pageQuery struct {
+	PageSize       int32
+	Limit          int32
+	Offset         int32
+	AfterRank      *float32
+	AfterCreatedAt pgtype.Timestamptz
+	AfterID        pgtype.UUID
+}
+
+// pageRequest resolves page_size, page_token and the legacy 1-based page number.
+// A non-empty token always wins over the page number. ranked selects the cursor
+// layout, so a token from a search cannot be replayed against a plain listing.
+func pageRequest(page, pageSize int32, token string, ranked bool) (pageQuery, error) {
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+	if pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+	pq := pageQuery{PageSize: pageSize, Limit: pageSize + 1}
+
+	if token != "" {
+		c, err := decodePageToken(token)
+		if err != nil || (c.Rank != nil) != ranked {
+			return pageQuery{}, connect.NewError(connect.CodeInvalidArgument, errInvalidPageToken)
+		}
+		pq.AfterRank, pq.AfterCreatedAt, pq.AfterID = c.Rank, c.CreatedAt, c.ID
+		return pq, nil
+	}
+	if page > 1 {
+		offset := int64(page-1) * int64(pageSize)
+		if offset > math.MaxInt32 {
+			return pageQuery{}, connect.NewError(connect.CodeInvalidArgument, errors.New("page is out of range"))
+		}
+		pq.Offset = int32(offset)
+	}
+	return pq, nil
+}
+
+// nextPage trims rows fetched with pageQuery.Limit down to the page size and returns
+// the token for the following page, or "" when rows was the last page.
+func nextPage[T any](rows []T, pq pageQuery, key func(T) pageCursor) ([]T, string) {
+	if len(rows) <= int(pq.PageSize) {
+		return rows, ""
+	}
+	rows = rows[:pq.PageSize]
+	return rows, encodePageToken(key(rows[len(rows)-1]))
+}
+
+// encodePageToken packs a cursor into an opaque URL-safe string.
+func encodePageToken(c pageCursor) string {
+	buf := make([]byte, 0, 1+4+8+16)
+	if c.Rank != nil {
+		buf = append(buf, pageTokenRankedV1)
+		buf = binary.BigEndian.AppendUint32(buf, math.Float32bits(*c.Rank))
+	} else {
+		buf = append(buf, pageTokenV1)
+	}
+	buf = binary.BigEndian.AppendUint64(buf, uint64(c.CreatedAt.Time.UnixMicro()))
+	buf = append(buf, c.ID.Bytes[:]...)
+	return base64.RawURLEncoding.EncodeToString(buf)
+}
+
+func decodePageToken(token string) (pageCursor, error) {
+	buf, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil || len(buf) == 0 {
+		return pageCursor{}, errInvalidPageToken
+	}
+
+	var c pageCursor
+	switch {
+	case buf[0] == pageTokenV1 && len(buf) == 1+8+16:
+		buf = buf[1:]
+	case buf[0] == pageTokenRankedV1 && len(buf) == 1+4+8+16:
+		rank := math.Float32frombits(binary.BigEndian.Uint32(buf[1:5]))
+		c.Rank = &rank
+		buf = buf[5:]
+	default:
+		return pageCursor{}, errInvalidPageToken
+	}
+	c.CreatedAt = pgtype.Timestamptz{Time: time.UnixMicro(int64(binary.BigEndian.Uint64(buf[:8]))), Valid: true}
+	c.ID = pgtype.UUID{Valid: true}
+	copy(c.ID.Bytes[:], buf[8:])
+	return c, nil
+}
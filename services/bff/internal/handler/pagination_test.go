@@ -0,0 +1,110 @@
+package handler
+
+import (
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+func TestPageTokenRoundTrip(t *testing.T) {
+	rank := float32(0.75)
+	tests := []struct {
+		name   string
+		cursor pageCursor
+	}{
+		{"plain", pageCursor{}},
+		{"ranked", pageCursor{Rank: &rank}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := tt.cursor
+			c.CreatedAt = pgtype.Timestamptz{Time: time.Date(2026, 3, 1, 12, 30, 0, 123456000, time.UTC), Valid: true}
+			c.ID = pgtype.UUID{Bytes: uuid.New(), Valid: true}
+
+			pq, err := pageRequest(0, 10, encodePageToken(c), c.Rank != nil)
+			if err != nil {
+				t.Fatalf("pageRequest: %v", err)
+			}
+			if !pq.AfterCreatedAt.Time.Equal(c.CreatedAt.Time) {
+				t.Errorf("created_at = %v, want %v", pq.AfterCreatedAt.Time, c.CreatedAt.Time)
+			}
+			if pq.AfterID != c.ID {
+				t.Errorf("id = %v, want %v", pq.AfterID, c.ID)
+			}
+			if (pq.AfterRank == nil) != (c.Rank == nil) || (c.Rank != nil && *pq.AfterRank != *c.Rank) {
+				t.Errorf("rank = %v, want %v", pq.AfterRank, c.Rank)
+			}
+			if pq.PageSize != 10 || pq.Limit != 11 || pq.Offset != 0 {
+				t.Errorf("window = %+v, want size 10, limit 11, no offset", pq)
+			}
+		})
+	}
+}
+
+func TestPageTokenRejected(t *testing.T) {
+	rank := float32(1)
+	plain := pageCursor{
+		CreatedAt: pgtype.Timestamptz{Time: time.Now(), Valid: true},
+		ID:        pgtype.UUID{Bytes: uuid.New(), Valid: true},
+	}
+	ranked := plain
+	ranked.Rank = &rank
+
+	raw, _ := base64.RawURLEncoding.DecodeString(encodePageToken(plain))
+	retagged := append([]byte{pageTokenRankedV1}, raw[1:]...)
+	truncated := raw[:len(raw)-1]
+	unknown := append([]byte{9}, raw[1:]...)
+
+	tests := []struct {
+		name   string
+		token  string
+		ranked bool
+	}{
+		{"plain token on a search", encodePageToken(plain), true},
+		{"ranked token on a listing", encodePageToken(ranked), false},
+		{"plain token tagged as ranked", base64.RawURLEncoding.EncodeToString(retagged), true},
+		{"truncated", base64.RawURLEncoding.EncodeToString(truncated), false},
+		{"unknown layout", base64.RawURLEncoding.EncodeToString(unknown), false},
+		{"not base64", "not a token!", false},
+		{"padded base64", base64.URLEncoding.EncodeToString(raw), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := pageRequest(0, 10, tt.token, tt.ranked)
+			if connect.CodeOf(err) != connect.CodeInvalidArgument {
+				t.Errorf("err = %v, want InvalidArgument", err)
+			}
+		})
+	}
+}
+
+func TestPageRequestWindow(t *testing.T) {
+	tests := []struct {
+		name               string
+		page, size         int32
+		wantSize, wantSkip int32
+	}{
+		{"defaults", 0, 0, defaultPageSize, 0},
+		{"capped size", 1, maxPageSize + 1, maxPageSize, 0},
+		{"third page", 3, 25, 25, 50},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pq, err := pageRequest(tt.page, tt.size, "", false)
+			if err != nil {
+				t.Fatalf("pageRequest: %v", err)
+			}
+			if pq.PageSize != tt.wantSize || pq.Limit != tt.wantSize+1 || pq.Offset != tt.wantSkip {
+				t.Errorf("window = %+v, want size %d, offset %d", pq, tt.wantSize, tt.wantSkip)
+			}
+		})
+	}
+
+	if _, err := pageRequest(1<<30, maxPageSize, "", false); connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Errorf("huge page: err = %v, want InvalidArgument", err)
+	}
+}
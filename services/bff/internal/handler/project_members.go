@@ -0,0 +1,254 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/ApeironFoundation/axle/bff/internal/audit"
+	bffv1 "github.com/ApeironFoundation/axle/contracts/go/bff/v1"
+	"github.com/ApeironFoundation/axle/contracts/go/bff/v1/gen_bff_v1connect"
+	gatewayv1 "github.com/ApeironFoundation/axle/contracts/go/gateway/v1"
+	gendb "github.com/ApeironFoundation/axle/db/generated"
+)
+
+// errLastAdmin is returned when a change would leave a project without an admin.
+var errLastAdmin = errors.New("project must keep at least one admin")
+
+// Compile-time interface check.
+var _ gen_bff_v1connect.ProjectMemberServiceHandler = (*ProjectMembersHandler)(nil)
+
+// ProjectMembersHandler implements the bff.v1.ProjectMemberService ConnectRPC methods.
+type ProjectMembersHandler struct {
+	Pool *pgxpool.Pool
+}
+
+func (h *ProjectMembersHandler) ListProjectMembers(
+	ctx context.Context,
+	req *bffv1.ListProjectMembersRequest,
+) (*bffv1.ListProjectMembersResponse, error) {
+	projectID, err := parseUUID("project_id", req.GetProjectId())
+	if err != nil {
+		return nil, err
+	}
+	q := gendb.New(h.Pool)
+	if _, err := q.GetProject(ctx, projectID); err != nil {
+		return nil, dbError(err, "project")
+	}
+	rows, err := q.ListProjectMembers(ctx, projectID)
+	if err != nil {
+		return nil, dbError(err, "project members")
+	}
+
+	members := make([]*bffv1.ProjectMember, 0, len(rows))
+	for _, m := range rows {
+		members = append(members, projectMemberToProto(gendb.GetProjectMemberRow(m)))
+	}
+	return &bffv1.ListProjectMembersResponse{Members: members}, nil
+}
+
+func (h *ProjectMembersHandler) AddProjectMember(
+	ctx context.Context,
+	req *bffv1.AddProjectMemberRequest,
+) (*bffv1.AddProjectMemberResponse, error) {
+	projectID, userID, err := parseMemberKey(req.GetProjectId(), req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	role := gendb.UserRoleMember
+	if req.GetRole() != bffv1.UserRole_USER_ROLE_UNSPECIFIED {
+		if role, err = userRoleFromProto(req.GetRole()); err != nil {
+			return nil, err
+		}
+	}
+
+	var member gendb.GetProjectMemberRow
+	err = withTx(ctx, h.Pool, func(q *gendb.Queries) error {
+		if err := lockLiveProject(ctx, q, projectID); err != nil {
+			return err
+		}
+		prev, err := q.GetProjectMember(ctx, gendb.GetProjectMemberParams{ProjectID: projectID, UserID: userID})
+		existed := err == nil
+		if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+			return dbError(err, "project member")
+		}
+		if existed && prev.Role == role {
+			// Nothing changes, so there is nothing to audit or announce.
+			member = prev
+			return nil
+		}
+		// Adding an existing member upserts the role, so the last-admin rule applies here too.
+		if role != gendb.UserRoleAdmin {
+			if err := ensureOtherAdmin(ctx, q, projectID, userID); err != nil {
+				return err
+			}
+		}
+		if _, err := q.AddProjectMember(ctx, gendb.AddProjectMemberParams{
+			ProjectID: projectID,
+			UserID:    userID,
+			Role:      role,
+		}); err != nil {
+			if isForeignKeyViolation(err) {
+				return connect.NewError(connect.CodeNotFound, errors.New("project or user not found"))
+			}
+			return dbError(err, "project member")
+		}
+		member, err = q.GetProjectMember(ctx, gendb.GetProjectMemberParams{ProjectID: projectID, UserID: userID})
+		if err != nil {
+			return dbError(err, "project member")
+		}
+		// An existing member only changed role: record and announce it as
+		// such, not as a new member.
+		var before any
+		event := gatewayv1.EventType_EVENT_TYPE_PROJECT_MEMBER_ADDED
+		if existed {
+			before = projectMemberToProto(prev)
+			event = gatewayv1.EventType_EVENT_TYPE_PROJECT_MEMBER_UPDATED
+		}
+		if err := audited(ctx, q, audit.EntityProjectMember, member.ID, before, projectMemberToProto(member)); err != nil {
+			return err
+		}
+		return emit(ctx, q, event, projectID, projectMemberToProto(member))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &bffv1.AddProjectMemberResponse{Member: projectMemberToProto(member)}, nil
+}
+
+func (h *ProjectMembersHandler) UpdateProjectMemberRole(
+	ctx context.Context,
+	req *bffv1.UpdateProjectMemberRoleRequest,
+) (*bffv1.UpdateProjectMemberRoleResponse, error) {
+	projectID, userID, err := parseMemberKey(req.GetProjectId(), req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	role, err := userRoleFromProto(req.GetRole())
+	if err != nil {
+		return nil, err
+	}
+
+	var member gendb.GetProjectMemberRow
+	err = withTx(ctx, h.Pool, func(q *gendb.Queries) error {
+		if err := lockLiveProject(ctx, q, projectID); err != nil {
+			return err
+		}
+		if role != gendb.UserRoleAdmin {
+			if err := ensureOtherAdmin(ctx, q, projectID, userID); err != nil {
+				return err
+			}
+		}
+		before, err := q.GetProjectMember(ctx, gendb.GetProjectMemberParams{ProjectID: projectID, UserID: userID})
+		if err != nil {
+			return dbError(err, "project member")
+		}
+		if _, err := q.UpdateProjectMemberRole(ctx, gendb.UpdateProjectMemberRoleParams{
+			ProjectID: projectID,
+			UserID:    userID,
+			Role:      role,
+		}); err != nil {
+			return dbError(err, "project member")
+		}
+		member, err = q.GetProjectMember(ctx, gendb.GetProjectMemberParams{ProjectID: projectID, UserID: userID})
+		if err != nil {
+			return dbError(err, "project member")
+		}
+		if err := audited(ctx, q, audit.EntityProjectMember, member.ID, projectMemberToProto(before), projectMemberToProto(member)); err != nil {
+			return err
+		}
+		return emit(ctx, q, gatewayv1.EventType_EVENT_TYPE_PROJECT_MEMBER_UPDATED, projectID, projectMemberToProto(member))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &bffv1.UpdateProjectMemberRoleResponse{Member: projectMemberToProto(member)}, nil
+}
+
+func (h *ProjectMembersHandler) RemoveProjectMember(
+	ctx context.Context,
+	req *bffv1.RemoveProjectMemberRequest,
+) (*bffv1.RemoveProjectMemberResponse, error) {
+	projectID, userID, err := parseMemberKey(req.GetProjectId(), req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	err = withTx(ctx, h.Pool, func(q *gendb.Queries) error {
+		if err := lockLiveProject(ctx, q, projectID); err != nil {
+			return err
+		}
+		if err := ensureOtherAdmin(ctx, q, projectID, userID); err != nil {
+			return err
+		}
+		before, err := q.GetProjectMember(ctx, gendb.GetProjectMemberParams{ProjectID: projectID, UserID: userID})
+		if err != nil {
+			return dbError(err, "project member")
+		}
+		if err := q.RemoveProjectMember(ctx, gendb.RemoveProjectMemberParams{ProjectID: projectID, UserID: userID}); err != nil {
+			return dbError(err, "project member")
+		}
+		if err := audited(ctx, q, audit.EntityProjectMember, before.ID, projectMemberToProto(before), nil); err != nil {
+			return err
+		}
+		return emit(ctx, q, gatewayv1.EventType_EVENT_TYPE_PROJECT_MEMBER_REMOVED, projectID, &bffv1.ProjectMember{
+			ProjectId: req.GetProjectId(),
+			UserId:    req.GetUserId(),
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &bffv1.RemoveProjectMemberResponse{}, nil
+}
+
+// lockLiveProject fails with CodeNotFound when the project does not exist or
+// is in the trash, and otherwise keeps it out of the trash until the
+// surrounding transaction ends.
+func lockLiveProject(ctx context.Context, q *gendb.Queries, projectID pgtype.UUID) error {
+	if _, err := q.LockProjectForShare(ctx, projectID); err != nil {
+		return dbError(err, "project")
+	}
+	return nil
+}
+
+// ensureOtherAdmin fails with CodeFailedPrecondition when userID is the only admin
+// of the project. The admin rows stay locked until the surrounding transaction ends.
+func ensureOtherAdmin(ctx context.Context, q *gendb.Queries, projectID, userID pgtype.UUID) error {
+	admins, err := q.LockProjectAdmins(ctx, projectID)
+	if err != nil {
+		return dbError(err, "project members")
+	}
+	if len(admins) == 1 && admins[0] == userID {
+		return connect.NewError(connect.CodeFailedPrecondition, errLastAdmin)
+	}
+	return nil
+}
+
+func parseMemberKey(projectID, userID string) (pgtype.UUID, pgtype.UUID, error) {
+	p, err := parseUUID("project_id", projectID)
+	if err != nil {
+		return pgtype.UUID{}, pgtype.UUID{}, err
+	}
+	u, err := parseUUID("user_id", userID)
+	if err != nil {
+		return pgtype.UUID{}, pgtype.UUID{}, err
+	}
+	return p, u, nil
+}
+
+func projectMemberToProto(m gendb.GetProjectMemberRow) *bffv1.ProjectMember {
+	return &bffv1.ProjectMember{
+		Id:        uuidString(m.ID),
+		ProjectId: uuidString(m.ProjectID),
+		UserId:    uuidString(m.UserID),
+		UserName:  m.UserName,
+		UserEmail: m.UserEmail,
+		Role:      userRoleToProto(m.Role),
+		JoinedAt:  timestampProto(m.JoinedAt),
+	}
+}
@@ -2,72 +2,475 @@ package handler
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"html"
+	"strings"
+	"time"
 
 	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 
+	"github.com/ApeironFoundation/axle/bff/internal/audit"
+	"github.com/ApeironFoundation/axle/bff/internal/auth"
+	"github.com/ApeironFoundation/axle/bff/internal/cache"
 	bffv1 "github.com/ApeironFoundation/axle/contracts/go/bff/v1"
 	"github.com/ApeironFoundation/axle/contracts/go/bff/v1/gen_bff_v1connect"
+	gatewayv1 "github.com/ApeironFoundation/axle/contracts/go/gateway/v1"
+	gendb "github.com/ApeironFoundation/axle/db/generated"
 )
 
 // Compile-time interface check.
 var _ gen_bff_v1connect.ProjectServiceHandler = (*ProjectsHandler)(nil)
 
-// ProjectsHandler implements the bff.v1.ProjectService ConnectRPC methods.
-// Stub implementation — real DB queries wired when migrations are applied.
+// ProjectsHandler implements the bff.v1.ProjectService ConnectRPC methods
+// on top of the sqlc-generated queries for the projects table.
 type ProjectsHandler struct {
-	Pool *pgxpool.Pool
+	Pool  *pgxpool.Pool
+	Cache *cache.Cache
+	// TrashRetention is how long deleted projects can be restored; it is
+	// reported to clients, the purger enforces it.
+	TrashRetention time.Duration
 }
 
 func (h *ProjectsHandler) ListProjects(
-	_ context.Context,
+	ctx context.Context,
 	req *bffv1.ListProjectsRequest,
 ) (*bffv1.ListProjectsResponse, error) {
-	pageSize := req.GetPageSize()
-	if pageSize <= 0 {
-		pageSize = 20
-	}
-	// TODO: query pool for real data after goose migrations are applied.
-	return &bffv1.ListProjectsResponse{
-		Projects: []*bffv1.Project{},
-		Total:    0,
-	}, nil
+	query := strings.TrimSpace(req.GetQuery())
+	pq, err := pageRequest(req.GetPage(), req.GetPageSize(), req.GetPageToken(), query != "")
+	if err != nil {
+		return nil, err
+	}
+	f, err := projectFilterFromRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	// Global admins see every project; everyone else lists their own.
+	if p, ok := auth.PrincipalFromContext(ctx); ok && p.Role != gendb.UserRoleAdmin {
+		caller := pgtype.UUID{Bytes: p.UserID, Valid: true}
+		if f.MemberUserID.Valid && f.MemberUserID != caller {
+			return nil, connect.NewError(connect.CodePermissionDenied,
+				errors.New("member_user_id: only admins can list other users' projects"))
+		}
+		f.MemberUserID = caller
+	}
+	q := gendb.New(h.Pool)
+
+	resp := &bffv1.ListProjectsResponse{}
+	if query == "" {
+		rows, err := q.ListProjects(ctx, gendb.ListProjectsParams{
+			Status:         f.Status,
+			NamePattern:    f.NamePattern,
+			MemberUserID:   f.MemberUserID,
+			UpdatedSince:   f.UpdatedSince,
+			AfterCreatedAt: pq.AfterCreatedAt,
+			AfterID:        pq.AfterID,
+			Limit:          pq.Limit,
+			Offset:         pq.Offset,
+		})
+		if err != nil {
+			return nil, dbError(err, "projects")
+		}
+		rows, resp.NextPageToken = nextPage(rows, pq, func(p gendb.Project) pageCursor {
+			return pageCursor{CreatedAt: p.CreatedAt, ID: p.ID}
+		})
+		resp.Projects = make([]*bffv1.Project, 0, len(rows))
+		for _, p := range rows {
+			resp.Projects = append(resp.Projects, projectToProto(p))
+		}
+	} else {
+		rows, err := q.SearchProjects(ctx, gendb.SearchProjectsParams{
+			Query:          query,
+			Status:         f.Status,
+			NamePattern:    f.NamePattern,
+			MemberUserID:   f.MemberUserID,
+			UpdatedSince:   f.UpdatedSince,
+			AfterRank:      pq.AfterRank,
+			AfterCreatedAt: pq.AfterCreatedAt,
+			AfterID:        pq.AfterID,
+			Limit:          pq.Limit,
+			Offset:         pq.Offset,
+		})
+		if err != nil {
+			return nil, dbError(err, "projects")
+		}
+		rows, resp.NextPageToken = nextPage(rows, pq, func(r gendb.SearchProjectsRow) pageCursor {
+			return pageCursor{Rank: &r.Rank, CreatedAt: r.CreatedAt, ID: r.ID}
+		})
+		resp.Projects = make([]*bffv1.Project, 0, len(rows))
+		resp.Highlights = make(map[string]*bffv1.ProjectHighlight, len(rows))
+		for _, r := range rows {
+			p := projectToProto(gendb.Project{
+				ID:          r.ID,
+				Name:        r.Name,
+				Description: r.Description,
+				Status:      r.Status,
+				Settings:    r.Settings,
+				CreatedAt:   r.CreatedAt,
+				UpdatedAt:   r.UpdatedAt,
+				Version:     r.Version,
+			})
+			resp.Projects = append(resp.Projects, p)
+			resp.Highlights[p.Id] = &bffv1.ProjectHighlight{
+				Name:        highlightMarkup(r.NameHighlight),
+				Description: highlightMarkup(r.DescriptionHighlight),
+				Rank:        r.Rank,
+			}
+		}
+	}
+
+	if !req.GetSkipTotal() {
+		params := gendb.CountProjectsParams{
+			Status:       f.Status,
+			NamePattern:  f.NamePattern,
+			MemberUserID: f.MemberUserID,
+			UpdatedSince: f.UpdatedSince,
+		}
+		if query != "" {
+			params.Query = &query
+		}
+		total, err := q.CountProjects(ctx, params)
+		if err != nil {
+			return nil, dbError(err, "projects")
+		}
+		resp.Total = int32(total)
+	}
+	return resp, nil
 }
 
 func (h *ProjectsHandler) GetProject(
-	_ context.Context,
+	ctx context.Context,
 	req *bffv1.GetProjectRequest,
 ) (*bffv1.GetProjectResponse, error) {
-	return nil, connect.NewError(connect.CodeNotFound,
-		errors.New("project not found: "+req.GetId()))
+	id, err := parseUUID("id", req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	p, err := h.Cache.Project(ctx, gendb.New(h.Pool), id)
+	if err != nil {
+		return nil, dbError(err, "project")
+	}
+	return &bffv1.GetProjectResponse{Project: projectToProto(p)}, nil
 }
 
 func (h *ProjectsHandler) CreateProject(
-	_ context.Context,
+	ctx context.Context,
 	req *bffv1.CreateProjectRequest,
 ) (*bffv1.CreateProjectResponse, error) {
-	// TODO: insert into DB.
-	return &bffv1.CreateProjectResponse{
-		Project: &bffv1.Project{
-			Id:          "00000000-0000-0000-0000-000000000000",
-			Name:        req.GetName(),
+	name := strings.TrimSpace(req.GetName())
+	if name == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("name is required"))
+	}
+	var p gendb.Project
+	err := withTx(ctx, h.Pool, func(q *gendb.Queries) error {
+		var err error
+		p, err = q.CreateProject(ctx, gendb.CreateProjectParams{
+			Name:        name,
 			Description: req.GetDescription(),
-			Status:      bffv1.ProjectStatus_PROJECT_STATUS_ACTIVE,
-		},
-	}, nil
+		})
+		if err != nil {
+			return dbError(err, "project")
+		}
+		// The creator administers the new project.
+		if creator, ok := auth.PrincipalFromContext(ctx); ok {
+			m, err := q.AddProjectMember(ctx, gendb.AddProjectMemberParams{
+				ProjectID: p.ID,
+				UserID:    pgtype.UUID{Bytes: creator.UserID, Valid: true},
+				Role:      gendb.UserRoleAdmin,
+			})
+			if err != nil {
+				return dbError(err, "project member")
+			}
+			if err := audited(ctx, q, audit.EntityProjectMember, m.ID, nil, m); err != nil {
+				return err
+			}
+		}
+		if err := audited(ctx, q, audit.EntityProject, p.ID, nil, projectToProto(p)); err != nil {
+			return err
+		}
+		return emit(ctx, q, gatewayv1.EventType_EVENT_TYPE_PROJECT_CREATED, p.ID, projectToProto(p))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &bffv1.CreateProjectResponse{Project: projectToProto(p)}, nil
 }
 
 func (h *ProjectsHandler) UpdateProject(
-	_ context.Context,
-	_ *bffv1.UpdateProjectRequest,
+	ctx context.Context,
+	req *bffv1.UpdateProjectRequest,
 ) (*bffv1.UpdateProjectResponse, error) {
-	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("not implemented"))
+	id, err := parseUUID("id", req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	mask, err := updateMask(req.GetUpdateMask(), "name", "description", "status")
+	if err != nil {
+		return nil, err
+	}
+	if mask == nil {
+		// No mask: apply only the fields that carry a value.
+		mask = map[string]bool{
+			"name":        req.GetName() != "",
+			"description": req.GetDescription() != "",
+			"status":      req.GetStatus() != bffv1.ProjectStatus_PROJECT_STATUS_UNSPECIFIED,
+		}
+	}
+
+	expected, err := parseETag(req.GetExpectedEtag())
+	if err != nil {
+		return nil, err
+	}
+	params := gendb.UpdateProjectParams{ID: id, ExpectedVersion: expected}
+	if mask["name"] {
+		name := strings.TrimSpace(req.GetName())
+		if name == "" {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("name must not be empty"))
+		}
+		params.SetName, params.Name = true, name
+	}
+	if mask["description"] {
+		params.SetDescription, params.Description = true, req.GetDescription()
+	}
+	if mask["status"] {
+		status, err := projectStatusFromProto(req.GetStatus())
+		if err != nil {
+			return nil, err
+		}
+		params.SetStatus = true
+		params.Status = gendb.NullProjectStatus{ProjectStatus: status, Valid: true}
+	}
+
+	var p gendb.Project
+	err = withTx(ctx, h.Pool, func(q *gendb.Queries) error {
+		before, err := q.LockProject(ctx, id)
+		if err != nil {
+			return dbError(err, "project")
+		}
+		p, err = q.UpdateProject(ctx, params)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return versionConflict(ctx, "project", params.ExpectedVersion, func(ctx context.Context) (int64, error) {
+				return q.GetProjectVersion(ctx, id)
+			})
+		}
+		if err != nil {
+			return dbError(err, "project")
+		}
+		if err := audited(ctx, q, audit.EntityProject, id, projectToProto(before), projectToProto(p)); err != nil {
+			return err
+		}
+		return emit(ctx, q, gatewayv1.EventType_EVENT_TYPE_PROJECT_UPDATED, id, projectToProto(p))
+	})
+	if err != nil {
+		return nil, err
+	}
+	h.Cache.Changed(ctx, cache.KindProject, id, p.Version)
+	return &bffv1.UpdateProjectResponse{Project: projectToProto(p)}, nil
 }
 
 func (h *ProjectsHandler) DeleteProject(
-	_ context.Context,
-	_ *bffv1.DeleteProjectRequest,
+	ctx context.Context,
+	req *bffv1.DeleteProjectRequest,
 ) (*bffv1.DeleteProjectResponse, error) {
-	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("not implemented"))
+	id, err := parseUUID("id", req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	expected, err := parseETag(req.GetExpectedEtag())
+	if err != nil {
+		return nil, err
+	}
+	var version int64
+	err = withTx(ctx, h.Pool, func(q *gendb.Queries) error {
+		before, err := q.LockProject(ctx, id)
+		if err != nil {
+			return dbError(err, "project")
+		}
+		trashed, err := q.TrashProject(ctx, gendb.TrashProjectParams{ID: id, ExpectedVersion: expected})
+		if errors.Is(err, pgx.ErrNoRows) {
+			return versionConflict(ctx, "project", expected, func(ctx context.Context) (int64, error) {
+				return q.GetProjectVersion(ctx, id)
+			})
+		}
+		if err != nil {
+			return dbError(err, "project")
+		}
+		if err := audited(ctx, q, audit.EntityProject, id, projectToProto(before), projectToProto(trashed)); err != nil {
+			return err
+		}
+		version = trashed.Version
+		// The etag tells caches which version the project was trashed at.
+		return emit(ctx, q, gatewayv1.EventType_EVENT_TYPE_PROJECT_DELETED, id,
+			&bffv1.Project{Id: req.GetId(), Etag: formatETag(trashed.Version)})
+	})
+	if err != nil {
+		return nil, err
+	}
+	h.Cache.Changed(ctx, cache.KindProject, id, version)
+	return &bffv1.DeleteProjectResponse{}, nil
+}
+
+func (h *ProjectsHandler) GetProjectSettings(
+	ctx context.Context,
+	req *bffv1.GetProjectSettingsRequest,
+) (*bffv1.GetProjectSettingsResponse, error) {
+	id, err := parseUUID("project_id", req.GetProjectId())
+	if err != nil {
+		return nil, err
+	}
+	p, err := h.Cache.Project(ctx, gendb.New(h.Pool), id)
+	if err != nil {
+		return nil, dbError(err, "project")
+	}
+	settings, err := settingsProto(p.Settings, "project settings")
+	if err != nil {
+		return nil, err
+	}
+	return &bffv1.GetProjectSettingsResponse{Settings: settings, Etag: formatETag(p.Version)}, nil
+}
+
+func (h *ProjectsHandler) UpdateProjectSettings(
+	ctx context.Context,
+	req *bffv1.UpdateProjectSettingsRequest,
+) (*bffv1.UpdateProjectSettingsResponse, error) {
+	id, err := parseUUID("project_id", req.GetProjectId())
+	if err != nil {
+		return nil, err
+	}
+	expected, err := parseETag(req.GetExpectedEtag())
+	if err != nil {
+		return nil, err
+	}
+	patch := req.GetPatch().AsMap()
+	if err := projectSettingsSchema.validatePatch(patch); err != nil {
+		return nil, err
+	}
+
+	var row gendb.SetProjectSettingsRow
+	err = withTx(ctx, h.Pool, func(q *gendb.Queries) error {
+		cur, err := q.LockProjectSettings(ctx, id)
+		if err != nil {
+			return dbError(err, "project")
+		}
+		if err := checkVersion("project", expected, cur.Version); err != nil {
+			return err
+		}
+		merged, err := applySettingsPatch(cur.Settings, patch, "project settings")
+		if err != nil {
+			return err
+		}
+		row, err = q.SetProjectSettings(ctx, gendb.SetProjectSettingsParams{ID: id, Settings: merged})
+		if err != nil {
+			return dbError(err, "project")
+		}
+		if err := audited(ctx, q, audit.EntityProject, id,
+			map[string]json.RawMessage{"settings": cur.Settings},
+			map[string]json.RawMessage{"settings": merged},
+		); err != nil {
+			return err
+		}
+		// Settings are not part of bff.v1.Project, but its etag moves with them.
+		p, err := q.GetProject(ctx, id)
+		if err != nil {
+			return dbError(err, "project")
+		}
+		return emit(ctx, q, gatewayv1.EventType_EVENT_TYPE_PROJECT_UPDATED, id, projectToProto(p))
+	})
+	if err != nil {
+		return nil, err
+	}
+	h.Cache.Changed(ctx, cache.KindProject, id, row.Version)
+	settings, err := settingsProto(row.Settings, "project settings")
+	if err != nil {
+		return nil, err
+	}
+	return &bffv1.UpdateProjectSettingsResponse{Settings: settings, Etag: formatETag(row.Version)}, nil
+}
+
+// projectFilter holds the optional ListProjects filters in query-parameter form;
+// zero values leave a filter disabled.
+type projectFilter struct {
+	Status       gendb.NullProjectStatus
+	NamePattern  *string
+	MemberUserID pgtype.UUID
+	UpdatedSince pgtype.Timestamptz
+}
+
+func projectFilterFromRequest(req *bffv1.ListProjectsRequest) (projectFilter, error) {
+	var f projectFilter
+	if req.GetStatus() != bffv1.ProjectStatus_PROJECT_STATUS_UNSPECIFIED {
+		status, err := projectStatusFromProto(req.GetStatus())
+		if err != nil {
+			return projectFilter{}, err
+		}
+		f.Status = gendb.NullProjectStatus{ProjectStatus: status, Valid: true}
+	}
+	if name := req.GetNameContains(); name != "" {
+		pattern := "%" + likeEscaper.Replace(name) + "%"
+		f.NamePattern = &pattern
+	}
+	if id := req.GetMemberUserId(); id != "" {
+		u, err := parseUUID("member_user_id", id)
+		if err != nil {
+			return projectFilter{}, err
+		}
+		f.MemberUserID = u
+	}
+	if ts := req.GetUpdatedSince(); ts != nil {
+		if err := ts.CheckValid(); err != nil {
+			return projectFilter{}, connect.NewError(connect.CodeInvalidArgument, errors.New("updated_since: invalid timestamp"))
+		}
+		f.UpdatedSince = pgtype.Timestamptz{Time: ts.AsTime(), Valid: true}
+	}
+	return f, nil
+}
+
+// likeEscaper escapes the LIKE wildcards so user input matches literally.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// highlightMarkup HTML-escapes a ts_headline result and turns its \x02/\x03
+// match markers into <mark> tags.
+func highlightMarkup(s string) string {
+	return highlightReplacer.Replace(html.EscapeString(s))
+}
+
+var highlightReplacer = strings.NewReplacer("\x02", "<mark>", "\x03", "</mark>")
+
+func projectToProto(p gendb.Project) *bffv1.Project {
+	return &bffv1.Project{
+		Id:          uuidString(p.ID),
+		Name:        p.Name,
+		Description: p.Description,
+		Status:      projectStatusToProto(p.Status),
+		CreatedAt:   timestampProto(p.CreatedAt),
+		UpdatedAt:   timestampProto(p.UpdatedAt),
+		Etag:        formatETag(p.Version),
+		DeletedAt:   timestampProto(p.DeletedAt),
+	}
+}
+
+func projectStatusToProto(s gendb.ProjectStatus) bffv1.ProjectStatus {
+	switch s {
+	case gendb.ProjectStatusActive:
+		return bffv1.ProjectStatus_PROJECT_STATUS_ACTIVE
+	case gendb.ProjectStatusArchived:
+		return bffv1.ProjectStatus_PROJECT_STATUS_ARCHIVED
+	default:
+		return bffv1.ProjectStatus_PROJECT_STATUS_UNSPECIFIED
+	}
+}
+
+func projectStatusFromProto(s bffv1.ProjectStatus) (gendb.ProjectStatus, error) {
+	switch s {
+	case bffv1.ProjectStatus_PROJECT_STATUS_ACTIVE:
+		return gendb.ProjectStatusActive, nil
+	case bffv1.ProjectStatus_PROJECT_STATUS_ARCHIVED:
+		return gendb.ProjectStatusArchived, nil
+	default:
+		return "", connect.NewError(connect.CodeInvalidArgument, errors.New("invalid project status: "+s.String()))
+	}
 }
@@ -0,0 +1,81 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/ApeironFoundation/axle/bff/internal/audit"
+	"github.com/ApeironFoundation/axle/bff/internal/auth"
+	"github.com/ApeironFoundation/axle/bff/internal/cache"
+	bffv1 "github.com/ApeironFoundation/axle/contracts/go/bff/v1"
+	gatewayv1 "github.com/ApeironFoundation/axle/contracts/go/gateway/v1"
+	gendb "github.com/ApeironFoundation/axle/db/generated"
+)
+
+func (h *ProjectsHandler) ListTrashedProjects(
+	ctx context.Context,
+	req *bffv1.ListTrashedProjectsRequest,
+) (*bffv1.ListTrashedProjectsResponse, error) {
+	pq, err := pageRequest(0, req.GetPageSize(), req.GetPageToken(), false)
+	if err != nil {
+		return nil, err
+	}
+	// Global admins see the whole trash; everyone else the projects they can restore.
+	var adminUserID pgtype.UUID
+	if p, ok := auth.PrincipalFromContext(ctx); ok && p.Role != gendb.UserRoleAdmin {
+		adminUserID = pgtype.UUID{Bytes: p.UserID, Valid: true}
+	}
+	rows, err := gendb.New(h.Pool).ListTrashedProjects(ctx, gendb.ListTrashedProjectsParams{
+		AdminUserID:    adminUserID,
+		AfterDeletedAt: pq.AfterCreatedAt,
+		AfterID:        pq.AfterID,
+		Limit:          pq.Limit,
+	})
+	if err != nil {
+		return nil, dbError(err, "projects")
+	}
+	rows, next := nextPage(rows, pq, func(p gendb.Project) pageCursor {
+		return pageCursor{CreatedAt: p.DeletedAt, ID: p.ID}
+	})
+
+	resp := &bffv1.ListTrashedProjectsResponse{
+		Projects:      make([]*bffv1.Project, 0, len(rows)),
+		NextPageToken: next,
+		Retention:     durationpb.New(h.TrashRetention),
+	}
+	for _, p := range rows {
+		resp.Projects = append(resp.Projects, projectToProto(p))
+	}
+	return resp, nil
+}
+
+func (h *ProjectsHandler) RestoreProject(
+	ctx context.Context,
+	req *bffv1.RestoreProjectRequest,
+) (*bffv1.RestoreProjectResponse, error) {
+	id, err := parseUUID("id", req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	var p gendb.Project
+	err = withTx(ctx, h.Pool, func(q *gendb.Queries) error {
+		before, err := q.LockTrashedProject(ctx, id)
+		if err != nil {
+			return dbError(err, "trashed project")
+		}
+		if p, err = q.RestoreProject(ctx, id); err != nil {
+			return dbError(err, "project")
+		}
+		if err := audited(ctx, q, audit.EntityProject, id, projectToProto(before), projectToProto(p)); err != nil {
+			return err
+		}
+		return emit(ctx, q, gatewayv1.EventType_EVENT_TYPE_PROJECT_RESTORED, id, projectToProto(p))
+	})
+	if err != nil {
+		return nil, err
+	}
+	h.Cache.Changed(ctx, cache.KindProject, id, p.Version)
+	return &bffv1.RestoreProjectResponse{Project: projectToProto(p)}, nil
+}
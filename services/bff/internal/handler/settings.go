@@ -0,0 +1,165 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"sort"
+
+	"connectrpc.com/connect"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// settingKind is the JSON type a settings key must hold.
+type settingKind int
+
+const (
+	settingString settingKind = iota + 1
+	settingNumber
+	settingInteger
+	settingBool
+)
+
+// settingSpec describes one allowed key of a JSONB settings document.
+type settingSpec struct {
+	Kind  settingKind
+	OneOf []string // allowed string values; empty means any string
+	Min   float64  // inclusive lower bound for numbers
+	Max   float64  // inclusive upper bound for numbers; 0 means unbounded
+}
+
+// settingsSchema lists the keys a settings document may contain.
+// Adding a key here is all it takes to make it storable; no migration is needed.
+type settingsSchema map[string]settingSpec
+
+// projectSettingsSchema covers projects.settings: per-project defaults.
+var projectSettingsSchema = settingsSchema{
+	"default_provider":    {Kind: settingString},
+	"default_model":       {Kind: settingString},
+	"default_temperature": {Kind: settingNumber, Min: 0, Max: 2},
+	"default_max_tokens":  {Kind: settingInteger, Min: 1},
+}
+
+// userPreferencesSchema covers users.preferences: per-user UI state.
+var userPreferencesSchema = settingsSchema{
+	"theme":              {Kind: settingString, OneOf: []string{"light", "dark", "system"}},
+	"density":            {Kind: settingString, OneOf: []string{"comfortable", "compact"}},
+	"sidebar_collapsed":  {Kind: settingBool},
+	"default_project_id": {Kind: settingString},
+	"page_size":          {Kind: settingInteger, Min: 1, Max: maxPageSize},
+}
+
+// validatePatch checks every key of a merge patch against the schema.
+// Null values are always accepted because they delete the key.
+func (s settingsSchema) validatePatch(patch map[string]any) error {
+	keys := make([]string, 0, len(patch))
+	for k := range patch {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys) // report the same key first on every call
+
+	for _, k := range keys {
+		v := patch[k]
+		spec, ok := s[k]
+		if !ok {
+			return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("patch: unknown key %q", k))
+		}
+		if v == nil {
+			continue
+		}
+		if err := spec.check(v); err != nil {
+			return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("patch.%s: %w", k, err))
+		}
+	}
+	return nil
+}
+
+func (spec settingSpec) check(v any) error {
+	switch spec.Kind {
+	case settingString:
+		s, ok := v.(string)
+		if !ok {
+			return errors.New("must be a string")
+		}
+		if len(spec.OneOf) > 0 && !slices.Contains(spec.OneOf, s) {
+			return fmt.Errorf("must be one of %q", spec.OneOf)
+		}
+	case settingNumber, settingInteger:
+		n, ok := v.(float64)
+		if !ok {
+			return errors.New("must be a number")
+		}
+		if spec.Kind == settingInteger && n != math.Trunc(n) {
+			return errors.New("must be an integer")
+		}
+		if n < spec.Min || (spec.Max != 0 && n > spec.Max) {
+			return errors.New("out of range")
+		}
+	case settingBool:
+		if _, ok := v.(bool); !ok {
+			return errors.New("must be a boolean")
+		}
+	}
+	return nil
+}
+
+// mergePatch applies an RFC 7386 JSON merge patch to doc and returns the result.
+func mergePatch(doc, patch map[string]any) map[string]any {
+	if doc == nil {
+		doc = make(map[string]any, len(patch))
+	}
+	for k, v := range patch {
+		switch pv := v.(type) {
+		case nil:
+			delete(doc, k)
+		case map[string]any:
+			dv, _ := doc[k].(map[string]any)
+			doc[k] = mergePatch(dv, pv)
+		default:
+			doc[k] = v
+		}
+	}
+	return doc
+}
+
+// applySettingsPatch merges patch into a stored JSONB document and re-encodes it.
+func applySettingsPatch(raw []byte, patch map[string]any, what string) ([]byte, error) {
+	doc, err := decodeSettings(raw, what)
+	if err != nil {
+		return nil, err
+	}
+	out, err := json.Marshal(mergePatch(doc, patch))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("patch: %w", err))
+	}
+	return out, nil
+}
+
+// settingsProto converts a stored JSONB document into a protobuf Struct.
+func settingsProto(raw []byte, what string) (*structpb.Struct, error) {
+	doc, err := decodeSettings(raw, what)
+	if err != nil {
+		return nil, err
+	}
+	s, err := structpb.NewStruct(doc)
+	if err != nil {
+		log.Error().Err(err).Str("entity", what).Msg("settings are not representable as a Struct")
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("%s: corrupt document", what))
+	}
+	return s, nil
+}
+
+func decodeSettings(raw []byte, what string) (map[string]any, error) {
+	doc := map[string]any{}
+	if len(raw) == 0 {
+		return doc, nil
+	}
+	if err := json.Unmarshal(raw, &doc); err != nil {
+		log.Error().Err(err).Str("entity", what).Msg("stored settings are not a JSON object")
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("%s: corrupt document", what))
+	}
+	return doc, nil
+}
@@ -0,0 +1,53 @@
+package handler
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+// The cases follow the examples in RFC 7386 Appendix A, restricted to object
+// documents and patches since settings are always objects.
+func TestApplySettingsPatch(t *testing.T) {
+	tests := []struct {
+		name  string
+		doc   string
+		patch string
+		want  string
+	}{
+		{"replace", `{"a":"b"}`, `{"a":"c"}`, `{"a":"c"}`},
+		{"add", `{"a":"b"}`, `{"b":"c"}`, `{"a":"b","b":"c"}`},
+		{"null deletes", `{"a":"b"}`, `{"a":null}`, `{}`},
+		{"null keeps siblings", `{"a":"b","b":"c"}`, `{"a":null}`, `{"b":"c"}`},
+		{"array replaced whole", `{"a":["b"]}`, `{"a":"c"}`, `{"a":"c"}`},
+		{"value replaced by array", `{"a":"c"}`, `{"a":["b"]}`, `{"a":["b"]}`},
+		{"nested merge", `{"a":{"b":"c"}}`, `{"a":{"b":"d","c":null}}`, `{"a":{"b":"d"}}`},
+		{"nested null deletes", `{"a":{"b":"c","d":"e"}}`, `{"a":{"d":null}}`, `{"a":{"b":"c"}}`},
+		{"array of objects replaced", `{"a":[{"b":"c"}]}`, `{"a":[1]}`, `{"a":[1]}`},
+		{"null absent key", `{"e":null}`, `{"a":1}`, `{"e":null,"a":1}`},
+		{"object over scalar", `{"a":"foo"}`, `{"a":{"bb":{"ccc":null}}}`, `{"a":{"bb":{}}}`},
+		{"empty document", ``, `{"a":{"b":null,"c":1}}`, `{"a":{"c":1}}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var patch map[string]any
+			if err := json.Unmarshal([]byte(tt.patch), &patch); err != nil {
+				t.Fatal(err)
+			}
+			out, err := applySettingsPatch([]byte(tt.doc), patch, "test")
+			if err != nil {
+				t.Fatalf("applySettingsPatch: %v", err)
+			}
+			var got, want map[string]any
+			if err := json.Unmarshal(out, &got); err != nil {
+				t.Fatal(err)
+			}
+			if err := json.Unmarshal([]byte(tt.want), &want); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("got %s, want %s", out, tt.want)
+			}
+		})
+	}
+}
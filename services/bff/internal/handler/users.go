@@ -2,56 +2,324 @@ package handler
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"strings"
 
 	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
 
+	"github.com/ApeironFoundation/axle/bff/internal/audit"
+	"github.com/ApeironFoundation/axle/bff/internal/auth"
+	"github.com/ApeironFoundation/axle/bff/internal/cache"
 	bffv1 "github.com/ApeironFoundation/axle/contracts/go/bff/v1"
 	"github.com/ApeironFoundation/axle/contracts/go/bff/v1/gen_bff_v1connect"
+	gatewayv1 "github.com/ApeironFoundation/axle/contracts/go/gateway/v1"
+	gendb "github.com/ApeironFoundation/axle/db/generated"
 )
 
 // Compile-time interface check.
 var _ gen_bff_v1connect.UserServiceHandler = (*UsersHandler)(nil)
 
 // UsersHandler implements the bff.v1.UserService ConnectRPC methods.
-type UsersHandler struct{}
+type UsersHandler struct {
+	Pool        *pgxpool.Pool
+	Cache       *cache.Cache
+	Revocations *auth.RevocationCache
+}
 
 func (h *UsersHandler) ListUsers(
-	_ context.Context,
-	_ *bffv1.ListUsersRequest,
+	ctx context.Context,
+	req *bffv1.ListUsersRequest,
 ) (*bffv1.ListUsersResponse, error) {
+	pq, err := pageRequest(req.GetPage(), req.GetPageSize(), req.GetPageToken(), false)
+	if err != nil {
+		return nil, err
+	}
+	q := gendb.New(h.Pool)
+
+	rows, err := q.ListUsers(ctx, gendb.ListUsersParams{
+		AfterCreatedAt: pq.AfterCreatedAt,
+		AfterID:        pq.AfterID,
+		Limit:          pq.Limit,
+		Offset:         pq.Offset,
+	})
+	if err != nil {
+		return nil, dbError(err, "users")
+	}
+	rows, nextToken := nextPage(rows, pq, func(u gendb.User) pageCursor {
+		return pageCursor{CreatedAt: u.CreatedAt, ID: u.ID}
+	})
+
+	var total int64
+	if !req.GetSkipTotal() {
+		if total, err = q.CountUsers(ctx); err != nil {
+			return nil, dbError(err, "users")
+		}
+	}
+
+	users := make([]*bffv1.User, 0, len(rows))
+	for _, u := range rows {
+		users = append(users, userToProto(u))
+	}
 	return &bffv1.ListUsersResponse{
-		Users: []*bffv1.User{},
-		Total: 0,
+		Users:         users,
+		Total:         int32(total),
+		NextPageToken: nextToken,
 	}, nil
 }
 
 func (h *UsersHandler) GetUser(
-	_ context.Context,
+	ctx context.Context,
 	req *bffv1.GetUserRequest,
 ) (*bffv1.GetUserResponse, error) {
-	return nil, connect.NewError(connect.CodeNotFound,
-		errors.New("user not found: "+req.GetId()))
+	id, err := parseUUID("id", req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	u, err := h.Cache.User(ctx, gendb.New(h.Pool), id)
+	if err != nil {
+		return nil, dbError(err, "user")
+	}
+	return &bffv1.GetUserResponse{User: userToProto(u)}, nil
 }
 
 func (h *UsersHandler) GetMe(
-	_ context.Context,
+	ctx context.Context,
 	_ *bffv1.GetMeRequest,
 ) (*bffv1.GetMeResponse, error) {
-	// TODO: extract user from auth context (Ory integration).
-	return &bffv1.GetMeResponse{
-		User: &bffv1.User{
-			Id:    "00000000-0000-0000-0000-000000000000",
-			Name:  "Demo User",
-			Email: "demo@github.com/ApeironFoundation/axle",
-			Role:  bffv1.UserRole_USER_ROLE_ADMIN,
-		},
-	}, nil
+	id, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	u, err := h.Cache.User(ctx, gendb.New(h.Pool), id)
+	if err != nil {
+		return nil, dbError(err, "user")
+	}
+	return &bffv1.GetMeResponse{User: userToProto(u)}, nil
+}
+
+func (h *UsersHandler) GetMyAccess(
+	ctx context.Context,
+	_ *bffv1.GetMyAccessRequest,
+) (*bffv1.GetMyAccessResponse, error) {
+	id, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	q := gendb.New(h.Pool)
+	u, err := h.Cache.User(ctx, q, id)
+	if err != nil {
+		return nil, dbError(err, "user")
+	}
+	projects, err := q.ListMemberProjectIDs(ctx, id)
+	if err != nil {
+		return nil, dbError(err, "project members")
+	}
+	resp := &bffv1.GetMyAccessResponse{
+		UserId:     uuidString(u.ID),
+		Role:       userRoleToProto(u.Role),
+		ProjectIds: make([]string, 0, len(projects)),
+	}
+	for _, p := range projects {
+		resp.ProjectIds = append(resp.ProjectIds, uuidString(p))
+	}
+	return resp, nil
 }
 
 func (h *UsersHandler) UpdateUser(
-	_ context.Context,
-	_ *bffv1.UpdateUserRequest,
+	ctx context.Context,
+	req *bffv1.UpdateUserRequest,
 ) (*bffv1.UpdateUserResponse, error) {
-	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("not implemented"))
+	id, err := parseUUID("id", req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	mask, err := updateMask(req.GetUpdateMask(), "name", "locale")
+	if err != nil {
+		return nil, err
+	}
+	if mask == nil {
+		// No mask: apply only the fields that carry a value.
+		mask = map[string]bool{
+			"name":   req.GetName() != "",
+			"locale": req.GetLocale() != "",
+		}
+	}
+
+	expected, err := parseETag(req.GetExpectedEtag())
+	if err != nil {
+		return nil, err
+	}
+	params := gendb.UpdateUserParams{ID: id, ExpectedVersion: expected}
+	if mask["name"] {
+		name := strings.TrimSpace(req.GetName())
+		if name == "" {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("name must not be empty"))
+		}
+		params.SetName, params.Name = true, name
+	}
+	if mask["locale"] {
+		locale := strings.TrimSpace(req.GetLocale())
+		if locale == "" {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("locale must not be empty"))
+		}
+		params.SetLocale, params.Locale = true, locale
+	}
+
+	var u gendb.User
+	err = withTx(ctx, h.Pool, func(q *gendb.Queries) error {
+		before, err := q.LockUser(ctx, id)
+		if err != nil {
+			return dbError(err, "user")
+		}
+		u, err = q.UpdateUser(ctx, params)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return versionConflict(ctx, "user", params.ExpectedVersion, func(ctx context.Context) (int64, error) {
+				return q.GetUserVersion(ctx, id)
+			})
+		}
+		if err != nil {
+			return dbError(err, "user")
+		}
+		if err := audited(ctx, q, audit.EntityUser, id, userToProto(before), userToProto(u)); err != nil {
+			return err
+		}
+		return emit(ctx, q, gatewayv1.EventType_EVENT_TYPE_USER_UPDATED, pgtype.UUID{}, userToProto(u))
+	})
+	if err != nil {
+		return nil, err
+	}
+	h.Cache.Changed(ctx, cache.KindUser, id, u.Version)
+	return &bffv1.UpdateUserResponse{User: userToProto(u)}, nil
+}
+
+func (h *UsersHandler) GetMyPreferences(
+	ctx context.Context,
+	_ *bffv1.GetMyPreferencesRequest,
+) (*bffv1.GetMyPreferencesResponse, error) {
+	id, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	u, err := h.Cache.User(ctx, gendb.New(h.Pool), id)
+	if err != nil {
+		return nil, dbError(err, "user")
+	}
+	prefs, err := settingsProto(u.Preferences, "user preferences")
+	if err != nil {
+		return nil, err
+	}
+	return &bffv1.GetMyPreferencesResponse{Preferences: prefs, Etag: formatETag(u.Version)}, nil
+}
+
+func (h *UsersHandler) UpdateMyPreferences(
+	ctx context.Context,
+	req *bffv1.UpdateMyPreferencesRequest,
+) (*bffv1.UpdateMyPreferencesResponse, error) {
+	id, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	expected, err := parseETag(req.GetExpectedEtag())
+	if err != nil {
+		return nil, err
+	}
+	patch := req.GetPatch().AsMap()
+	if err := userPreferencesSchema.validatePatch(patch); err != nil {
+		return nil, err
+	}
+
+	var row gendb.SetUserPreferencesRow
+	err = withTx(ctx, h.Pool, func(q *gendb.Queries) error {
+		cur, err := q.LockUserPreferences(ctx, id)
+		if err != nil {
+			return dbError(err, "user")
+		}
+		if err := checkVersion("user", expected, cur.Version); err != nil {
+			return err
+		}
+		merged, err := applySettingsPatch(cur.Preferences, patch, "user preferences")
+		if err != nil {
+			return err
+		}
+		row, err = q.SetUserPreferences(ctx, gendb.SetUserPreferencesParams{ID: id, Preferences: merged})
+		if err != nil {
+			return dbError(err, "user")
+		}
+		if err := audited(ctx, q, audit.EntityUser, id,
+			map[string]json.RawMessage{"preferences": cur.Preferences},
+			map[string]json.RawMessage{"preferences": merged},
+		); err != nil {
+			return err
+		}
+		// Preferences stay private; the event only moves the user's etag.
+		u, err := q.GetUser(ctx, id)
+		if err != nil {
+			return dbError(err, "user")
+		}
+		return emit(ctx, q, gatewayv1.EventType_EVENT_TYPE_USER_UPDATED, pgtype.UUID{}, userToProto(u))
+	})
+	if err != nil {
+		return nil, err
+	}
+	h.Cache.Changed(ctx, cache.KindUser, id, row.Version)
+	prefs, err := settingsProto(row.Preferences, "user preferences")
+	if err != nil {
+		return nil, err
+	}
+	return &bffv1.UpdateMyPreferencesResponse{Preferences: prefs, Etag: formatETag(row.Version)}, nil
+}
+
+func userToProto(u gendb.User) *bffv1.User {
+	return &bffv1.User{
+		Id:        uuidString(u.ID),
+		Name:      u.Name,
+		Email:     u.Email,
+		Role:      userRoleToProto(u.Role),
+		Locale:    u.Locale,
+		CreatedAt: timestampProto(u.CreatedAt),
+		UpdatedAt: timestampProto(u.UpdatedAt),
+		Etag:      formatETag(u.Version),
+		Status:    userStatusToProto(u.Status),
+	}
+}
+
+func userStatusToProto(s gendb.UserStatus) bffv1.UserStatus {
+	switch s {
+	case gendb.UserStatusActive:
+		return bffv1.UserStatus_USER_STATUS_ACTIVE
+	case gendb.UserStatusDeactivated:
+		return bffv1.UserStatus_USER_STATUS_DEACTIVATED
+	default:
+		return bffv1.UserStatus_USER_STATUS_UNSPECIFIED
+	}
+}
+
+func userRoleToProto(r gendb.UserRole) bffv1.UserRole {
+	switch r {
+	case gendb.UserRoleAdmin:
+		return bffv1.UserRole_USER_ROLE_ADMIN
+	case gendb.UserRoleMember:
+		return bffv1.UserRole_USER_ROLE_MEMBER
+	case gendb.UserRoleViewer:
+		return bffv1.UserRole_USER_ROLE_VIEWER
+	default:
+		return bffv1.UserRole_USER_ROLE_UNSPECIFIED
+	}
+}
+
+func userRoleFromProto(r bffv1.UserRole) (gendb.UserRole, error) {
+	switch r {
+	case bffv1.UserRole_USER_ROLE_ADMIN:
+		return gendb.UserRoleAdmin, nil
+	case bffv1.UserRole_USER_ROLE_MEMBER:
+		return gendb.UserRoleMember, nil
+	case bffv1.UserRole_USER_ROLE_VIEWER:
+		return gendb.UserRoleViewer, nil
+	default:
+		return "", connect.NewError(connect.CodeInvalidArgument, errors.New("invalid user role: "+r.String()))
+	}
 }
@@ -0,0 +1,227 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/ApeironFoundation/axle/bff/internal/audit"
+	"github.com/ApeironFoundation/axle/bff/internal/cache"
+	bffv1 "github.com/ApeironFoundation/axle/contracts/go/bff/v1"
+	gatewayv1 "github.com/ApeironFoundation/axle/contracts/go/gateway/v1"
+	gendb "github.com/ApeironFoundation/axle/db/generated"
+)
+
+// errLastActiveAdmin is returned when a change would leave no active global admin.
+var errLastActiveAdmin = errors.New("at least one active admin must remain")
+
+func (h *UsersHandler) CreateUser(
+	ctx context.Context,
+	req *bffv1.CreateUserRequest,
+) (*bffv1.CreateUserResponse, error) {
+	role := gendb.UserRoleMember
+	if req.GetRole() != bffv1.UserRole_USER_ROLE_UNSPECIFIED {
+		var err error
+		if role, err = userRoleFromProto(req.GetRole()); err != nil {
+			return nil, err
+		}
+	}
+	locale := strings.TrimSpace(req.GetLocale())
+	if locale == "" {
+		locale = "en"
+	}
+
+	var u gendb.User
+	err := withTx(ctx, h.Pool, func(q *gendb.Queries) error {
+		var err error
+		u, err = q.CreateUser(ctx, gendb.CreateUserParams{
+			Name:        strings.TrimSpace(req.GetName()),
+			Email:       strings.ToLower(strings.TrimSpace(req.GetEmail())),
+			Role:        role,
+			Locale:      locale,
+			Preferences: []byte("{}"),
+		})
+		if isUniqueViolation(err) {
+			return connect.NewError(connect.CodeAlreadyExists, errors.New("a user with this email already exists"))
+		}
+		if err != nil {
+			return dbError(err, "user")
+		}
+		if err := audited(ctx, q, audit.EntityUser, u.ID, nil, userToProto(u)); err != nil {
+			return err
+		}
+		return emit(ctx, q, gatewayv1.EventType_EVENT_TYPE_USER_CREATED, pgtype.UUID{}, userToProto(u))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &bffv1.CreateUserResponse{User: userToProto(u)}, nil
+}
+
+func (h *UsersHandler) UpdateUserRole(
+	ctx context.Context,
+	req *bffv1.UpdateUserRoleRequest,
+) (*bffv1.UpdateUserRoleResponse, error) {
+	id, err := parseUUID("id", req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	role, err := userRoleFromProto(req.GetRole())
+	if err != nil {
+		return nil, err
+	}
+	u, err := h.changeUser(ctx, id, role != gendb.UserRoleAdmin, func(q *gendb.Queries) (gendb.User, error) {
+		return q.SetUserRole(ctx, gendb.SetUserRoleParams{ID: id, Role: role})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &bffv1.UpdateUserRoleResponse{User: userToProto(u)}, nil
+}
+
+func (h *UsersHandler) DeactivateUser(
+	ctx context.Context,
+	req *bffv1.DeactivateUserRequest,
+) (*bffv1.DeactivateUserResponse, error) {
+	u, err := h.setStatus(ctx, req.GetId(), gendb.UserStatusDeactivated)
+	if err != nil {
+		return nil, err
+	}
+	return &bffv1.DeactivateUserResponse{User: userToProto(u)}, nil
+}
+
+func (h *UsersHandler) ReactivateUser(
+	ctx context.Context,
+	req *bffv1.ReactivateUserRequest,
+) (*bffv1.ReactivateUserResponse, error) {
+	u, err := h.setStatus(ctx, req.GetId(), gendb.UserStatusActive)
+	if err != nil {
+		return nil, err
+	}
+	return &bffv1.ReactivateUserResponse{User: userToProto(u)}, nil
+}
+
+func (h *UsersHandler) DeleteUser(
+	ctx context.Context,
+	req *bffv1.DeleteUserRequest,
+) (*bffv1.DeleteUserResponse, error) {
+	id, err := parseUUID("id", req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	var revoked []gendb.AccessToken
+	err = withTx(ctx, h.Pool, func(q *gendb.Queries) error {
+		if err := ensureOtherActiveAdmin(ctx, q, id); err != nil {
+			return err
+		}
+		before, err := q.LockUser(ctx, id)
+		if err != nil {
+			return dbError(err, "user")
+		}
+		// Memberships go with the user, which would orphan these projects.
+		sole, err := q.CountSoleAdminProjects(ctx, id)
+		if err != nil {
+			return dbError(err, "project members")
+		}
+		if sole > 0 {
+			return connect.NewError(connect.CodeFailedPrecondition,
+				fmt.Errorf("user is the only admin of %d project(s); promote another admin first", sole))
+		}
+		// The tokens go with the user; revoking them first yields the hashes
+		// replicas may still have cached.
+		if revoked, err = revokeUserTokens(ctx, q, id); err != nil {
+			return err
+		}
+		if _, err := q.DeleteUser(ctx, id); err != nil {
+			return dbError(err, "user")
+		}
+		if err := audited(ctx, q, audit.EntityUser, id, userToProto(before), nil); err != nil {
+			return err
+		}
+		return emit(ctx, q, gatewayv1.EventType_EVENT_TYPE_USER_DELETED, pgtype.UUID{}, &bffv1.User{Id: req.GetId()})
+	})
+	if err != nil {
+		return nil, err
+	}
+	h.Cache.Changed(ctx, cache.KindUser, id, cache.Gone)
+	publishRevocations(ctx, h.Revocations, revoked...)
+	return &bffv1.DeleteUserResponse{}, nil
+}
+
+func (h *UsersHandler) setStatus(ctx context.Context, rawID string, status gendb.UserStatus) (gendb.User, error) {
+	id, err := parseUUID("id", rawID)
+	if err != nil {
+		return gendb.User{}, err
+	}
+	var revoked []gendb.AccessToken
+	u, err := h.changeUser(ctx, id, status != gendb.UserStatusActive, func(q *gendb.Queries) (gendb.User, error) {
+		u, err := q.SetUserStatus(ctx, gendb.SetUserStatusParams{ID: id, Status: status})
+		if err != nil || status == gendb.UserStatusActive {
+			return u, err
+		}
+		// Reactivation does not bring the tokens back; the user makes new ones.
+		revoked, err = revokeUserTokens(ctx, q, id)
+		return u, err
+	})
+	if err != nil {
+		return gendb.User{}, err
+	}
+	publishRevocations(ctx, h.Revocations, revoked...)
+	return u, nil
+}
+
+// changeUser applies an admin change to user id and records it. demotes says
+// whether the user is no longer an active admin afterwards, in which case the
+// last-admin rule is checked first.
+func (h *UsersHandler) changeUser(
+	ctx context.Context,
+	id pgtype.UUID,
+	demotes bool,
+	change func(q *gendb.Queries) (gendb.User, error),
+) (gendb.User, error) {
+	var u gendb.User
+	err := withTx(ctx, h.Pool, func(q *gendb.Queries) error {
+		// Admins are locked before the user, in the same order every time, so
+		// concurrent changes queue up instead of deadlocking.
+		if demotes {
+			if err := ensureOtherActiveAdmin(ctx, q, id); err != nil {
+				return err
+			}
+		}
+		before, err := q.LockUser(ctx, id)
+		if err != nil {
+			return dbError(err, "user")
+		}
+		if u, err = change(q); err != nil {
+			return dbError(err, "user")
+		}
+		if err := audited(ctx, q, audit.EntityUser, id, userToProto(before), userToProto(u)); err != nil {
+			return err
+		}
+		return emit(ctx, q, gatewayv1.EventType_EVENT_TYPE_USER_UPDATED, pgtype.UUID{}, userToProto(u))
+	})
+	if err != nil {
+		return gendb.User{}, err
+	}
+	h.Cache.Changed(ctx, cache.KindUser, id, u.Version)
+	return u, nil
+}
+
+// ensureOtherActiveAdmin fails with CodeFailedPrecondition when userID is the
+// only active global admin. The admin rows stay locked until the surrounding
+// transaction ends.
+func ensureOtherActiveAdmin(ctx context.Context, q *gendb.Queries, userID pgtype.UUID) error {
+	admins, err := q.LockActiveAdmins(ctx)
+	if err != nil {
+		return dbError(err, "users")
+	}
+	if len(admins) == 1 && slices.Contains(admins, userID) {
+		return connect.NewError(connect.CodeFailedPrecondition, errLastActiveAdmin)
+	}
+	return nil
+}
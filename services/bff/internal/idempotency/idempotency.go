@@ -0,0 +1,190 @@
+// Package idempotency lets clients retry mutating RPCs safely: the first
+// response for an Idempotency-Key is kept in Redis and replayed to retries.
+package idempotency
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/redis/go-redis/v9"
+	"google.golang.org/protobuf/proto"
+
+	bffv1 "github.com/ApeironFoundation/axle/contracts/go/bff/v1"
+	"github.com/ApeironFoundation/axle/contracts/go/bff/v1/gen_bff_v1connect"
+)
+
+// lockTTL bounds how long a key stays claimed by a call that never finishes,
+// e.g. because the replica died mid-request.
+const lockTTL = time.Minute
+
+// Responder wraps a stored response message in the typed connect.Response the
+// procedure's handler returns.
+type Responder struct {
+	New  func() proto.Message
+	Wrap func(proto.Message) connect.AnyResponse
+}
+
+func responder[T any, PT interface {
+	*T
+	proto.Message
+}]() Responder {
+	return Responder{
+		New:  func() proto.Message { return PT(new(T)) },
+		Wrap: func(m proto.Message) connect.AnyResponse { return connect.NewResponse(m.(PT)) },
+	}
+}
+
+// DefaultReplayable lists the mutating procedures that honour Idempotency-Key.
+// CreateAccessToken is left out on purpose: replaying it would mean keeping the
+// token secret in Redis.
+var DefaultReplayable = map[string]Responder{
+	gen_bff_v1connect.ProjectServiceCreateProjectProcedure:         responder[bffv1.CreateProjectResponse](),
+	gen_bff_v1connect.ProjectServiceUpdateProjectProcedure:         responder[bffv1.UpdateProjectResponse](),
+	gen_bff_v1connect.ProjectServiceDeleteProjectProcedure:         responder[bffv1.DeleteProjectResponse](),
+	gen_bff_v1connect.ProjectServiceRestoreProjectProcedure:        responder[bffv1.RestoreProjectResponse](),
+	gen_bff_v1connect.ProjectServiceUpdateProjectSettingsProcedure: responder[bffv1.UpdateProjectSettingsResponse](),
+
+	gen_bff_v1connect.ProjectMemberServiceAddProjectMemberProcedure:        responder[bffv1.AddProjectMemberResponse](),
+	gen_bff_v1connect.ProjectMemberServiceUpdateProjectMemberRoleProcedure: responder[bffv1.UpdateProjectMemberRoleResponse](),
+	gen_bff_v1connect.ProjectMemberServiceRemoveProjectMemberProcedure:     responder[bffv1.RemoveProjectMemberResponse](),
+
+	gen_bff_v1connect.UserServiceUpdateUserProcedure:          responder[bffv1.UpdateUserResponse](),
+	gen_bff_v1connect.UserServiceUpdateMyPreferencesProcedure: responder[bffv1.UpdateMyPreferencesResponse](),
+	gen_bff_v1connect.UserServiceCreateUserProcedure:          responder[bffv1.CreateUserResponse](),
+	gen_bff_v1connect.UserServiceUpdateUserRoleProcedure:      responder[bffv1.UpdateUserRoleResponse](),
+	gen_bff_v1connect.UserServiceDeactivateUserProcedure:      responder[bffv1.DeactivateUserResponse](),
+	gen_bff_v1connect.UserServiceReactivateUserProcedure:      responder[bffv1.ReactivateUserResponse](),
+	gen_bff_v1connect.UserServiceDeleteUserProcedure:          responder[bffv1.DeleteUserResponse](),
+
+	gen_bff_v1connect.InvitationServiceInviteToProjectProcedure:   responder[bffv1.InviteToProjectResponse](),
+	gen_bff_v1connect.InvitationServiceRevokeInvitationProcedure:  responder[bffv1.RevokeInvitationResponse](),
+	gen_bff_v1connect.InvitationServiceAcceptInvitationProcedure:  responder[bffv1.AcceptInvitationResponse](),
+	gen_bff_v1connect.InvitationServiceDeclineInvitationProcedure: responder[bffv1.DeclineInvitationResponse](),
+
+	gen_bff_v1connect.AccessTokenServiceRevokeAccessTokenProcedure: responder[bffv1.RevokeAccessTokenResponse](),
+}
+
+// Errors returned by Store.Begin.
+var (
+	ErrInProgress = errors.New("idempotency key is in use by a request that has not finished")
+	ErrMismatch   = errors.New("idempotency key was used with a different request")
+)
+
+// record is the Redis value of a key. While a call runs, Owner identifies it;
+// Response is empty until the first call succeeds.
+type record struct {
+	Hash     string `json:"hash"`
+	Owner    string `json:"owner,omitempty"`
+	Done     bool   `json:"done"`
+	Response []byte `json:"response,omitempty"`
+}
+
+// Store keeps idempotency records in Redis for TTL.
+type Store struct {
+	Redis *redis.Client
+	TTL   time.Duration
+}
+
+// Key scopes a client-chosen key to its caller and procedure, so keys cannot
+// collide across users or RPCs.
+func Key(subject, procedure, clientKey string) string {
+	sum := sha256.Sum256([]byte(clientKey))
+	return "axle:idem:" + subject + ":" + procedure + ":" + hex.EncodeToString(sum[:])
+}
+
+// Hash fingerprints a request message.
+func Hash(req proto.Message) (string, error) {
+	b, err := proto.MarshalOptions{Deterministic: true}.Marshal(req)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// Begin claims key for a request with the given hash. When an earlier call
+// with the same request already succeeded, done is true and res holds its
+// marshalled response. Otherwise the caller now owns the key under owner and
+// must pass it to Finish or Abandon. A key held by another request fails with
+// ErrInProgress or ErrMismatch.
+func (s *Store) Begin(ctx context.Context, key, hash string) (owner string, res []byte, done bool, err error) {
+	owner = rand.Text()
+	claim, err := json.Marshal(record{Hash: hash, Owner: owner})
+	if err != nil {
+		return "", nil, false, err
+	}
+	ok, err := s.Redis.SetNX(ctx, key, claim, lockTTL).Result()
+	if err != nil {
+		return "", nil, false, fmt.Errorf("claim idempotency key: %w", err)
+	}
+	if ok {
+		return owner, nil, false, nil
+	}
+
+	raw, err := s.Redis.Get(ctx, key).Bytes()
+	if errors.Is(err, redis.Nil) {
+		// The claim expired in between; let the client retry.
+		return "", nil, false, ErrInProgress
+	}
+	if err != nil {
+		return "", nil, false, fmt.Errorf("read idempotency key: %w", err)
+	}
+	var rec record
+	if err := json.Unmarshal(raw, &rec); err != nil {
+		return "", nil, false, fmt.Errorf("decode idempotency record: %w", err)
+	}
+	switch {
+	case rec.Hash != hash:
+		return "", nil, false, ErrMismatch
+	case !rec.Done:
+		return "", nil, false, ErrInProgress
+	}
+	return "", rec.Response, true, nil
+}
+
+// Finish stores the successful response for key, unless owner's claim has
+// expired and another call has claimed the key since.
+func (s *Store) Finish(ctx context.Context, key, owner, hash string, res proto.Message) error {
+	b, err := proto.Marshal(res)
+	if err != nil {
+		return err
+	}
+	rec, err := json.Marshal(record{Hash: hash, Done: true, Response: b})
+	if err != nil {
+		return err
+	}
+	return finishScript.Run(ctx, s.Redis, []string{key}, owner, rec, s.TTL.Milliseconds()).Err()
+}
+
+// Abandon releases key after a failed call, so a retry runs it again. A key
+// claimed by another call since owner's claim expired is left alone.
+func (s *Store) Abandon(ctx context.Context, key, owner string) error {
+	return abandonScript.Run(ctx, s.Redis, []string{key}, owner).Err()
+}
+
+// finishScript sets KEYS[1] to ARGV[2] for ARGV[3] milliseconds if it is
+// unclaimed or claimed by owner ARGV[1].
+var finishScript = redis.NewScript(`
+local v = redis.call('GET', KEYS[1])
+if v and cjson.decode(v).owner ~= ARGV[1] then
+  return 0
+end
+redis.call('SET', KEYS[1], ARGV[2], 'PX', ARGV[3])
+return 1
+`)
+
+// abandonScript deletes KEYS[1] if it is claimed by owner ARGV[1].
+var abandonScript = redis.NewScript(`
+local v = redis.call('GET', KEYS[1])
+if v and cjson.decode(v).owner == ARGV[1] then
+  return redis.call('DEL', KEYS[1])
+end
+return 0
+`)
@@ -0,0 +1,106 @@
+package idempotency
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"google.golang.org/protobuf/proto"
+
+	bffv1 "github.com/ApeironFoundation/axle/contracts/go/bff/v1"
+)
+
+func newTestStore(t *testing.T) (*Store, *miniredis.Miniredis) {
+	t.Helper()
+	mr := miniredis.RunT(t)
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { _ = rdb.Close() })
+	return &Store{Redis: rdb, TTL: lockTTL * 10}, mr
+}
+
+func TestBeginConcurrentClaims(t *testing.T) {
+	s, _ := newTestStore(t)
+	const callers = 16
+
+	var (
+		wg     sync.WaitGroup
+		mu     sync.Mutex
+		owners []string
+		errs   []error
+	)
+	for range callers {
+		wg.Go(func() {
+			owner, _, _, err := s.Begin(t.Context(), "k", "h")
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				errs = append(errs, err)
+				return
+			}
+			owners = append(owners, owner)
+		})
+	}
+	wg.Wait()
+
+	if len(owners) != 1 {
+		t.Fatalf("%d callers own the key, want exactly 1", len(owners))
+	}
+	for _, err := range errs {
+		if !errors.Is(err, ErrInProgress) {
+			t.Errorf("losing caller: err = %v, want ErrInProgress", err)
+		}
+	}
+}
+
+// An owner whose claim expired mid-call races a retry that claimed the key
+// since: the stale owner can neither finish nor release the new claim.
+func TestStaleOwnerCannotTouchNewClaim(t *testing.T) {
+	s, mr := newTestStore(t)
+	ctx := t.Context()
+
+	stale, _, _, err := s.Begin(ctx, "k", "h")
+	if err != nil {
+		t.Fatalf("first Begin: %v", err)
+	}
+	mr.FastForward(lockTTL + 1)
+	current, _, _, err := s.Begin(ctx, "k", "h")
+	if err != nil {
+		t.Fatalf("Begin after the claim expired: %v", err)
+	}
+	if current == stale {
+		t.Fatal("both calls got the same owner")
+	}
+
+	staleRes := &bffv1.DeleteProjectResponse{}
+	if err := s.Finish(ctx, "k", stale, "h", staleRes); err != nil {
+		t.Fatalf("stale Finish: %v", err)
+	}
+	if err := s.Abandon(ctx, "k", stale); err != nil {
+		t.Fatalf("stale Abandon: %v", err)
+	}
+	if _, _, _, err := s.Begin(ctx, "k", "h"); !errors.Is(err, ErrInProgress) {
+		t.Fatalf("after the stale owner finished: err = %v, want the new claim still in progress", err)
+	}
+
+	want := &bffv1.CreateProjectResponse{Project: &bffv1.Project{Id: "p1", Name: "current"}}
+	if err := s.Finish(ctx, "k", current, "h", want); err != nil {
+		t.Fatalf("Finish: %v", err)
+	}
+	_, raw, done, err := s.Begin(ctx, "k", "h")
+	if err != nil || !done {
+		t.Fatalf("replay: done = %v, err = %v", done, err)
+	}
+	got := &bffv1.CreateProjectResponse{}
+	if err := proto.Unmarshal(raw, got); err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(got, want) {
+		t.Errorf("replayed %v, want the current owner's %v", got, want)
+	}
+
+	if _, _, _, err := s.Begin(ctx, "k", "other"); !errors.Is(err, ErrMismatch) {
+		t.Errorf("different request: err = %v, want ErrMismatch", err)
+	}
+}
@@ -0,0 +1,30 @@
+package interceptor
+
+import (
+	"context"
+	"net/http"
+
+	"connectrpc.com/connect"
+	"github.com/rs/zerolog/log"
+
+	"github.com/ApeironFoundation/axle/bff/internal/auth"
+	shared "github.com/ApeironFoundation/axle/shared/interceptor"
+)
+
+// Auth authenticates every call from its Authorization header and stores the
+// caller in the context (see auth.PrincipalFromContext). Handler logs are
+// tagged with the caller's user ID.
+func Auth(authn *auth.Authenticator) connect.Interceptor {
+	return shared.Auth(func(ctx context.Context, header http.Header) (context.Context, error) {
+		p, err := authn.Authenticate(ctx, header.Get("Authorization"))
+		if err != nil {
+			return ctx, err
+		}
+		logCtx := log.Ctx(ctx).With().Str("user_id", p.UserID.String())
+		if p.IsToken() {
+			logCtx = logCtx.Str("token_id", p.TokenID.String())
+		}
+		ctx = logCtx.Logger().WithContext(ctx)
+		return auth.WithPrincipal(ctx, p), nil
+	})
+}
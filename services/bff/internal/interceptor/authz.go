@@ -0,0 +1,68 @@
+package interceptor
+
+import (
+	"context"
+	"errors"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/ApeironFoundation/axle/bff/internal/authz"
+)
+
+// Authorize enforces the access policy of authz on every call. It must run
+// after Auth, which puts the caller in the context.
+func Authorize(az *authz.Authorizer) connect.Interceptor {
+	return authorizeInterceptor{az: az}
+}
+
+type authorizeInterceptor struct {
+	az *authz.Authorizer
+}
+
+func (a authorizeInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if err := a.authorize(ctx, req.Spec().Procedure, req.Any()); err != nil {
+			return nil, err
+		}
+		return next(ctx, req)
+	}
+}
+
+func (a authorizeInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+// Streams are authorized on their first message, which carries the project
+// or user the rule refers to.
+func (a authorizeInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		return next(ctx, &authorizingConn{StreamingHandlerConn: conn, ctx: ctx, az: a})
+	}
+}
+
+type authorizingConn struct {
+	connect.StreamingHandlerConn
+	ctx  context.Context
+	az   authorizeInterceptor
+	done bool
+}
+
+func (c *authorizingConn) Receive(msg any) error {
+	if err := c.StreamingHandlerConn.Receive(msg); err != nil {
+		return err
+	}
+	if c.done {
+		return nil
+	}
+	c.done = true
+	return c.az.authorize(c.ctx, c.Spec().Procedure, msg)
+}
+
+func (a authorizeInterceptor) authorize(ctx context.Context, procedure string, msg any) error {
+	m, ok := msg.(proto.Message)
+	if !ok {
+		return connect.NewError(connect.CodeInternal, errors.New("internal error"))
+	}
+	return a.az.Authorize(ctx, procedure, m)
+}
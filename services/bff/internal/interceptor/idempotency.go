@@ -0,0 +1,103 @@
+package interceptor
+
+import (
+	"context"
+	"errors"
+
+	"connectrpc.com/connect"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/ApeironFoundation/axle/bff/internal/auth"
+	"github.com/ApeironFoundation/axle/bff/internal/idempotency"
+)
+
+const (
+	idempotencyKeyHeader     = "Idempotency-Key"
+	idempotentReplayedHeader = "Idempotent-Replayed"
+	maxIdempotencyKeyLen     = 255
+)
+
+// Idempotency makes unary calls to the replayable procedures safe to retry.
+// A call with an Idempotency-Key header runs once; retries with the same key
+// and request get the first successful response back, marked with an
+// Idempotent-Replayed header. Failed calls are not stored, so retrying them
+// runs them again. It must run after Auth and Authorize, so a replay is only
+// served to a caller who may still make the call.
+func Idempotency(store *idempotency.Store, replayable map[string]idempotency.Responder) connect.Interceptor {
+	return idempotencyInterceptor{store: store, replayable: replayable}
+}
+
+type idempotencyInterceptor struct {
+	store      *idempotency.Store
+	replayable map[string]idempotency.Responder
+}
+
+func (i idempotencyInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		clientKey := req.Header().Get(idempotencyKeyHeader)
+		r, ok := i.replayable[req.Spec().Procedure]
+		if clientKey == "" || !ok {
+			return next(ctx, req)
+		}
+		if len(clientKey) > maxIdempotencyKeyLen {
+			return nil, connect.NewError(connect.CodeInvalidArgument,
+				errors.New("idempotency key must be at most 255 characters"))
+		}
+		p, ok := auth.PrincipalFromContext(ctx)
+		msg, isProto := req.Any().(proto.Message)
+		if !ok || !isProto {
+			return next(ctx, req)
+		}
+
+		hash, err := idempotency.Hash(msg)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, errors.New("internal error"))
+		}
+		key := idempotency.Key(p.UserID.String(), req.Spec().Procedure, clientKey)
+		owner, stored, done, err := i.store.Begin(ctx, key, hash)
+		switch {
+		case errors.Is(err, idempotency.ErrMismatch):
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		case errors.Is(err, idempotency.ErrInProgress):
+			return nil, connect.NewError(connect.CodeAborted, err)
+		case err != nil:
+			log.Ctx(ctx).Error().Err(err).Msg("idempotency lookup failed")
+			return nil, connect.NewError(connect.CodeUnavailable, errors.New("idempotency store unavailable; retry later"))
+		case done:
+			m := r.New()
+			if err := proto.Unmarshal(stored, m); err != nil {
+				log.Ctx(ctx).Error().Err(err).Msg("decoding stored response failed")
+				return nil, connect.NewError(connect.CodeInternal, errors.New("internal error"))
+			}
+			res := r.Wrap(m)
+			res.Header().Set(idempotentReplayedHeader, "true")
+			return res, nil
+		}
+
+		// The outcome is recorded even if the client has gone away, since
+		// that is exactly when it will retry.
+		bg := context.WithoutCancel(ctx)
+		res, err := next(ctx, req)
+		if err != nil {
+			if aerr := i.store.Abandon(bg, key, owner); aerr != nil {
+				log.Ctx(ctx).Warn().Err(aerr).Msg("releasing idempotency key failed")
+			}
+			return nil, err
+		}
+		if m, ok := res.Any().(proto.Message); ok {
+			if ferr := i.store.Finish(bg, key, owner, hash, m); ferr != nil {
+				log.Ctx(ctx).Warn().Err(ferr).Msg("storing idempotent response failed")
+			}
+		}
+		return res, nil
+	}
+}
+
+func (i idempotencyInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (i idempotencyInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return next
+}
@@ -0,0 +1,48 @@
+// Package interceptor holds the ConnectRPC interceptor chain that every BFF
+// service handler is mounted with.
+package interceptor
+
+import (
+	"connectrpc.com/connect"
+
+	"github.com/ApeironFoundation/axle/bff/internal/auth"
+	"github.com/ApeironFoundation/axle/bff/internal/authz"
+	"github.com/ApeironFoundation/axle/bff/internal/idempotency"
+	"github.com/ApeironFoundation/axle/bff/internal/ratelimit"
+	shared "github.com/ApeironFoundation/axle/shared/interceptor"
+)
+
+// Chain lists the optional stages of the interceptor chain; nil stages are
+// skipped.
+type Chain struct {
+	Authn     *auth.Authenticator
+	Authz     *authz.Authorizer
+	RateLimit *ratelimit.Limiter
+	// Idempotency stores responses of the procedures in Replayable.
+	Idempotency *idempotency.Store
+	Replayable  map[string]idempotency.Responder
+}
+
+// HandlerOptions returns the options to pass to each New*ServiceHandler:
+// logging, panic recovery, authentication, rate limiting, request validation,
+// authorization and idempotent replay. Interceptors run outermost first, so
+// rejected and panicking calls are still logged, throttled callers cost no
+// database work, only authenticated callers learn why a request is invalid,
+// access rules see valid IDs, and only permitted calls are replayed.
+func HandlerOptions(c Chain) connect.HandlerOption {
+	chain := []connect.Interceptor{shared.Logging(), shared.Recover()}
+	if c.Authn != nil {
+		chain = append(chain, Auth(c.Authn))
+	}
+	if c.RateLimit != nil {
+		chain = append(chain, RateLimit(c.RateLimit))
+	}
+	chain = append(chain, shared.Validate())
+	if c.Authz != nil {
+		chain = append(chain, Authorize(c.Authz))
+	}
+	if c.Idempotency != nil {
+		chain = append(chain, Idempotency(c.Idempotency, c.Replayable))
+	}
+	return connect.WithInterceptors(chain...)
+}
@@ -0,0 +1,82 @@
+package interceptor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+
+	"connectrpc.com/connect"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/ApeironFoundation/axle/bff/internal/auth"
+	"github.com/ApeironFoundation/axle/bff/internal/ratelimit"
+)
+
+// RateLimit rejects calls over the caller's quota with CodeResourceExhausted,
+// a Retry-After header (in seconds) and an errdetails.RetryInfo detail. It must
+// run after Auth. When Redis is unreachable, calls are let through rather than
+// failing the whole API, except expensive ones such as AI runs, which fail
+// with CodeUnavailable rather than run unmetered.
+func RateLimit(l *ratelimit.Limiter) connect.Interceptor {
+	return rateLimitInterceptor{l: l}
+}
+
+type rateLimitInterceptor struct {
+	l *ratelimit.Limiter
+}
+
+func (r rateLimitInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if err := r.allow(ctx, req.Spec().Procedure); err != nil {
+			return nil, err
+		}
+		return next(ctx, req)
+	}
+}
+
+func (r rateLimitInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (r rateLimitInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		if err := r.allow(ctx, conn.Spec().Procedure); err != nil {
+			return err
+		}
+		return next(ctx, conn)
+	}
+}
+
+func (r rateLimitInterceptor) allow(ctx context.Context, procedure string) error {
+	p, ok := auth.PrincipalFromContext(ctx)
+	if !ok {
+		return nil
+	}
+	res, err := r.l.Allow(ctx, p, procedure)
+	if err != nil {
+		if r.l.Class(procedure) == ratelimit.ClassExpensive {
+			log.Ctx(ctx).Error().Err(err).Msg("rate limiter unavailable, refusing expensive call")
+			return connect.NewError(connect.CodeUnavailable, errors.New("try again later"))
+		}
+		log.Ctx(ctx).Warn().Err(err).Msg("rate limiter unavailable, allowing call")
+		return nil
+	}
+	if res.Allowed {
+		return nil
+	}
+
+	secs := int(math.Ceil(res.RetryAfter.Seconds()))
+	cerr := connect.NewError(connect.CodeResourceExhausted,
+		fmt.Errorf("rate limit exceeded for %s; retry in %ds", procedure, secs))
+	cerr.Meta().Set("Retry-After", strconv.Itoa(secs))
+	if detail, err := connect.NewErrorDetail(&errdetails.RetryInfo{
+		RetryDelay: durationpb.New(res.RetryAfter),
+	}); err == nil {
+		cerr.AddDetail(detail)
+	}
+	return cerr
+}
@@ -0,0 +1,44 @@
+package interceptor
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+
+	"github.com/ApeironFoundation/axle/bff/internal/auth"
+	"github.com/ApeironFoundation/axle/bff/internal/ratelimit"
+	"github.com/ApeironFoundation/axle/contracts/go/ai/v1/gen_ai_v1connect"
+	"github.com/ApeironFoundation/axle/contracts/go/bff/v1/gen_bff_v1connect"
+	gendb "github.com/ApeironFoundation/axle/db/generated"
+)
+
+func TestRateLimitWithoutRedis(t *testing.T) {
+	// A port nothing listens on.
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := ln.Addr().String()
+	_ = ln.Close()
+	rdb := redis.NewClient(&redis.Options{Addr: addr, MaxRetries: -1})
+	t.Cleanup(func() { _ = rdb.Close() })
+
+	limits := ratelimit.RoleLimits{gendb.UserRoleMember: {Rate: 1, Burst: 1}}
+	r := rateLimitInterceptor{l: &ratelimit.Limiter{
+		Redis:   rdb,
+		Limits:  map[ratelimit.Class]ratelimit.RoleLimits{ratelimit.ClassDefault: limits, ratelimit.ClassExpensive: limits},
+		Classes: ratelimit.DefaultClasses,
+	}}
+	ctx := auth.WithPrincipal(context.Background(), auth.Principal{UserID: uuid.New(), Role: gendb.UserRoleMember})
+
+	if err := r.allow(ctx, gen_bff_v1connect.ProjectServiceListProjectsProcedure); err != nil {
+		t.Errorf("default class: %v, want the call let through", err)
+	}
+	if err := r.allow(ctx, gen_ai_v1connect.AITaskServiceRunAITaskProcedure); connect.CodeOf(err) != connect.CodeUnavailable {
+		t.Errorf("expensive class: %v, want Unavailable", err)
+	}
+}
@@ -0,0 +1,61 @@
+package invitation
+
+import (
+	"encoding/base64"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestSignerVerify(t *testing.T) {
+	s := &Signer{Key: []byte("test key")}
+	id := uuid.New()
+	now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	token := s.Sign(id, now.Add(time.Hour))
+
+	enc := base64.RawURLEncoding
+	payload, mac, _ := strings.Cut(token, ".")
+	raw, _ := enc.DecodeString(payload)
+	// Push the expiry a second later, keeping the original MAC.
+	extended := append([]byte(nil), raw...)
+	extended[len(extended)-1]++
+	// Point the token at another invitation.
+	other := append([]byte(nil), raw...)
+	other[0] ^= 0xff
+	flipped, _ := enc.DecodeString(mac)
+	flipped[0] ^= 0x01
+
+	tests := []struct {
+		name  string
+		token string
+		now   time.Time
+		err   error
+	}{
+		{"valid", token, now, nil},
+		{"just before expiry", token, now.Add(time.Hour - time.Second), nil},
+		{"at expiry", token, now.Add(time.Hour), ErrExpired},
+		{"expired", token, now.Add(48 * time.Hour), ErrExpired},
+		{"altered expiry", enc.EncodeToString(extended) + "." + mac, now, ErrInvalidToken},
+		{"altered id", enc.EncodeToString(other) + "." + mac, now, ErrInvalidToken},
+		{"altered mac", payload + "." + enc.EncodeToString(flipped), now, ErrInvalidToken},
+		{"truncated mac", payload + "." + mac[:len(mac)-2], now, ErrInvalidToken},
+		{"other key", (&Signer{Key: []byte("other key")}).Sign(id, now.Add(time.Hour)), now, ErrInvalidToken},
+		{"no mac", payload, now, ErrInvalidToken},
+		{"short payload", enc.EncodeToString(raw[:16]) + "." + mac, now, ErrInvalidToken},
+		{"not base64", "!!." + mac, now, ErrInvalidToken},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := s.Verify(tt.token, tt.now)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("err = %v, want %v", err, tt.err)
+			}
+			if tt.err == nil && got != id {
+				t.Errorf("id = %s, want %s", got, id)
+			}
+		})
+	}
+}
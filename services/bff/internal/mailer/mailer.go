@@ -0,0 +1,35 @@
+// Package mailer sends the BFF's outgoing email. Handlers depend on the Mailer
+// interface; SMTP delivers through a mail server and Log stands in for one in
+// development.
+package mailer
+
+import (
+	"context"
+
+	"github.com/rs/zerolog/log"
+)
+
+// Message is a plain-text email to a single recipient.
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// Mailer delivers messages.
+type Mailer interface {
+	Send(ctx context.Context, msg Message) error
+}
+
+// Log writes messages to the log instead of sending them. It is meant for
+// development, where links in the body can be copied from the log.
+type Log struct{}
+
+func (Log) Send(ctx context.Context, msg Message) error {
+	log.Ctx(ctx).Info().
+		Str("to", msg.To).
+		Str("subject", msg.Subject).
+		Str("body", msg.Body).
+		Msg("DEV-ONLY: email not sent")
+	return nil
+}
@@ -0,0 +1,134 @@
+package mailer
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/tls"
+	"encoding/hex"
+	"fmt"
+	"mime"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strings"
+	"time"
+)
+
+// defaultTimeout bounds a delivery whose context has no deadline.
+const defaultTimeout = 30 * time.Second
+
+// SMTP delivers messages through a mail server. It upgrades to TLS when the
+// server offers STARTTLS and authenticates with PLAIN when Username is set;
+// net/smtp refuses to send the password in the clear to anything but localhost.
+type SMTP struct {
+	Addr     string // host:port
+	From     string // sender address, optionally with a display name
+	Username string
+	Password string
+}
+
+func (s *SMTP) Send(ctx context.Context, msg Message) error {
+	from, err := mail.ParseAddress(s.From)
+	if err != nil {
+		return fmt.Errorf("mailer: sender %q: %w", s.From, err)
+	}
+	to, err := mail.ParseAddress(msg.To)
+	if err != nil {
+		return fmt.Errorf("mailer: recipient %q: %w", msg.To, err)
+	}
+	data, err := compose(from, to, msg)
+	if err != nil {
+		return err
+	}
+
+	host, _, err := net.SplitHostPort(s.Addr)
+	if err != nil {
+		return fmt.Errorf("mailer: address %q: %w", s.Addr, err)
+	}
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, defaultTimeout)
+		defer cancel()
+	}
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "tcp", s.Addr)
+	if err != nil {
+		return fmt.Errorf("mailer: dial %s: %w", s.Addr, err)
+	}
+	deadline, _ := ctx.Deadline()
+	_ = conn.SetDeadline(deadline)
+
+	c, err := smtp.NewClient(conn, host)
+	if err != nil {
+		_ = conn.Close()
+		return fmt.Errorf("mailer: %w", err)
+	}
+	defer c.Close()
+
+	if ok, _ := c.Extension("STARTTLS"); ok {
+		if err := c.StartTLS(&tls.Config{ServerName: host}); err != nil {
+			return fmt.Errorf("mailer: starttls: %w", err)
+		}
+	}
+	if s.Username != "" {
+		if err := c.Auth(smtp.PlainAuth("", s.Username, s.Password, host)); err != nil {
+			return fmt.Errorf("mailer: auth: %w", err)
+		}
+	}
+	if err := c.Mail(from.Address); err != nil {
+		return fmt.Errorf("mailer: MAIL FROM: %w", err)
+	}
+	if err := c.Rcpt(to.Address); err != nil {
+		return fmt.Errorf("mailer: RCPT TO: %w", err)
+	}
+	w, err := c.Data()
+	if err != nil {
+		return fmt.Errorf("mailer: DATA: %w", err)
+	}
+	if _, err := w.Write(data); err != nil {
+		return fmt.Errorf("mailer: DATA: %w", err)
+	}
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("mailer: DATA: %w", err)
+	}
+	return c.Quit()
+}
+
+// compose renders msg as a UTF-8 text/plain message. Header values come out
+// of net/mail and mime encoders, so a subject or name cannot smuggle in
+// headers of its own.
+func compose(from, to *mail.Address, msg Message) ([]byte, error) {
+	var b bytes.Buffer
+	header := func(k, v string) { fmt.Fprintf(&b, "%s: %s\r\n", k, v) }
+	header("From", from.String())
+	header("To", to.String())
+	header("Subject", mime.QEncoding.Encode("utf-8", msg.Subject))
+	header("Date", time.Now().Format(time.RFC1123Z))
+	header("Message-ID", messageID(from.Address))
+	header("MIME-Version", "1.0")
+	header("Content-Type", "text/plain; charset=utf-8")
+	header("Content-Transfer-Encoding", "quoted-printable")
+	b.WriteString("\r\n")
+
+	qp := quotedprintable.NewWriter(&b)
+	if _, err := qp.Write([]byte(msg.Body)); err != nil {
+		return nil, fmt.Errorf("mailer: encode body: %w", err)
+	}
+	if err := qp.Close(); err != nil {
+		return nil, fmt.Errorf("mailer: encode body: %w", err)
+	}
+	return b.Bytes(), nil
+}
+
+// messageID returns a unique Message-ID in the sender's domain.
+func messageID(sender string) string {
+	domain := "localhost"
+	if _, d, ok := strings.Cut(sender, "@"); ok {
+		domain = d
+	}
+	var r [16]byte
+	_, _ = rand.Read(r[:])
+	return "<" + hex.EncodeToString(r[:]) + "@" + domain + ">"
+}
@@ -0,0 +1,159 @@
+package mailer
+
+import (
+	"bufio"
+	"io"
+	"mime"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"net/textproto"
+	"strings"
+	"testing"
+)
+
+// session is what fakeSMTP received in one connection.
+type session struct {
+	from, rcpt string
+	data       []byte
+}
+
+// fakeSMTP serves one SMTP session on a local listener. It offers no
+// extensions, STARTTLS and AUTH included, and answers RCPT TO with rcptReply.
+func fakeSMTP(t *testing.T, rcptReply string) (addr string, got <-chan session) {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	t.Cleanup(func() { _ = ln.Close() })
+
+	ch := make(chan session, 1)
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		tp := textproto.NewConn(conn)
+		var s session
+		defer func() { ch <- s }()
+
+		_ = tp.PrintfLine("220 fake ESMTP")
+		for {
+			line, err := tp.ReadLine()
+			if err != nil {
+				return
+			}
+			verb, arg, _ := strings.Cut(line, " ")
+			switch strings.ToUpper(verb) {
+			case "EHLO", "HELO":
+				_ = tp.PrintfLine("250 fake")
+			case "MAIL":
+				s.from = arg
+				_ = tp.PrintfLine("250 ok")
+			case "RCPT":
+				s.rcpt = arg
+				_ = tp.PrintfLine("%s", rcptReply)
+			case "DATA":
+				_ = tp.PrintfLine("354 go ahead")
+				if s.data, err = tp.ReadDotBytes(); err != nil {
+					return
+				}
+				_ = tp.PrintfLine("250 queued")
+			case "QUIT":
+				_ = tp.PrintfLine("221 bye")
+				return
+			default:
+				_ = tp.PrintfLine("502 not implemented")
+			}
+		}
+	}()
+	return ln.Addr().String(), ch
+}
+
+func TestSMTPSend(t *testing.T) {
+	addr, got := fakeSMTP(t, "250 ok")
+	s := &SMTP{Addr: addr, From: "Äxle <noreply@axle.example>"}
+
+	msg := Message{
+		To:      "bob@example.com",
+		Subject: "Einladung für Ünïcode\r\nBcc: eve@example.com",
+		Body:    "Hallo Bob,\n\nclick here: https://axle.example/invite?token=abc=def\n",
+	}
+	if err := s.Send(t.Context(), msg); err != nil {
+		t.Fatalf("Send: %v", err)
+	}
+	sess := <-got
+
+	if sess.from != "FROM:<noreply@axle.example>" {
+		t.Errorf("MAIL %q, want FROM:<noreply@axle.example>", sess.from)
+	}
+	if sess.rcpt != "TO:<bob@example.com>" {
+		t.Errorf("RCPT %q, want TO:<bob@example.com>", sess.rcpt)
+	}
+
+	m, err := mail.ReadMessage(bufio.NewReader(strings.NewReader(string(sess.data))))
+	if err != nil {
+		t.Fatalf("parse message: %v", err)
+	}
+	if b := m.Header.Get("Bcc"); b != "" {
+		t.Errorf("subject injected a Bcc header: %q", b)
+	}
+	for _, k := range []string{"From", "Subject"} {
+		if raw := m.Header.Get(k); !isASCII(raw) {
+			t.Errorf("%s header is not encoded: %q", k, raw)
+		}
+	}
+	subject, err := new(mime.WordDecoder).DecodeHeader(m.Header.Get("Subject"))
+	if err != nil || subject != msg.Subject {
+		t.Errorf("Subject decodes to %q (%v), want %q", subject, err, msg.Subject)
+	}
+	from, err := m.Header.AddressList("From")
+	if err != nil || len(from) != 1 || from[0].Name != "Äxle" || from[0].Address != "noreply@axle.example" {
+		t.Errorf("From = %v (%v)", from, err)
+	}
+	if to := m.Header.Get("To"); to != "<bob@example.com>" {
+		t.Errorf("To = %q", to)
+	}
+	if id := m.Header.Get("Message-Id"); !strings.HasSuffix(id, "@axle.example>") {
+		t.Errorf("Message-ID = %q, want one in the sender's domain", id)
+	}
+	if ct := m.Header.Get("Content-Transfer-Encoding"); ct != "quoted-printable" {
+		t.Fatalf("Content-Transfer-Encoding = %q", ct)
+	}
+	body, err := io.ReadAll(quotedprintable.NewReader(m.Body))
+	if err != nil {
+		t.Fatalf("decode body: %v", err)
+	}
+	// ReadDotBytes has already turned the CRLF line endings back into LF.
+	if string(body) != msg.Body {
+		t.Errorf("body = %q, want %q", body, msg.Body)
+	}
+}
+
+func TestSMTPSendRecipientRejected(t *testing.T) {
+	addr, _ := fakeSMTP(t, "550 no such user")
+	s := &SMTP{Addr: addr, From: "noreply@axle.example"}
+
+	err := s.Send(t.Context(), Message{To: "nobody@example.com", Subject: "hi", Body: "hi"})
+	if err == nil || !strings.Contains(err.Error(), "RCPT TO") {
+		t.Fatalf("err = %v, want a RCPT TO failure", err)
+	}
+}
+
+func TestSMTPSendBadRecipient(t *testing.T) {
+	s := &SMTP{Addr: "127.0.0.1:1", From: "noreply@axle.example"}
+	if err := s.Send(t.Context(), Message{To: "not an address", Subject: "hi", Body: "hi"}); err == nil {
+		t.Fatal("Send accepted a malformed recipient")
+	}
+}
+
+func isASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= 0x80 {
+			return false
+		}
+	}
+	return true
+}
@@ -1,29 +1,66 @@
 package middleware
 
 import (
+	"context"
 	"net/http"
 	"time"
 
+	"connectrpc.com/connect"
 	"github.com/google/uuid"
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
+
+	"github.com/ApeironFoundation/axle/bff/internal/auth"
+	"github.com/ApeironFoundation/axle/bff/internal/authz"
+)
+
+const (
+	requestIDHeader = "X-Request-Id"
+	// maxRequestIDLen fits a UUID and the other common ID formats, e.g.
+	// ULIDs and W3C trace IDs.
+	maxRequestIDLen = 64
 )
 
-const requestIDHeader = "X-Request-Id"
+type requestIDKey struct{}
+
+// RequestIDFromContext returns the ID that RequestID gave the request, or "".
+func RequestIDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
 
 // RequestID injects a unique request ID into the context and response headers.
+// A client's X-Request-Id is kept if it looks like an ID; anything else is
+// replaced, since the ID ends up in logs and the audit trail.
 func RequestID(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		id := r.Header.Get(requestIDHeader)
-		if id == "" {
+		if !validRequestID(id) {
 			id = uuid.New().String()
 		}
 		w.Header().Set(requestIDHeader, id)
 		ctx := zerolog.Ctx(r.Context()).With().Str("request_id", id).Logger().WithContext(r.Context())
+		ctx = context.WithValue(ctx, requestIDKey{}, id)
 		next.ServeHTTP(w, r.WithContext(ctx))
 	})
 }
 
+// validRequestID reports whether id is a non-empty run of at most
+// maxRequestIDLen ASCII letters, digits and hyphens.
+func validRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLen {
+		return false
+	}
+	for _, c := range []byte(id) {
+		switch {
+		case c >= '0' && c <= '9', c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c == '-':
+		default:
+			return false
+		}
+	}
+	return true
+}
+
 // Logger logs each request with method, path, status and duration.
 func Logger(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -64,3 +101,35 @@ func (sw *statusWriter) WriteHeader(code int) {
 	sw.status = code
 	sw.ResponseWriter.WriteHeader(code)
 }
+
+// Authorize guards a plain HTTP route with the checks the interceptor chain
+// applies to RPCs: the caller is authenticated from the Authorization header
+// and must pass the policy rule registered for route. Failures are written as
+// Connect JSON errors. A nil authn leaves the route open, as the RPCs are
+// when the BFF runs without OIDC.
+func Authorize(authn *auth.Authenticator, az *authz.Authorizer, route string) func(http.Handler) http.Handler {
+	errs := connect.NewErrorWriter()
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if authn == nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+			p, err := authn.Authenticate(r.Context(), r.Header.Get("Authorization"))
+			if err != nil {
+				_ = errs.Write(w, r, err)
+				return
+			}
+			logCtx := zerolog.Ctx(r.Context()).With().Str("user_id", p.UserID.String())
+			if p.IsToken() {
+				logCtx = logCtx.Str("token_id", p.TokenID.String())
+			}
+			ctx := auth.WithPrincipal(logCtx.Logger().WithContext(r.Context()), p)
+			if err := az.Authorize(ctx, route, nil); err != nil {
+				_ = errs.Write(w, r, err)
+				return
+			}
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
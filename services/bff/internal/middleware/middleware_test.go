@@ -0,0 +1,53 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestRequestID(t *testing.T) {
+	tests := []struct {
+		name   string
+		header string
+		keep   bool
+	}{
+		{"uuid", "0b6f3c2e-8d4a-4f7e-9c1b-2a5d6e7f8091", true},
+		{"ulid", "01ARZ3NDEKTSV4RRFFQ69G5FAV", true},
+		{"none", "", false},
+		{"too long", strings.Repeat("a", maxRequestIDLen+1), false},
+		{"log injection", "abc\nlevel=error", false},
+		{"spaces", "not an id", false},
+		{"non-ascii", "ідентифікатор", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var seen string
+			h := RequestID(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+				seen = RequestIDFromContext(r.Context())
+			}))
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			if tt.header != "" {
+				req.Header.Set(requestIDHeader, tt.header)
+			}
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, req)
+
+			if got := rec.Header().Get(requestIDHeader); got != seen {
+				t.Errorf("response ID %q, context ID %q", got, seen)
+			}
+			if tt.keep {
+				if seen != tt.header {
+					t.Errorf("ID = %q, want the client's %q", seen, tt.header)
+				}
+				return
+			}
+			if _, err := uuid.Parse(seen); err != nil || seen == tt.header {
+				t.Errorf("ID = %q, want a generated UUID", seen)
+			}
+		})
+	}
+}
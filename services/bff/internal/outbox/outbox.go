@@ -0,0 +1,69 @@
+// Package outbox publishes domain events without losing any: handlers record
+// each event in the outbox table inside the transaction that makes the change,
+// and a Relay copies committed rows to JetStream. Delivery is at-least-once;
+// consumers deduplicate on Event.id.
+package outbox
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	gatewayv1 "github.com/ApeironFoundation/axle/contracts/go/gateway/v1"
+	gendb "github.com/ApeironFoundation/axle/db/generated"
+)
+
+// subjectPrefix is where the Gateway listens; "<entity>.<action>" follows it.
+const subjectPrefix = "axle.events."
+
+// subjects maps each event type the BFF emits to its subject suffix.
+var subjects = map[gatewayv1.EventType]string{
+	gatewayv1.EventType_EVENT_TYPE_PROJECT_CREATED:        "project.created",
+	gatewayv1.EventType_EVENT_TYPE_PROJECT_UPDATED:        "project.updated",
+	gatewayv1.EventType_EVENT_TYPE_PROJECT_DELETED:        "project.deleted",
+	gatewayv1.EventType_EVENT_TYPE_PROJECT_RESTORED:       "project.restored",
+	gatewayv1.EventType_EVENT_TYPE_PROJECT_MEMBER_ADDED:   "project_member.added",
+	gatewayv1.EventType_EVENT_TYPE_PROJECT_MEMBER_UPDATED: "project_member.updated",
+	gatewayv1.EventType_EVENT_TYPE_PROJECT_MEMBER_REMOVED: "project_member.removed",
+	gatewayv1.EventType_EVENT_TYPE_USER_CREATED:           "user.created",
+	gatewayv1.EventType_EVENT_TYPE_USER_UPDATED:           "user.updated",
+	gatewayv1.EventType_EVENT_TYPE_USER_DELETED:           "user.deleted",
+}
+
+// Enqueue records an event of type typ with payload as its body. projectID
+// scopes the event to a project and is left NULL for events that are not
+// about one. q must run in the transaction making the change, so the event
+// is published if and only if the change commits.
+func Enqueue(ctx context.Context, q *gendb.Queries, typ gatewayv1.EventType, projectID pgtype.UUID, payload proto.Message) error {
+	suffix, ok := subjects[typ]
+	if !ok {
+		return fmt.Errorf("outbox: no subject for %s", typ)
+	}
+	body, err := proto.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("outbox: marshal %s payload: %w", typ, err)
+	}
+	id := uuid.New()
+	event := &gatewayv1.Event{
+		Id:         id.String(),
+		Type:       typ,
+		Payload:    body,
+		OccurredAt: timestamppb.Now(),
+	}
+	if projectID.Valid {
+		event.ProjectId = uuid.UUID(projectID.Bytes).String()
+	}
+	b, err := proto.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("outbox: marshal %s event: %w", typ, err)
+	}
+	return q.InsertOutboxEvent(ctx, gendb.InsertOutboxEventParams{
+		ID:      pgtype.UUID{Bytes: id, Valid: true},
+		Subject: subjectPrefix + suffix,
+		Event:   b,
+	})
+}
@@ -0,0 +1,145 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/rs/zerolog/log"
+
+	gendb "github.com/ApeironFoundation/axle/db/generated"
+)
+
+// StreamName is the JetStream stream that stores everything published under
+// axle.events.
+const StreamName = "AXLE_EVENTS"
+
+const (
+	// streamMaxAge bounds how far back the stream keeps events.
+	streamMaxAge = 24 * time.Hour
+	// dedupWindow is how long JetStream remembers message IDs, so an event
+	// republished after a failed commit is stored once.
+	dedupWindow = 2 * time.Minute
+	// lockKey is the advisory lock ("axleoutb") held by the publishing relay.
+	lockKey int64 = 0x61786c656f757462
+	// defaultBatchSize is how many events a Relay publishes per transaction.
+	defaultBatchSize = 100
+)
+
+// EnsureStream creates the events stream, or updates it to the current
+// configuration.
+func EnsureStream(ctx context.Context, js jetstream.JetStream) error {
+	_, err := js.CreateOrUpdateStream(ctx, jetstream.StreamConfig{
+		Name:       StreamName,
+		Subjects:   []string{subjectPrefix + ">"},
+		Storage:    jetstream.FileStorage,
+		MaxAge:     streamMaxAge,
+		Duplicates: dedupWindow,
+	})
+	if err != nil {
+		return fmt.Errorf("ensure stream %s: %w", StreamName, err)
+	}
+	return nil
+}
+
+// Relay moves events from the outbox table to JetStream. Every BFF replica
+// runs one; an advisory lock lets only one of them publish at a time.
+type Relay struct {
+	Pool *pgxpool.Pool
+	JS   jetstream.JetStream
+	// Interval is how long the relay sleeps once the outbox is drained.
+	Interval time.Duration
+	// BatchSize caps the events published per transaction (default 100).
+	BatchSize int
+}
+
+// Run publishes outbox events until ctx is cancelled.
+func (r *Relay) Run(ctx context.Context) {
+	batch := r.BatchSize
+	if batch <= 0 {
+		batch = defaultBatchSize
+	}
+	t := time.NewTicker(r.Interval)
+	defer t.Stop()
+	for {
+		n, err := r.publishBatch(ctx, batch)
+		if err != nil && ctx.Err() == nil {
+			log.Warn().Err(err).Int("published", n).Msg("outbox relay failed")
+		}
+		// A full batch means more may be waiting.
+		if err == nil && n == batch {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+		}
+	}
+}
+
+// publishBatch publishes up to limit events in seq order and deletes the ones
+// JetStream acknowledged. A crash before the delete commits publishes those
+// events again; the message ID lets JetStream drop the duplicates.
+func (r *Relay) publishBatch(ctx context.Context, limit int) (int, error) {
+	tx, err := r.Pool.Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("begin: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+	q := gendb.New(tx)
+
+	locked, err := q.TryLockOutbox(ctx, lockKey)
+	if err != nil {
+		return 0, fmt.Errorf("lock outbox: %w", err)
+	}
+	if !locked {
+		return 0, nil
+	}
+	rows, err := q.ListOutboxEvents(ctx, int32(limit))
+	if err != nil {
+		return 0, fmt.Errorf("list outbox: %w", err)
+	}
+
+	seqs, pubErr := publishRows(ctx, r.JS, rows)
+	if len(seqs) == 0 {
+		return 0, pubErr
+	}
+	if err := q.DeleteOutboxEvents(ctx, seqs); err != nil {
+		return 0, errors.Join(pubErr, fmt.Errorf("delete published: %w", err))
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return 0, errors.Join(pubErr, fmt.Errorf("commit: %w", err))
+	}
+	return len(seqs), pubErr
+}
+
+// publisher is the part of jetstream.JetStream the relay publishes with.
+type publisher interface {
+	PublishMsg(ctx context.Context, msg *nats.Msg, opts ...jetstream.PublishOpt) (*jetstream.PubAck, error)
+}
+
+// publishRows publishes rows in order, each under its event ID as message ID,
+// and returns the seqs of the ones JetStream acknowledged. It stops at the
+// first failure, so the failed row and everything after it stay in the outbox.
+func publishRows(ctx context.Context, js publisher, rows []gendb.ListOutboxEventsRow) ([]int64, error) {
+	var seqs []int64
+	for _, row := range rows {
+		msg := &nats.Msg{
+			Subject: row.Subject,
+			Data:    row.Event,
+			Header:  nats.Header{jetstream.MsgIDHeader: []string{uuid.UUID(row.ID.Bytes).String()}},
+		}
+		if _, err := js.PublishMsg(ctx, msg); err != nil {
+			// Stop here so later events do not overtake this one.
+			return seqs, fmt.Errorf("publish %s: %w", row.Subject, err)
+		}
+		seqs = append(seqs, row.Seq)
+	}
+	return seqs, nil
+}
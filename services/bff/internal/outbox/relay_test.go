@@ -0,0 +1,105 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+
+	gendb "github.com/ApeironFoundation/axle/db/generated"
+)
+
+// fakeStream stores published messages and, like JetStream within its
+// duplicates window, acknowledges a repeated message ID without storing it.
+type fakeStream struct {
+	ids    map[string]bool
+	stored []string // subjects, in order
+	// fail rejects the next publish to this subject.
+	fail string
+}
+
+func (f *fakeStream) PublishMsg(_ context.Context, msg *nats.Msg, _ ...jetstream.PublishOpt) (*jetstream.PubAck, error) {
+	if msg.Subject == f.fail {
+		f.fail = ""
+		return nil, errors.New("nats: timeout")
+	}
+	id := msg.Header.Get(jetstream.MsgIDHeader)
+	if id == "" {
+		return nil, errors.New("no message ID")
+	}
+	if f.ids[id] {
+		return &jetstream.PubAck{Stream: StreamName, Duplicate: true}, nil
+	}
+	f.ids[id] = true
+	f.stored = append(f.stored, msg.Subject)
+	return &jetstream.PubAck{Stream: StreamName, Sequence: uint64(len(f.stored))}, nil
+}
+
+func outboxRows(subjects ...string) []gendb.ListOutboxEventsRow {
+	rows := make([]gendb.ListOutboxEventsRow, len(subjects))
+	for i, s := range subjects {
+		rows[i] = gendb.ListOutboxEventsRow{
+			Seq:     int64(i + 1),
+			ID:      pgtype.UUID{Bytes: uuid.New(), Valid: true},
+			Subject: s,
+		}
+	}
+	return rows
+}
+
+// remaining returns the rows whose seqs were not acknowledged, as the outbox
+// holds them after the relay deletes the acknowledged ones.
+func remaining(rows []gendb.ListOutboxEventsRow, acked []int64) []gendb.ListOutboxEventsRow {
+	return slices.DeleteFunc(slices.Clone(rows), func(r gendb.ListOutboxEventsRow) bool {
+		return slices.Contains(acked, r.Seq)
+	})
+}
+
+func TestPublishRowsKeepsFailedRows(t *testing.T) {
+	js := &fakeStream{ids: map[string]bool{}, fail: "b"}
+	rows := outboxRows("a", "b", "c")
+
+	acked, err := publishRows(t.Context(), js, rows)
+	if err == nil {
+		t.Fatal("publishRows succeeded despite a failed publish")
+	}
+	if !slices.Equal(acked, []int64{1}) {
+		t.Fatalf("acked seqs %v, want [1]: the failed row and the ones after it stay", acked)
+	}
+
+	acked, err = publishRows(t.Context(), js, remaining(rows, acked))
+	if err != nil {
+		t.Fatalf("retry: %v", err)
+	}
+	if !slices.Equal(acked, []int64{2, 3}) {
+		t.Errorf("retry acked seqs %v, want [2 3]", acked)
+	}
+	if want := []string{"a", "b", "c"}; !slices.Equal(js.stored, want) {
+		t.Errorf("stream holds %v, want %v in order", js.stored, want)
+	}
+}
+
+func TestPublishRowsRepublishIsDeduplicated(t *testing.T) {
+	js := &fakeStream{ids: map[string]bool{}}
+	rows := outboxRows("a", "b")
+
+	if _, err := publishRows(t.Context(), js, rows); err != nil {
+		t.Fatalf("publishRows: %v", err)
+	}
+	// The delete did not commit, so the same rows are published again.
+	acked, err := publishRows(t.Context(), js, rows)
+	if err != nil {
+		t.Fatalf("republish: %v", err)
+	}
+	if !slices.Equal(acked, []int64{1, 2}) {
+		t.Errorf("republish acked seqs %v, want [1 2] so the rows get deleted", acked)
+	}
+	if want := []string{"a", "b"}; !slices.Equal(js.stored, want) {
+		t.Errorf("stream holds %v, want each event once", js.stored)
+	}
+}
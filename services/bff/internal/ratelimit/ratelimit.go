@@ -0,0 +1,154 @@
+// Package ratelimit throttles callers with token buckets kept in Redis, so the
+// limits hold across every BFF replica.
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/ApeironFoundation/axle/bff/internal/auth"
+	"github.com/ApeironFoundation/axle/contracts/go/ai/v1/gen_ai_v1connect"
+	gendb "github.com/ApeironFoundation/axle/db/generated"
+)
+
+// Class groups procedures that share a quota.
+type Class string
+
+const (
+	ClassDefault   Class = "default"
+	ClassExpensive Class = "expensive"
+)
+
+// DefaultClasses lists the procedures that are not ClassDefault. AI runs are
+// served by the BFF, which forwards them to the LLM service, so their quota
+// is enforced here like any other.
+var DefaultClasses = map[string]Class{
+	gen_ai_v1connect.AITaskServiceRunAITaskProcedure: ClassExpensive,
+}
+
+// Limit is a token bucket: Rate requests per second on average, with bursts
+// of up to Burst requests.
+type Limit struct {
+	Rate  float64
+	Burst int
+}
+
+// RoleLimits holds the limit of each role. Roles without an entry are not
+// limited.
+type RoleLimits map[gendb.UserRole]Limit
+
+// ParseRoleLimits parses "role=rate:burst" pairs separated by commas, e.g.
+// "admin=20:40,member=10:20,viewer=5:10". An empty string limits nobody.
+func ParseRoleLimits(s string) (RoleLimits, error) {
+	limits := RoleLimits{}
+	for _, pair := range strings.Split(s, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		role, spec, ok := strings.Cut(pair, "=")
+		if !ok {
+			return nil, fmt.Errorf("%q: want role=rate:burst", pair)
+		}
+		switch r := gendb.UserRole(strings.TrimSpace(role)); r {
+		case gendb.UserRoleAdmin, gendb.UserRoleMember, gendb.UserRoleViewer:
+			rate, burst, ok := strings.Cut(spec, ":")
+			if !ok {
+				return nil, fmt.Errorf("%q: want role=rate:burst", pair)
+			}
+			l := Limit{}
+			var err error
+			if l.Rate, err = strconv.ParseFloat(strings.TrimSpace(rate), 64); err != nil || l.Rate <= 0 {
+				return nil, fmt.Errorf("%q: invalid rate", pair)
+			}
+			if l.Burst, err = strconv.Atoi(strings.TrimSpace(burst)); err != nil || l.Burst < 1 {
+				return nil, fmt.Errorf("%q: invalid burst", pair)
+			}
+			limits[r] = l
+		default:
+			return nil, fmt.Errorf("%q: unknown role %q", pair, role)
+		}
+	}
+	return limits, nil
+}
+
+// Limiter enforces per-role limits for each principal and procedure. Callers
+// using a personal access token get buckets of their own, apart from their
+// browser session and their other tokens.
+type Limiter struct {
+	Redis   *redis.Client
+	Limits  map[Class]RoleLimits
+	Classes map[string]Class
+}
+
+// Result is the outcome of Limiter.Allow.
+type Result struct {
+	Allowed bool
+	// RetryAfter is how long until the next request would be allowed.
+	RetryAfter time.Duration
+}
+
+// Class returns the class of procedure.
+func (l *Limiter) Class(procedure string) Class {
+	if class, ok := l.Classes[procedure]; ok {
+		return class
+	}
+	return ClassDefault
+}
+
+// Allow takes a token from the caller's bucket for procedure.
+func (l *Limiter) Allow(ctx context.Context, p auth.Principal, procedure string) (Result, error) {
+	limit, ok := l.Limits[l.Class(procedure)][p.Role]
+	if !ok {
+		return Result{Allowed: true}, nil
+	}
+
+	subject := "user:" + p.UserID.String()
+	if p.IsToken() {
+		subject = "token:" + p.TokenID.String()
+	}
+	key := "axle:ratelimit:" + subject + ":" + procedure
+
+	res, err := tokenBucket.Run(ctx, l.Redis, []string{key},
+		limit.Rate/1000, limit.Burst).Int64Slice()
+	if err != nil {
+		return Result{}, fmt.Errorf("rate limit %s: %w", key, err)
+	}
+	return Result{
+		Allowed:    res[0] == 1,
+		RetryAfter: time.Duration(res[1]) * time.Millisecond,
+	}, nil
+}
+
+// tokenBucket refills KEYS[1] at ARGV[1] tokens per millisecond up to ARGV[2]
+// and takes one token if it can. It returns {allowed, retry_after_ms}. Time
+// comes from the Redis server so replicas with skewed clocks agree.
+var tokenBucket = redis.NewScript(`
+local rate = tonumber(ARGV[1])
+local burst = tonumber(ARGV[2])
+local t = redis.call('TIME')
+local now = t[1] * 1000 + math.floor(t[2] / 1000)
+
+local b = redis.call('HMGET', KEYS[1], 'tokens', 'ts')
+local tokens = tonumber(b[1]) or burst
+local ts = tonumber(b[2]) or now
+tokens = math.min(burst, tokens + math.max(0, now - ts) * rate)
+
+local allowed, wait = 0, 0
+if tokens >= 1 then
+  tokens = tokens - 1
+  allowed = 1
+else
+  wait = math.ceil((1 - tokens) / rate)
+end
+
+redis.call('HSET', KEYS[1], 'tokens', tostring(tokens), 'ts', now)
+-- A bucket left alone until it is full again is the same as no bucket.
+redis.call('PEXPIRE', KEYS[1], math.ceil(burst / rate) + 1000)
+return {allowed, wait}
+`)
@@ -0,0 +1,92 @@
+// Package trash hard-deletes projects that have sat in the trash for longer
+// than the retention period.
+package trash
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog/log"
+
+	"github.com/ApeironFoundation/axle/bff/internal/audit"
+	gendb "github.com/ApeironFoundation/axle/db/generated"
+)
+
+// defaultBatchSize is how many projects a Purger deletes per transaction.
+const defaultBatchSize = 50
+
+// Purger deletes trashed projects once Retention has passed since they were
+// trashed, cascading to their members and everything else they own. Every
+// BFF replica runs one; each project is purged by whichever gets to it first.
+type Purger struct {
+	Pool      *pgxpool.Pool
+	Retention time.Duration
+	// Interval is how long the purger sleeps once nothing is due.
+	Interval time.Duration
+	// BatchSize caps the projects deleted per transaction (default 50).
+	BatchSize int
+}
+
+// Run purges expired projects until ctx is cancelled.
+func (p *Purger) Run(ctx context.Context) {
+	batch := p.BatchSize
+	if batch <= 0 {
+		batch = defaultBatchSize
+	}
+	t := time.NewTicker(p.Interval)
+	defer t.Stop()
+	for {
+		n, err := p.purgeBatch(ctx, batch)
+		if err != nil && ctx.Err() == nil {
+			log.Warn().Err(err).Msg("trash purge failed")
+		}
+		if n > 0 {
+			log.Info().Int("projects", n).Msg("purged trashed projects")
+		}
+		// A full batch means more may be due.
+		if err == nil && n == batch {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+		}
+	}
+}
+
+// purgeBatch deletes up to limit expired projects and records each deletion
+// in the audit log, without an actor.
+func (p *Purger) purgeBatch(ctx context.Context, limit int) (int, error) {
+	tx, err := p.Pool.Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("begin: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+	q := gendb.New(tx)
+
+	rows, err := q.PurgeTrashedProjects(ctx, gendb.PurgeTrashedProjectsParams{
+		TrashedBefore: pgtype.Timestamptz{Time: time.Now().Add(-p.Retention), Valid: true},
+		Limit:         int32(limit),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("purge: %w", err)
+	}
+	for _, row := range rows {
+		before := map[string]any{
+			"name":        row.Name,
+			"description": row.Description,
+			"deleted_at":  row.DeletedAt.Time,
+		}
+		if err := audit.Record(ctx, q, audit.EntityProject, row.ID, before, nil); err != nil {
+			return 0, err
+		}
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("commit: %w", err)
+	}
+	return len(rows), nil
+}
@@ -3,6 +3,7 @@ package main
 import (
 	"context"
 	"errors"
+	"expvar"
 	"fmt"
 	"net/http"
 	"os"
@@ -12,7 +13,6 @@ import (
 
 	"github.com/go-chi/chi/v5"
 	"github.com/google/uuid"
-	"github.com/nats-io/nats.go"
 	"github.com/redis/go-redis/v9"
 	"github.com/rs/cors"
 	"github.com/rs/zerolog"
@@ -20,11 +20,16 @@ import (
 	"golang.org/x/net/http2"
 	"golang.org/x/net/http2/h2c"
 
+	"github.com/ApeironFoundation/axle/contracts/go/bff/v1/gen_bff_v1connect"
+	gatewayv1 "github.com/ApeironFoundation/axle/contracts/go/gateway/v1"
 	"github.com/ApeironFoundation/axle/contracts/go/gateway/v1/gen_gateway_v1connect"
+	"github.com/ApeironFoundation/axle/gateway/internal/access"
 	"github.com/ApeironFoundation/axle/gateway/internal/config"
 	"github.com/ApeironFoundation/axle/gateway/internal/enterprise"
+	"github.com/ApeironFoundation/axle/gateway/internal/eventlog"
 	"github.com/ApeironFoundation/axle/gateway/internal/health"
 	"github.com/ApeironFoundation/axle/gateway/internal/hub"
+	"github.com/ApeironFoundation/axle/gateway/internal/interceptor"
 	"github.com/ApeironFoundation/axle/gateway/internal/natsclient"
 	"github.com/ApeironFoundation/axle/gateway/internal/streaming"
 )
@@ -33,6 +38,7 @@ func main() {
 	// ── Structured logging ───────────────────────────────────────────────────
 	log.Logger = log.Output(zerolog.ConsoleWriter{Out: os.Stderr, TimeFormat: time.RFC3339})
 	zerolog.TimeFieldFormat = zerolog.TimeFormatUnix
+	zerolog.DefaultContextLogger = &log.Logger
 
 	// ── Config ───────────────────────────────────────────────────────────────
 	cfg, err := config.Load()
@@ -74,19 +80,45 @@ func main() {
 	// ── Enterprise registry ──────────────────────────────────────────────────
 	_ = enterprise.NewRegistry()
 
-	// ── Streaming hub ────────────────────────────────────────────────────────
-	eventHub := hub.New()
-
-	// Subscribe to NATS events topic and fan-out to hub
-	_, err = natsConns.NC.Subscribe("axle.events.>", func(msg *nats.Msg) {
-		if msg.Subject == "axle.events.test.ping" {
-			log.Info().Str("subject", msg.Subject).Int("bytes", len(msg.Data)).Msg("dev-only ping event received by gateway")
+	// ── Subscriber access ────────────────────────────────────────────────────
+	var authorizer access.Authorizer
+	switch {
+	case cfg.BFFURL != "":
+		authorizer = &access.Resolver{
+			Users: gen_bff_v1connect.NewUserServiceClient(&http.Client{Timeout: 10 * time.Second}, cfg.BFFURL),
 		}
-		eventHub.Publish(context.Background(), msg.Data)
+		log.Info().Str("url", cfg.BFFURL).Msg("subscribers are authorized by the bff")
+	case cfg.EnableDev:
+		authorizer = access.Open{}
+		log.Warn().Msg("DEV-ONLY: BFF_URL is empty, every subscriber sees every event")
+	default:
+		log.Fatal().Msg("BFF_URL is required")
+	}
+	watcher := access.NewWatcher()
+
+	// ── Streaming hub ────────────────────────────────────────────────────────
+	policy, err := hub.ParsePolicy(cfg.SlowConsumerPolicy)
+	if err != nil {
+		log.Fatal().Err(err).Msg("invalid SLOW_CONSUMER_POLICY")
+	}
+	eventHub := hub.New(hub.Options{BufferSize: cfg.SubscriberBuffer, Policy: policy})
+
+	// Follow the event stream and fan out to the hub. Each event is decoded
+	// and validated once, in eventlog, and the same *Event is shared by every
+	// subscriber it is routed to. Access changes are applied before the event
+	// is published, so a subscription cut off by an event never receives it.
+	events := &eventlog.Log{JS: natsConns.JS}
+	if err := events.Wait(ctx); err != nil {
+		log.Fatal().Err(err).Msg("event stream unavailable")
+	}
+	follow, err := events.Follow(ctx, func(event *gatewayv1.Event) {
+		watcher.Observe(event)
+		eventHub.Publish(context.Background(), event)
 	})
 	if err != nil {
-		log.Fatal().Err(err).Msg("nats subscribe failed")
+		log.Fatal().Err(err).Msg("event stream subscribe failed")
 	}
+	defer follow.Stop()
 
 	// ── Health checker ───────────────────────────────────────────────────────
 	checker := health.NewChecker(rdb, natsConns.NC)
@@ -116,11 +148,14 @@ func main() {
 
 	r.Get("/health", checker.HealthHandler)
 	r.Get("/ready", checker.ReadyHandler)
+	// Expvars such as the gateway_hub slow subscriber counters and the
+	// gateway_events dead-letter count, for global admins.
+	r.With(access.AdminOnly(authorizer)).Get("/debug/vars", expvar.Handler().ServeHTTP)
 
 	// ConnectRPC streaming service
 	connectMux := http.NewServeMux()
 	connectMux.Handle(gen_gateway_v1connect.NewStreamingServiceHandler(
-		streaming.NewHandler(eventHub),
+		streaming.NewHandler(eventHub, events, watcher), interceptor.HandlerOptions(authorizer, watcher),
 	))
 	r.Handle("/gateway.v1.*", connectMux)
 
@@ -0,0 +1,116 @@
+// Package access decides which events a Gateway subscriber may receive. The
+// BFF authenticates the subscriber's token and reports their projects; a
+// Watcher then follows membership and account changes in the event stream to
+// keep live subscriptions within those bounds.
+package access
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"connectrpc.com/connect"
+
+	bffv1 "github.com/ApeironFoundation/axle/contracts/go/bff/v1"
+	"github.com/ApeironFoundation/axle/contracts/go/bff/v1/gen_bff_v1connect"
+)
+
+// Grant is what a subscriber may see.
+type Grant struct {
+	UserID string
+	// Admin is set for global admins, who see every project.
+	Admin bool
+	// Projects holds the projects the user is a member of.
+	Projects map[string]bool
+	// Revision is the Watcher revision taken before the grant was resolved.
+	Revision uint64
+}
+
+// CanSee reports whether the grant covers projectID.
+func (g Grant) CanSee(projectID string) bool {
+	return g.Admin || g.Projects[projectID]
+}
+
+type grantKey struct{}
+
+// WithGrant returns a copy of ctx carrying the caller's grant.
+func WithGrant(ctx context.Context, g Grant) context.Context {
+	return context.WithValue(ctx, grantKey{}, g)
+}
+
+// GrantFromContext returns the grant stored by WithGrant.
+func GrantFromContext(ctx context.Context) (Grant, bool) {
+	g, ok := ctx.Value(grantKey{}).(Grant)
+	return g, ok
+}
+
+// Authorizer resolves the Authorization header of a subscriber to a Grant.
+type Authorizer interface {
+	Resolve(ctx context.Context, authorization string) (Grant, error)
+}
+
+// Open grants every caller admin access without checking anything. It is
+// DEV-ONLY, for running the Gateway without a BFF.
+type Open struct{}
+
+// Resolve implements Authorizer.
+func (Open) Resolve(context.Context, string) (Grant, error) {
+	return Grant{UserID: "dev", Admin: true}, nil
+}
+
+// Resolver resolves a bearer token to a Grant by asking the BFF, so the
+// Gateway accepts exactly the tokens the BFF does.
+type Resolver struct {
+	Users gen_bff_v1connect.UserServiceClient
+}
+
+// Resolve returns the grant of the caller presenting authorization, the
+// value of their Authorization header. Authentication and permission errors
+// from the BFF are passed on; anything else becomes CodeUnavailable.
+func (r *Resolver) Resolve(ctx context.Context, authorization string) (Grant, error) {
+	if authorization == "" {
+		return Grant{}, connect.NewError(connect.CodeUnauthenticated, errors.New("authentication required"))
+	}
+	ctx, call := connect.NewClientContext(ctx)
+	call.RequestHeader().Set("Authorization", authorization)
+	resp, err := r.Users.GetMyAccess(ctx, &bffv1.GetMyAccessRequest{})
+	if err != nil {
+		switch connect.CodeOf(err) {
+		case connect.CodeUnauthenticated, connect.CodePermissionDenied:
+			var cerr *connect.Error
+			if errors.As(err, &cerr) {
+				return Grant{}, connect.NewError(cerr.Code(), errors.New(cerr.Message()))
+			}
+		}
+		return Grant{}, connect.NewError(connect.CodeUnavailable, errors.New("cannot verify access right now"))
+	}
+	g := Grant{
+		UserID:   resp.GetUserId(),
+		Admin:    resp.GetRole() == bffv1.UserRole_USER_ROLE_ADMIN,
+		Projects: make(map[string]bool, len(resp.GetProjectIds())),
+	}
+	for _, id := range resp.GetProjectIds() {
+		g.Projects[id] = true
+	}
+	return g, nil
+}
+
+// AdminOnly guards a plain HTTP route: the caller is resolved by a from the
+// Authorization header and must be a global admin. Failures are written as
+// Connect JSON errors.
+func AdminOnly(a Authorizer) func(http.Handler) http.Handler {
+	errs := connect.NewErrorWriter()
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			g, err := a.Resolve(r.Context(), r.Header.Get("Authorization"))
+			if err == nil && !g.Admin {
+				err = connect.NewError(connect.CodePermissionDenied, errors.New("admin role required"))
+			}
+			if err != nil {
+				_ = errs.Write(w, r, err)
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
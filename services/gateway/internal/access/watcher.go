@@ -0,0 +1,200 @@
+package access
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/rs/zerolog/log"
+	"google.golang.org/protobuf/proto"
+
+	bffv1 "github.com/ApeironFoundation/axle/contracts/go/bff/v1"
+	gatewayv1 "github.com/ApeironFoundation/axle/contracts/go/gateway/v1"
+)
+
+// Causes a watched subscription is cancelled with.
+var (
+	ErrMembershipRemoved = errors.New("you were removed from a project this stream follows")
+	ErrAccountChanged    = errors.New("your account was deactivated, deleted or lost the admin role")
+)
+
+// ErrGrantStale is returned by Watch when the user's access changed after
+// their grant was resolved.
+var ErrGrantStale = errors.New("your access changed while the stream was being set up; subscribe again")
+
+// changeLogSize is how many recent access changes the Watcher remembers to
+// check new subscriptions against.
+const changeLogSize = 1024
+
+// watch is a live subscription as the Watcher sees it.
+type watch struct {
+	admin bool
+	// all is set when the subscription follows every project the user can
+	// see, so it grows when they join one.
+	all      bool
+	projects map[string]bool
+	cancel   context.CancelCauseFunc
+	joined   func(projectID string)
+}
+
+// Watcher applies membership and account changes to live subscriptions:
+// leaving a project or losing the account cuts a subscription off, joining a
+// project extends the ones that follow all of the user's projects. Only
+// those events take its lock; the others pass straight through Observe.
+//
+// A grant is resolved before its subscription is watched, so the Watcher
+// also numbers the changes it observes and remembers whose access the recent
+// ones were about. Watch refuses a grant that a later change may have made
+// stale.
+type Watcher struct {
+	mu     sync.Mutex
+	byUser map[string]map[*watch]struct{}
+	// rev counts the changes observed; recent[r%changeLogSize] is the user
+	// change r was about, for the last changeLogSize of them.
+	rev    uint64
+	recent [changeLogSize]string
+}
+
+// NewWatcher creates a Watcher with no subscriptions.
+func NewWatcher() *Watcher {
+	return &Watcher{byUser: make(map[string]map[*watch]struct{})}
+}
+
+// Revision returns the number of access changes observed so far. Take it
+// before resolving a grant and store it in Grant.Revision.
+func (w *Watcher) Revision() uint64 {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.rev
+}
+
+// Watch registers a subscription made under g to projects, or to every
+// project g covers when all is set. cancel ends the subscription when its
+// access is revoked; joined is called with each project the user joins while
+// an all-projects subscription of a non-admin is live. The returned func
+// unregisters it. Watch fails with ErrGrantStale if the user's access may
+// have changed since g.Revision.
+func (w *Watcher) Watch(g Grant, projects []string, all bool, cancel context.CancelCauseFunc, joined func(projectID string)) (func(), error) {
+	wt := &watch{admin: g.Admin, all: all, projects: make(map[string]bool), cancel: cancel, joined: joined}
+	if all {
+		for p := range g.Projects {
+			wt.projects[p] = true
+		}
+	}
+	for _, p := range projects {
+		wt.projects[p] = true
+	}
+
+	w.mu.Lock()
+	if w.changedSince(g.UserID, g.Revision) {
+		w.mu.Unlock()
+		return nil, ErrGrantStale
+	}
+	set, ok := w.byUser[g.UserID]
+	if !ok {
+		set = make(map[*watch]struct{})
+		w.byUser[g.UserID] = set
+	}
+	set[wt] = struct{}{}
+	w.mu.Unlock()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			w.mu.Lock()
+			defer w.mu.Unlock()
+			// A user's set is only dropped once empty, so it still holds wt.
+			set := w.byUser[g.UserID]
+			delete(set, wt)
+			if len(set) == 0 {
+				delete(w.byUser, g.UserID)
+			}
+		})
+	}, nil
+}
+
+// changedSince reports whether a change observed after revision rev was
+// about userID. Changes too old to be remembered count as about everyone.
+// w.mu must be held.
+func (w *Watcher) changedSince(userID string, rev uint64) bool {
+	if w.rev-rev > changeLogSize {
+		return true
+	}
+	for r := rev + 1; r <= w.rev; r++ {
+		if w.recent[r%changeLogSize] == userID {
+			return true
+		}
+	}
+	return false
+}
+
+// Observe updates the subscriptions affected by event. Call it for every
+// event before it is published.
+func (w *Watcher) Observe(event *gatewayv1.Event) {
+	switch event.GetType() {
+	case gatewayv1.EventType_EVENT_TYPE_PROJECT_MEMBER_ADDED:
+		var m bffv1.ProjectMember
+		if !decode(event, &m) {
+			return
+		}
+		w.each(m.GetUserId(), func(wt *watch) {
+			if wt.admin || !wt.all || wt.projects[m.GetProjectId()] {
+				return
+			}
+			wt.projects[m.GetProjectId()] = true
+			wt.joined(m.GetProjectId())
+		})
+
+	case gatewayv1.EventType_EVENT_TYPE_PROJECT_MEMBER_REMOVED:
+		var m bffv1.ProjectMember
+		if !decode(event, &m) {
+			return
+		}
+		w.each(m.GetUserId(), func(wt *watch) {
+			if !wt.admin && wt.projects[m.GetProjectId()] {
+				wt.cancel(ErrMembershipRemoved)
+			}
+		})
+
+	case gatewayv1.EventType_EVENT_TYPE_USER_UPDATED:
+		var u bffv1.User
+		if !decode(event, &u) {
+			return
+		}
+		deactivated := u.GetStatus() == bffv1.UserStatus_USER_STATUS_DEACTIVATED
+		demoted := u.GetRole() != bffv1.UserRole_USER_ROLE_ADMIN
+		w.each(u.GetId(), func(wt *watch) {
+			if deactivated || (wt.admin && demoted) {
+				wt.cancel(ErrAccountChanged)
+			}
+		})
+
+	case gatewayv1.EventType_EVENT_TYPE_USER_DELETED:
+		var u bffv1.User
+		if !decode(event, &u) {
+			return
+		}
+		w.each(u.GetId(), func(wt *watch) { wt.cancel(ErrAccountChanged) })
+	}
+}
+
+// each records a change to userID's access and calls fn with each of their
+// subscriptions.
+func (w *Watcher) each(userID string, fn func(*watch)) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.rev++
+	w.recent[w.rev%changeLogSize] = userID
+	for wt := range w.byUser[userID] {
+		fn(wt)
+	}
+}
+
+func decode(event *gatewayv1.Event, m proto.Message) bool {
+	if err := proto.Unmarshal(event.GetPayload(), m); err != nil {
+		log.Warn().Err(err).Str("event_id", event.GetId()).Str("type", event.GetType().String()).
+			Msg("access: cannot decode event payload")
+		return false
+	}
+	return true
+}
@@ -0,0 +1,139 @@
+package access
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	bffv1 "github.com/ApeironFoundation/axle/contracts/go/bff/v1"
+	gatewayv1 "github.com/ApeironFoundation/axle/contracts/go/gateway/v1"
+)
+
+func memberEvent(typ gatewayv1.EventType, projectID, userID string) *gatewayv1.Event {
+	payload, _ := proto.Marshal(&bffv1.ProjectMember{ProjectId: projectID, UserId: userID})
+	return &gatewayv1.Event{Type: typ, ProjectId: projectID, Payload: payload}
+}
+
+func removal(projectID, userID string) *gatewayv1.Event {
+	return memberEvent(memberRemoved, projectID, userID)
+}
+
+func manyChanges(n int, userID string) []*gatewayv1.Event {
+	events := make([]*gatewayv1.Event, n)
+	for i := range events {
+		events[i] = removal("p1", userID)
+	}
+	return events
+}
+
+func userEvent(typ gatewayv1.EventType, u *bffv1.User) *gatewayv1.Event {
+	payload, _ := proto.Marshal(u)
+	return &gatewayv1.Event{Type: typ, Payload: payload}
+}
+
+var (
+	memberAdded   = gatewayv1.EventType_EVENT_TYPE_PROJECT_MEMBER_ADDED
+	memberRemoved = gatewayv1.EventType_EVENT_TYPE_PROJECT_MEMBER_REMOVED
+	userUpdated   = gatewayv1.EventType_EVENT_TYPE_USER_UPDATED
+)
+
+func TestWatchRefusesStaleGrant(t *testing.T) {
+	tests := []struct {
+		name string
+		// changes are observed between taking the revision and Watch.
+		changes []*gatewayv1.Event
+		stale   bool
+	}{
+		{"no changes", nil, false},
+		{"change about another user", []*gatewayv1.Event{removal("p1", "u2")}, false},
+		{"change about the user", []*gatewayv1.Event{removal("p1", "u1")}, true},
+		{"join by the user", []*gatewayv1.Event{memberEvent(memberAdded, "p2", "u1")}, true},
+		{"user change among others", []*gatewayv1.Event{
+			removal("p1", "u2"),
+			userEvent(userUpdated, &bffv1.User{Id: "u1", Role: bffv1.UserRole_USER_ROLE_MEMBER}),
+			removal("p1", "u3"),
+		}, true},
+		{"more changes than are remembered", manyChanges(changeLogSize+1, "u2"), true},
+		{"as many changes as are remembered", manyChanges(changeLogSize, "u2"), false},
+		{"event that changes no access", []*gatewayv1.Event{{Type: gatewayv1.EventType_EVENT_TYPE_TASK_UPDATED}}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := NewWatcher()
+			// Earlier changes about the user are already reflected in the grant.
+			w.Observe(removal("p0", "u1"))
+
+			g := Grant{UserID: "u1", Projects: map[string]bool{"p1": true}, Revision: w.Revision()}
+			for _, e := range tt.changes {
+				w.Observe(e)
+			}
+			unwatch, err := w.Watch(g, nil, true, func(error) {}, func(string) {})
+			if tt.stale {
+				if !errors.Is(err, ErrGrantStale) {
+					t.Fatalf("err = %v, want ErrGrantStale", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Watch: %v", err)
+			}
+			unwatch()
+			if len(w.byUser) != 0 {
+				t.Errorf("unwatch left %d users registered", len(w.byUser))
+			}
+		})
+	}
+}
+
+func TestObserveAppliesChanges(t *testing.T) {
+	member := Grant{UserID: "u1", Projects: map[string]bool{"p1": true}}
+	admin := Grant{UserID: "u1", Admin: true}
+	tests := []struct {
+		name   string
+		grant  Grant
+		all    bool
+		event  *gatewayv1.Event
+		cause  error
+		joined string
+	}{
+		{"removed from a followed project", member, true, removal("p1", "u1"), ErrMembershipRemoved, ""},
+		{"removed from another project", member, true, removal("p2", "u1"), nil, ""},
+		{"another user removed", member, true, removal("p1", "u2"), nil, ""},
+		{"admin removed from a project", admin, true, removal("p1", "u1"), nil, ""},
+		{"joined while following all", member, true, memberEvent(memberAdded, "p2", "u1"), nil, "p2"},
+		{"joined while following some", member, false, memberEvent(memberAdded, "p2", "u1"), nil, ""},
+		{"deactivated", member, true, userEvent(userUpdated, &bffv1.User{Id: "u1", Status: bffv1.UserStatus_USER_STATUS_DEACTIVATED}), ErrAccountChanged, ""},
+		{"admin demoted", admin, true, userEvent(userUpdated, &bffv1.User{Id: "u1", Role: bffv1.UserRole_USER_ROLE_MEMBER}), ErrAccountChanged, ""},
+		{"member renamed", member, true, userEvent(userUpdated, &bffv1.User{Id: "u1", Name: "new", Role: bffv1.UserRole_USER_ROLE_MEMBER}), nil, ""},
+		{"deleted", member, true, userEvent(gatewayv1.EventType_EVENT_TYPE_USER_DELETED, &bffv1.User{Id: "u1"}), ErrAccountChanged, ""},
+		{"undecodable payload", member, true, &gatewayv1.Event{Type: memberRemoved, Payload: []byte{0xff}}, nil, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := NewWatcher()
+			ctx, cancel := context.WithCancelCause(context.Background())
+			defer cancel(nil)
+			var joined string
+			var projects []string
+			if !tt.all {
+				projects = []string{"p1"}
+			}
+			tt.grant.Revision = w.Revision()
+			unwatch, err := w.Watch(tt.grant, projects, tt.all, cancel, func(p string) { joined = p })
+			if err != nil {
+				t.Fatalf("Watch: %v", err)
+			}
+			defer unwatch()
+
+			w.Observe(tt.event)
+			if got := context.Cause(ctx); !errors.Is(got, tt.cause) || (tt.cause == nil && got != nil) {
+				t.Errorf("cancelled with %v, want %v", got, tt.cause)
+			}
+			if joined != tt.joined {
+				t.Errorf("joined %q, want %q", joined, tt.joined)
+			}
+		})
+	}
+}
@@ -8,10 +8,20 @@ import (
 
 // Config holds all configuration for the Gateway service.
 type Config struct {
-	Port     int
-	NatsURL  string
-	RedisURL string
-	LogLevel string
+	Port      int
+	NatsURL   string
+	RedisURL  string
+	LogLevel  string
+	EnableDev bool
+	// BFFURL is where subscriber tokens and project access are checked.
+	// Required unless EnableDev.
+	BFFURL string
+
+	// SubscriberBuffer is how many events a subscriber may fall behind
+	// before SlowConsumerPolicy applies: "disconnect", "drop_oldest" or
+	// "coalesce". Subscribers may pick another policy.
+	SubscriberBuffer   int
+	SlowConsumerPolicy string
 }
 
 // Load reads configuration from environment variables with sensible defaults.
@@ -21,11 +31,22 @@ func Load() (*Config, error) {
 		return nil, fmt.Errorf("invalid PORT: %w", err)
 	}
 
+	subscriberBuffer, err := getEnvInt("SUBSCRIBER_BUFFER", 64)
+	if err != nil || subscriberBuffer <= 0 {
+		return nil, fmt.Errorf("invalid SUBSCRIBER_BUFFER: %q", os.Getenv("SUBSCRIBER_BUFFER"))
+	}
+
 	return &Config{
 		Port:     port,
 		NatsURL:  getEnv("NATS_URL", "nats://localhost:4222"),
 		RedisURL: getEnv("REDIS_URL", "redis://localhost:6379"),
 		LogLevel: getEnv("LOG_LEVEL", "info"),
+
+		EnableDev: getEnvBool("ENABLE_DEV_ENDPOINTS", false),
+		BFFURL:    getEnv("BFF_URL", ""),
+
+		SubscriberBuffer:   subscriberBuffer,
+		SlowConsumerPolicy: getEnv("SLOW_CONSUMER_POLICY", "disconnect"),
 	}, nil
 }
 
@@ -43,3 +64,15 @@ func getEnvInt(key string, fallback int) (int, error) {
 	}
 	return strconv.Atoi(v)
 }
+
+func getEnvBool(key string, fallback bool) bool {
+	v := os.Getenv(key)
+	if v == "" {
+		return fallback
+	}
+	b, err := strconv.ParseBool(v)
+	if err != nil {
+		return fallback
+	}
+	return b
+}
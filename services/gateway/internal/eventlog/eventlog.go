@@ -0,0 +1,206 @@
+// Package eventlog reads events from the JetStream stream the BFF publishes
+// them to: live for the hub, and from a given sequence on for subscribers
+// that resume after a disconnect.
+package eventlog
+
+import (
+	"context"
+	"errors"
+	"expvar"
+	"fmt"
+	"time"
+
+	"buf.build/go/protovalidate"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	gatewayv1 "github.com/ApeironFoundation/axle/contracts/go/gateway/v1"
+)
+
+const (
+	// StreamName is the stream the BFF creates for everything published
+	// under axle.events.
+	StreamName = "AXLE_EVENTS"
+	subjects   = "axle.events.>"
+	// waitInterval is how often Wait looks for the stream.
+	waitInterval = 2 * time.Second
+)
+
+// stats counts events "received" from the stream and those "dead_lettered";
+// they are served with the other expvars on /debug/vars.
+var stats = expvar.NewMap("gateway_events")
+
+// ErrResumeExpired is returned by Replay when the events after the requested
+// sequence are no longer in the stream, or the sequence is not one it gave.
+var ErrResumeExpired = errors.New("events after this sequence are no longer retained")
+
+// Log is the event stream.
+type Log struct {
+	JS jetstream.JetStream
+}
+
+// Wait blocks until the stream exists. The BFF creates it, and may well start
+// after the Gateway.
+func (l *Log) Wait(ctx context.Context) error {
+	for logged := false; ; logged = true {
+		_, err := l.JS.Stream(ctx, StreamName)
+		if err == nil {
+			return nil
+		}
+		if !errors.Is(err, jetstream.ErrStreamNotFound) {
+			return fmt.Errorf("stream %s: %w", StreamName, err)
+		}
+		if !logged {
+			log.Info().Str("stream", StreamName).Msg("waiting for the bff to create the event stream")
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(waitInterval):
+		}
+	}
+}
+
+// Follow calls fn with every event stored from now on, in order, until the
+// returned ConsumeContext is stopped. Each message is decoded and validated
+// here, once; those that are not valid events go to the dead-letter log.
+func (l *Log) Follow(ctx context.Context, fn func(*gatewayv1.Event)) (jetstream.ConsumeContext, error) {
+	cons, err := l.JS.OrderedConsumer(ctx, StreamName, jetstream.OrderedConsumerConfig{
+		FilterSubjects: []string{subjects},
+		DeliverPolicy:  jetstream.DeliverNewPolicy,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("follow %s: %w", StreamName, err)
+	}
+	return cons.Consume(func(msg jetstream.Msg) {
+		if msg.Subject() == "axle.events.test.ping" {
+			log.Info().Str("subject", msg.Subject()).Int("bytes", len(msg.Data())).Msg("dev-only ping event received by gateway")
+		}
+		stats.Add("received", 1)
+		event, err := decode(msg)
+		if err != nil {
+			deadLetter(msg, err)
+			return
+		}
+		fn(event)
+	})
+}
+
+// Replay calls fn, in order, with the events stored after sequence after up
+// to the last one stored when it was called, and returns the sequence of the
+// last event it read. It stops at the first error from fn or ctx.
+func (l *Log) Replay(ctx context.Context, after uint64, fn func(*gatewayv1.Event) error) (uint64, error) {
+	stream, err := l.JS.Stream(ctx, StreamName)
+	if err != nil {
+		return 0, fmt.Errorf("stream %s: %w", StreamName, err)
+	}
+	state := stream.CachedInfo().State
+	if done, err := replayBounds(after, state); done || err != nil {
+		return after, err
+	}
+
+	cons, err := stream.OrderedConsumer(ctx, jetstream.OrderedConsumerConfig{
+		FilterSubjects: []string{subjects},
+		DeliverPolicy:  jetstream.DeliverByStartSequencePolicy,
+		OptStartSeq:    after + 1,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("replay %s: %w", StreamName, err)
+	}
+	it, err := cons.Messages()
+	if err != nil {
+		return 0, fmt.Errorf("replay %s: %w", StreamName, err)
+	}
+	defer it.Stop()
+	stop := context.AfterFunc(ctx, it.Stop)
+	defer stop()
+
+	last := after
+	for last < state.LastSeq {
+		msg, err := it.Next()
+		if err != nil {
+			if ctx.Err() != nil {
+				return last, ctx.Err()
+			}
+			return last, fmt.Errorf("replay %s: %w", StreamName, err)
+		}
+		event, err := decode(msg)
+		if err != nil {
+			// Dead-lettered when it was first read.
+			if meta, err := msg.Metadata(); err == nil {
+				last = meta.Sequence.Stream
+			}
+			continue
+		}
+		if event.GetSequence() > state.LastSeq {
+			// Stored after the call; live delivery has it.
+			break
+		}
+		last = event.GetSequence()
+		if err := fn(event); err != nil {
+			return last, err
+		}
+	}
+	return last, nil
+}
+
+// FirstSeq returns the sequence of the oldest event the stream still holds,
+// or the one the next event will get if it is empty.
+func (l *Log) FirstSeq(ctx context.Context) (uint64, error) {
+	stream, err := l.JS.Stream(ctx, StreamName)
+	if err != nil {
+		return 0, fmt.Errorf("stream %s: %w", StreamName, err)
+	}
+	return stream.CachedInfo().State.FirstSeq, nil
+}
+
+// replayBounds checks a replay after sequence after against the stream's
+// state: done when there is nothing after it, ErrResumeExpired when the
+// stream never gave that sequence or no longer holds the events after it.
+func replayBounds(after uint64, state jetstream.StreamState) (done bool, err error) {
+	switch {
+	case after > state.LastSeq, after+1 < state.FirstSeq:
+		return false, ErrResumeExpired
+	case after == state.LastSeq:
+		return true, nil
+	}
+	return false, nil
+}
+
+// decode parses msg as an Event, checks it against its validation rules and
+// stamps it with its stream sequence. Publishers serialize events with
+// proto.Marshal; protojson is accepted too, which is handy for ad-hoc
+// publishing from the nats CLI.
+func decode(msg jetstream.Msg) (*gatewayv1.Event, error) {
+	meta, err := msg.Metadata()
+	if err != nil {
+		return nil, err
+	}
+	var event gatewayv1.Event
+	if err := proto.Unmarshal(msg.Data(), &event); err != nil {
+		if jsonErr := protojson.Unmarshal(msg.Data(), &event); jsonErr != nil {
+			return nil, err
+		}
+	}
+	if err := protovalidate.Validate(&event); err != nil {
+		return nil, err
+	}
+	event.Sequence = meta.Sequence.Stream
+	return &event, nil
+}
+
+// deadLetter records a message that is not a valid event. It stays in the
+// stream until it ages out, so the logged sequence is enough to fetch it for
+// inspection:
+//
+//	nats stream get AXLE_EVENTS <sequence>
+func deadLetter(msg jetstream.Msg, err error) {
+	stats.Add("dead_lettered", 1)
+	l := log.Error().Err(err).Str("subject", msg.Subject()).Int("bytes", len(msg.Data()))
+	if meta, metaErr := msg.Metadata(); metaErr == nil {
+		l = l.Uint64("sequence", meta.Sequence.Stream)
+	}
+	l.Msg("dead-letter: dropping invalid event")
+}
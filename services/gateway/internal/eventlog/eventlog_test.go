@@ -0,0 +1,122 @@
+package eventlog
+
+import (
+	"errors"
+	"expvar"
+	"testing"
+
+	"github.com/nats-io/nats.go/jetstream"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	gatewayv1 "github.com/ApeironFoundation/axle/contracts/go/gateway/v1"
+)
+
+func TestReplayBounds(t *testing.T) {
+	// The stream holds 11..20: 1..10 aged out past its MaxAge.
+	state := jetstream.StreamState{FirstSeq: 11, LastSeq: 20}
+	tests := []struct {
+		name  string
+		after uint64
+		state jetstream.StreamState
+		done  bool
+		err   error
+	}{
+		{"within the stream", 15, state, false, nil},
+		{"just before the oldest event", 10, state, false, nil},
+		{"aged out", 9, state, false, ErrResumeExpired},
+		{"long aged out", 1, state, false, ErrResumeExpired},
+		{"caught up", 20, state, true, nil},
+		{"never given", 21, state, false, ErrResumeExpired},
+		{"from the start of a fresh stream", 0, jetstream.StreamState{FirstSeq: 1, LastSeq: 3}, false, nil},
+		{"empty stream", 0, jetstream.StreamState{FirstSeq: 1}, true, nil},
+		{"emptied stream", 20, jetstream.StreamState{FirstSeq: 21, LastSeq: 20}, true, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			done, err := replayBounds(tt.after, tt.state)
+			if done != tt.done || !errors.Is(err, tt.err) {
+				t.Errorf("replayBounds(%d) = %v, %v; want %v, %v", tt.after, done, err, tt.done, tt.err)
+			}
+		})
+	}
+}
+
+// fakeMsg is a stored stream message; anything else it is asked for panics
+// on the nil embedded interface.
+type fakeMsg struct {
+	jetstream.Msg
+	data []byte
+	seq  uint64
+}
+
+func (m fakeMsg) Data() []byte    { return m.data }
+func (m fakeMsg) Subject() string { return "axle.events.test" }
+func (m fakeMsg) Metadata() (*jetstream.MsgMetadata, error) {
+	return &jetstream.MsgMetadata{Sequence: jetstream.SequencePair{Stream: m.seq}}, nil
+}
+
+func TestDecode(t *testing.T) {
+	valid := &gatewayv1.Event{
+		Id:         "e1",
+		Type:       gatewayv1.EventType_EVENT_TYPE_TASK_UPDATED,
+		OccurredAt: timestamppb.Now(),
+		// A publisher's own sequence is replaced with the stream's.
+		Sequence: 99,
+	}
+	asBinary, _ := proto.Marshal(valid)
+	asJSON, _ := protojson.Marshal(valid)
+	noID := proto.Clone(valid).(*gatewayv1.Event)
+	noID.Id = ""
+	noIDBinary, _ := proto.Marshal(noID)
+	noTime := proto.Clone(valid).(*gatewayv1.Event)
+	noTime.OccurredAt = nil
+	noTimeBinary, _ := proto.Marshal(noTime)
+	unknownType := proto.Clone(valid).(*gatewayv1.Event)
+	unknownType.Type = 999
+	unknownTypeBinary, _ := proto.Marshal(unknownType)
+
+	tests := []struct {
+		name  string
+		data  []byte
+		valid bool
+	}{
+		{"binary", asBinary, true},
+		{"protojson", asJSON, true},
+		{"garbage", []byte("not an event"), false},
+		{"missing id", noIDBinary, false},
+		{"missing occurred_at", noTimeBinary, false},
+		{"undefined type", unknownTypeBinary, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg := fakeMsg{data: tt.data, seq: 7}
+			event, err := decode(msg)
+			if !tt.valid {
+				if err == nil {
+					t.Fatalf("decoded %v, want it dead-lettered", event)
+				}
+				before := deadLettered()
+				deadLetter(msg, err)
+				if got := deadLettered(); got != before+1 {
+					t.Errorf("dead_lettered went from %d to %d", before, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("decode: %v", err)
+			}
+			if event.GetId() != valid.GetId() || event.GetSequence() != 7 {
+				t.Errorf("decoded id %q at sequence %d, want %q at the stream's 7", event.GetId(), event.GetSequence(), valid.GetId())
+			}
+		})
+	}
+}
+
+func deadLettered() int64 {
+	if v, ok := stats.Get("dead_lettered").(*expvar.Int); ok {
+		return v.Value()
+	}
+	return 0
+}
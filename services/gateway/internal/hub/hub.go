@@ -2,51 +2,253 @@ package hub
 
 import (
 	"context"
+	"slices"
 	"sync"
+	"sync/atomic"
 
-	"github.com/rs/zerolog/log"
+	gatewayv1 "github.com/ApeironFoundation/axle/contracts/go/gateway/v1"
 )
 
-// Subscriber is a channel that receives serialised Event bytes.
-type Subscriber chan []byte
+// defaultBufferSize is how many events a subscriber may fall behind before
+// its policy kicks in, unless Options say otherwise.
+const defaultBufferSize = 64
+
+// Options configure a Hub.
+type Options struct {
+	// BufferSize is how many events each subscriber may fall behind.
+	BufferSize int
+	// Policy applies to subscribers that do not pick one; PolicyDefault
+	// means PolicyDisconnect.
+	Policy Policy
+}
+
+// Filter selects the events a subscriber receives. A filter with no projects
+// matches nothing: callers decide what a subscriber may see and spell it out.
+// An empty EventTypes matches every type.
+type Filter struct {
+	ProjectIDs []string
+	// AllProjects matches events of every project and those of none.
+	AllProjects bool
+	// Global matches events that belong to no project, such as user changes.
+	Global     bool
+	EventTypes []gatewayv1.EventType
+}
+
+// Subscription is a registered subscriber. Events are queued for it until
+// it drains them; when it falls behind, its Policy decides what gives.
+type Subscription struct {
+	ID string
+
+	types   []gatewayv1.EventType
+	policy  Policy
+	size    int
+	ready   chan struct{}
+	dropped atomic.Uint64
+
+	qmu        sync.Mutex // guards the fields below
+	queue      []*entry
+	pending    map[string]*entry // queued events by coalescing key
+	overflowed bool
+	overflow   chan struct{}
+
+	mu       sync.Mutex // guards keys, projects and closed
+	keys     []key
+	projects map[string]bool
+	closed   bool
+}
+
+// allProjects is the project of the key AllProjects subscribers are stored
+// under; project-less events are routed under the empty project.
+const allProjects = "*"
+
+// key addresses a bucket of subscribers. The allProjects project and the zero
+// type are wildcards, so an event is looked up under up to four keys, and a
+// subscriber is stored under exactly one of them for each event it matches.
+type key struct {
+	project string
+	typ     gatewayv1.EventType
+}
+
+// bucket holds the subscribers under one key as a copy-on-write slice:
+// Publish reads it without locking, writers swap in a new slice under mu.
+type bucket struct {
+	mu   sync.Mutex
+	subs atomic.Pointer[[]*Subscription]
+	dead bool // removed from the hub; writers must fetch a fresh bucket
+}
 
 // Hub manages subscriptions and fan-out of events to connected clients.
-// Events arrive from NATS and are broadcast to matching subscribers.
+// Events arrive from NATS and go only to the subscribers whose filter
+// matches. Routing an event takes no lock, so publishing never waits on
+// subscribers coming and going.
 type Hub struct {
-	mu   sync.RWMutex
-	subs map[string]Subscriber // key: subscriber ID
+	buckets    sync.Map // key -> *bucket
+	bufferSize int
+	policy     Policy
 }
 
 // New creates an empty Hub.
-func New() *Hub {
-	return &Hub{subs: make(map[string]Subscriber)}
+func New(opts Options) *Hub {
+	h := &Hub{bufferSize: opts.BufferSize, policy: opts.Policy}
+	if h.bufferSize <= 0 {
+		h.bufferSize = defaultBufferSize
+	}
+	if h.policy == PolicyDefault {
+		h.policy = PolicyDisconnect
+	}
+	return h
 }
 
-// Subscribe registers a new subscriber and returns its channel and an unsubscribe func.
-func (h *Hub) Subscribe(id string) (Subscriber, func()) {
-	ch := make(Subscriber, 64)
-	h.mu.Lock()
-	h.subs[id] = ch
-	h.mu.Unlock()
+// Subscribe registers a subscriber for the events matching f, handling it
+// with policy p when it falls behind, and returns it with its unsubscribe
+// func.
+func (h *Hub) Subscribe(id string, f Filter, p Policy) (*Subscription, func()) {
+	// A repeated type would deliver every event of that type twice.
+	types := slices.Compact(slices.Sorted(slices.Values(f.EventTypes)))
+	if len(types) == 0 {
+		types = []gatewayv1.EventType{gatewayv1.EventType_EVENT_TYPE_UNSPECIFIED}
+	}
+	if p == PolicyDefault {
+		p = h.policy
+	}
+	sub := &Subscription{
+		ID:       id,
+		types:    types,
+		policy:   p,
+		size:     h.bufferSize,
+		ready:    make(chan struct{}, 1),
+		pending:  make(map[string]*entry),
+		overflow: make(chan struct{}),
+		projects: make(map[string]bool),
+	}
+
+	switch {
+	case f.AllProjects:
+		h.Extend(sub, allProjects)
+	default:
+		for _, p := range f.ProjectIDs {
+			h.Extend(sub, p)
+		}
+		if f.Global {
+			h.Extend(sub, "")
+		}
+	}
 
 	unsub := func() {
-		h.mu.Lock()
-		delete(h.subs, id)
-		close(ch)
-		h.mu.Unlock()
+		sub.mu.Lock()
+		defer sub.mu.Unlock()
+		if sub.closed {
+			return
+		}
+		sub.closed = true
+		for _, k := range sub.keys {
+			h.remove(k, sub)
+		}
 	}
-	return ch, unsub
+	return sub, unsub
 }
 
-// Publish fans out payload to all current subscribers.
-func (h *Hub) Publish(_ context.Context, payload []byte) {
-	h.mu.RLock()
-	defer h.mu.RUnlock()
-	for id, ch := range h.subs {
-		select {
-		case ch <- payload:
-		default:
-			log.Warn().Str("subscriber_id", id).Msg("hub: subscriber buffer full, dropping event")
+// Extend adds projectID to the projects sub receives events of, keeping its
+// event types. It does nothing once sub is unsubscribed or if it already
+// follows the project.
+func (h *Hub) Extend(sub *Subscription, projectID string) {
+	sub.mu.Lock()
+	defer sub.mu.Unlock()
+	if sub.closed || sub.projects[projectID] {
+		return
+	}
+	sub.projects[projectID] = true
+	for _, t := range sub.types {
+		k := key{project: projectID, typ: t}
+		sub.keys = append(sub.keys, k)
+		h.add(k, sub)
+	}
+}
+
+// Matches reports whether event is one sub receives, for delivering events
+// that do not pass through the hub.
+func (sub *Subscription) Matches(event *gatewayv1.Event) bool {
+	sub.mu.Lock()
+	defer sub.mu.Unlock()
+	if !sub.projects[allProjects] && !sub.projects[event.GetProjectId()] {
+		return false
+	}
+	return sub.types[0] == gatewayv1.EventType_EVENT_TYPE_UNSPECIFIED || slices.Contains(sub.types, event.GetType())
+}
+
+// Publish queues event for every matching subscriber.
+func (h *Hub) Publish(_ context.Context, event *gatewayv1.Event) {
+	projects := []string{allProjects, event.GetProjectId()}
+	types := []gatewayv1.EventType{gatewayv1.EventType_EVENT_TYPE_UNSPECIFIED}
+	if t := event.GetType(); t != gatewayv1.EventType_EVENT_TYPE_UNSPECIFIED {
+		types = append(types, t)
+	}
+	// Only coalescing subscribers need the key, and it takes decoding the
+	// payload, so it is worked out on first use.
+	ck := sync.OnceValue(func() string { return coalesceKey(event) })
+	for _, p := range projects {
+		for _, t := range types {
+			h.deliver(key{project: p, typ: t}, event, ck)
 		}
 	}
 }
+
+func (h *Hub) deliver(k key, event *gatewayv1.Event, coalesceKey func() string) {
+	v, ok := h.buckets.Load(k)
+	if !ok {
+		return
+	}
+	subs := v.(*bucket).subs.Load()
+	if subs == nil {
+		return
+	}
+	for _, s := range *subs {
+		s.push(event, coalesceKey)
+	}
+}
+
+func (h *Hub) add(k key, s *Subscription) {
+	for {
+		v, _ := h.buckets.LoadOrStore(k, &bucket{})
+		b := v.(*bucket)
+		b.mu.Lock()
+		if b.dead {
+			// Emptied and removed between the load and the lock.
+			b.mu.Unlock()
+			continue
+		}
+		var next []*Subscription
+		if cur := b.subs.Load(); cur != nil {
+			next = append(next, *cur...)
+		}
+		next = append(next, s)
+		b.subs.Store(&next)
+		b.mu.Unlock()
+		return
+	}
+}
+
+func (h *Hub) remove(k key, s *Subscription) {
+	v, ok := h.buckets.Load(k)
+	if !ok {
+		return
+	}
+	b := v.(*bucket)
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	cur := b.subs.Load()
+	if cur == nil {
+		return
+	}
+	next := make([]*Subscription, 0, len(*cur))
+	for _, other := range *cur {
+		if other != s {
+			next = append(next, other)
+		}
+	}
+	if len(next) == 0 {
+		b.dead = true
+		h.buckets.Delete(k)
+	}
+	b.subs.Store(&next)
+}
@@ -0,0 +1,148 @@
+package hub
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	gatewayv1 "github.com/ApeironFoundation/axle/contracts/go/gateway/v1"
+)
+
+const (
+	taskUpdated = gatewayv1.EventType_EVENT_TYPE_TASK_UPDATED
+	memberAdded = gatewayv1.EventType_EVENT_TYPE_PROJECT_MEMBER_ADDED
+	userUpdated = gatewayv1.EventType_EVENT_TYPE_USER_UPDATED
+	untyped     = gatewayv1.EventType_EVENT_TYPE_UNSPECIFIED
+)
+
+func testEvent(seq uint64, project string, typ gatewayv1.EventType) *gatewayv1.Event {
+	return &gatewayv1.Event{Sequence: seq, ProjectId: project, Type: typ}
+}
+
+func sequences(events []*gatewayv1.Event) []uint64 {
+	seqs := make([]uint64, len(events))
+	for i, e := range events {
+		seqs[i] = e.GetSequence()
+	}
+	return seqs
+}
+
+func TestPublishRoutes(t *testing.T) {
+	tests := []struct {
+		name   string
+		filter Filter
+		event  *gatewayv1.Event
+		want   bool
+	}{
+		{"followed project", Filter{ProjectIDs: []string{"p1"}}, testEvent(1, "p1", taskUpdated), true},
+		{"other project", Filter{ProjectIDs: []string{"p1"}}, testEvent(1, "p2", taskUpdated), false},
+		{"no projects matches nothing", Filter{}, testEvent(1, "p1", taskUpdated), false},
+		{"project-less event without Global", Filter{ProjectIDs: []string{"p1"}}, testEvent(1, "", userUpdated), false},
+		{"project-less event with Global", Filter{ProjectIDs: []string{"p1"}, Global: true}, testEvent(1, "", userUpdated), true},
+		{"all projects", Filter{AllProjects: true}, testEvent(1, "p9", taskUpdated), true},
+		{"all projects includes project-less", Filter{AllProjects: true}, testEvent(1, "", userUpdated), true},
+		{"matching type", Filter{ProjectIDs: []string{"p1"}, EventTypes: []gatewayv1.EventType{memberAdded}}, testEvent(1, "p1", memberAdded), true},
+		{"other type", Filter{ProjectIDs: []string{"p1"}, EventTypes: []gatewayv1.EventType{memberAdded}}, testEvent(1, "p1", taskUpdated), false},
+		{"untyped event with type filter", Filter{AllProjects: true, EventTypes: []gatewayv1.EventType{memberAdded}}, testEvent(1, "p1", untyped), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := New(Options{})
+			sub, unsub := h.Subscribe("s", tt.filter, PolicyDefault)
+			defer unsub()
+
+			h.Publish(context.Background(), tt.event)
+			got := len(sub.Drain()) == 1
+			if got != tt.want {
+				t.Errorf("delivered = %v, want %v", got, tt.want)
+			}
+			if got != sub.Matches(tt.event) {
+				t.Errorf("Matches = %v disagrees with routing", !got)
+			}
+		})
+	}
+}
+
+func TestPublishDeliversOnce(t *testing.T) {
+	h := New(Options{})
+	// Repeated projects and types, and extending to a project already
+	// followed, must not duplicate deliveries.
+	sub, unsub := h.Subscribe("s", Filter{
+		ProjectIDs: []string{"p1", "p1"},
+		EventTypes: []gatewayv1.EventType{taskUpdated, taskUpdated},
+	}, PolicyDefault)
+	defer unsub()
+	h.Extend(sub, "p1")
+
+	h.Publish(context.Background(), testEvent(1, "p1", taskUpdated))
+	if got := sequences(sub.Drain()); len(got) != 1 {
+		t.Errorf("delivered %v, want the event once", got)
+	}
+}
+
+func TestExtendAndUnsubscribe(t *testing.T) {
+	h := New(Options{})
+	sub, unsub := h.Subscribe("s", Filter{ProjectIDs: []string{"p1"}}, PolicyDefault)
+	other, unsubOther := h.Subscribe("o", Filter{ProjectIDs: []string{"p1"}}, PolicyDefault)
+	defer unsubOther()
+
+	h.Publish(context.Background(), testEvent(1, "p2", taskUpdated))
+	h.Extend(sub, "p2")
+	h.Publish(context.Background(), testEvent(2, "p2", taskUpdated))
+	if got := sequences(sub.Drain()); len(got) != 1 || got[0] != 2 {
+		t.Errorf("after Extend got %v, want only [2]", got)
+	}
+
+	unsub()
+	unsub()             // idempotent
+	h.Extend(sub, "p3") // ignored once unsubscribed
+	h.Publish(context.Background(), testEvent(3, "p1", taskUpdated))
+	h.Publish(context.Background(), testEvent(4, "p3", taskUpdated))
+	if got := sub.Drain(); len(got) != 0 {
+		t.Errorf("unsubscribed subscriber got %v", sequences(got))
+	}
+	if got := sequences(other.Drain()); len(got) != 1 || got[0] != 3 {
+		t.Errorf("remaining subscriber got %v, want [3]", got)
+	}
+
+	unsubOther()
+	empty := true
+	h.buckets.Range(func(any, any) bool { empty = false; return false })
+	if !empty {
+		t.Error("buckets left behind after every subscriber left")
+	}
+}
+
+// Subscribers come and go while events are published: the ones that stay
+// see every event, and Publish never blocks on the churn.
+func TestPublishDuringChurn(t *testing.T) {
+	h := New(Options{BufferSize: 1000})
+	stay, unsub := h.Subscribe("stay", Filter{ProjectIDs: []string{"p1"}}, PolicyDefault)
+	defer unsub()
+
+	var wg sync.WaitGroup
+	done := make(chan struct{})
+	for range 4 {
+		wg.Go(func() {
+			for {
+				select {
+				case <-done:
+					return
+				default:
+				}
+				_, u := h.Subscribe("churn", Filter{ProjectIDs: []string{"p1"}}, PolicyDropOldest)
+				u()
+			}
+		})
+	}
+	const n = 500
+	for i := range n {
+		h.Publish(context.Background(), testEvent(uint64(i+1), "p1", taskUpdated))
+	}
+	close(done)
+	wg.Wait()
+
+	if got := len(stay.Drain()); got != n {
+		t.Errorf("steady subscriber got %d events, want %d", got, n)
+	}
+}
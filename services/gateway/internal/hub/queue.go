@@ -0,0 +1,178 @@
+package hub
+
+import (
+	"expvar"
+	"fmt"
+	"slices"
+
+	"google.golang.org/protobuf/proto"
+
+	aiv1 "github.com/ApeironFoundation/axle/contracts/go/ai/v1"
+	bffv1 "github.com/ApeironFoundation/axle/contracts/go/bff/v1"
+	gatewayv1 "github.com/ApeironFoundation/axle/contracts/go/gateway/v1"
+)
+
+// stats counts "dropped" and "coalesced" events and slow subscribers
+// "disconnected"; they are served with the other expvars on /debug/vars.
+var stats = expvar.NewMap("gateway_hub")
+
+// Policy decides what happens to a subscriber whose buffer is full.
+type Policy int
+
+const (
+	// PolicyDefault applies the hub's policy.
+	PolicyDefault Policy = iota
+	// PolicyDisconnect stops queueing for the subscriber and signals
+	// Overflowed, so it can be told where to resume from.
+	PolicyDisconnect
+	// PolicyDropOldest discards the oldest queued event.
+	PolicyDropOldest
+	// PolicyCoalesce drops a queued event once a later one about the same
+	// entity is queued, whether or not the buffer is full, and disconnects as
+	// PolicyDisconnect does once the buffer fills regardless.
+	PolicyCoalesce
+)
+
+// ParsePolicy parses "disconnect", "drop_oldest" or "coalesce".
+func ParsePolicy(s string) (Policy, error) {
+	switch s {
+	case "disconnect":
+		return PolicyDisconnect, nil
+	case "drop_oldest":
+		return PolicyDropOldest, nil
+	case "coalesce":
+		return PolicyCoalesce, nil
+	}
+	return PolicyDefault, fmt.Errorf("unknown slow consumer policy %q", s)
+}
+
+// entry is a queued event. key is its coalescing key, empty if it has none.
+type entry struct {
+	event *gatewayv1.Event
+	key   string
+}
+
+// push queues event for sub according to its policy. coalesceKey returns the
+// event's coalescing key; it is only called under PolicyCoalesce.
+func (sub *Subscription) push(event *gatewayv1.Event, coalesceKey func() string) {
+	sub.qmu.Lock()
+	defer sub.qmu.Unlock()
+	if sub.overflowed {
+		// Disconnecting; the subscriber resumes from before this event.
+		sub.dropped.Add(1)
+		return
+	}
+	var key string
+	if sub.policy == PolicyCoalesce {
+		key = coalesceKey()
+	}
+	if key != "" {
+		if e, ok := sub.pending[key]; ok {
+			// Requeue at the back, so the queue stays in sequence order.
+			sub.queue = slices.DeleteFunc(sub.queue, func(other *entry) bool { return other == e })
+			stats.Add("coalesced", 1)
+		}
+	}
+	if len(sub.queue) >= sub.size {
+		switch sub.policy {
+		case PolicyDropOldest:
+			sub.queue[0] = nil
+			sub.queue = sub.queue[1:]
+			sub.dropped.Add(1)
+			stats.Add("dropped", 1)
+		default:
+			sub.overflowed = true
+			close(sub.overflow)
+			sub.dropped.Add(1)
+			stats.Add("disconnected", 1)
+			return
+		}
+	}
+	e := &entry{event: event, key: key}
+	sub.queue = append(sub.queue, e)
+	if key != "" {
+		sub.pending[key] = e
+	}
+	select {
+	case sub.ready <- struct{}{}:
+	default:
+	}
+}
+
+// Ready is signalled when events are queued; Drain them once it is.
+func (sub *Subscription) Ready() <-chan struct{} {
+	return sub.ready
+}
+
+// Drain removes and returns the queued events, oldest first.
+func (sub *Subscription) Drain() []*gatewayv1.Event {
+	sub.qmu.Lock()
+	defer sub.qmu.Unlock()
+	events := make([]*gatewayv1.Event, len(sub.queue))
+	for i, e := range sub.queue {
+		events[i] = e.event
+	}
+	sub.queue = nil
+	clear(sub.pending)
+	return events
+}
+
+// Overflowed is closed once the subscriber's buffer overflowed under a policy
+// that disconnects it. Events published since then were not queued.
+func (sub *Subscription) Overflowed() <-chan struct{} {
+	sub.qmu.Lock()
+	defer sub.qmu.Unlock()
+	return sub.overflow
+}
+
+// Clear empties the queue and rearms Overflowed, for a subscriber that has
+// caught up on everything published so far by other means.
+func (sub *Subscription) Clear() {
+	sub.qmu.Lock()
+	defer sub.qmu.Unlock()
+	sub.queue = nil
+	clear(sub.pending)
+	if sub.overflowed {
+		sub.overflowed = false
+		sub.overflow = make(chan struct{})
+	}
+}
+
+// Dropped returns how many events sub has not been queued because its buffer
+// was full.
+func (sub *Subscription) Dropped() uint64 {
+	return sub.dropped.Load()
+}
+
+// coalesceKey returns the key under which a queued event may be replaced by a
+// later one: its type and the entity whose full state it carries. Events that
+// are not such snapshots have no key.
+func coalesceKey(event *gatewayv1.Event) string {
+	var id string
+	switch event.GetType() {
+	case gatewayv1.EventType_EVENT_TYPE_TASK_UPDATED:
+		var t aiv1.AITask
+		if proto.Unmarshal(event.GetPayload(), &t) == nil {
+			id = t.GetId()
+		}
+	case gatewayv1.EventType_EVENT_TYPE_PROJECT_UPDATED:
+		var p bffv1.Project
+		if proto.Unmarshal(event.GetPayload(), &p) == nil {
+			id = p.GetId()
+		}
+	case gatewayv1.EventType_EVENT_TYPE_PROJECT_MEMBER_UPDATED:
+		var m bffv1.ProjectMember
+		if proto.Unmarshal(event.GetPayload(), &m) == nil && m.GetUserId() != "" {
+			id = m.GetProjectId() + "/" + m.GetUserId()
+		}
+	case gatewayv1.EventType_EVENT_TYPE_USER_UPDATED:
+		var u bffv1.User
+		if proto.Unmarshal(event.GetPayload(), &u) == nil {
+			id = u.GetId()
+		}
+	}
+	if id == "" {
+		return ""
+	}
+	return event.GetType().String() + "/" + id
+}
@@ -0,0 +1,111 @@
+package hub
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	aiv1 "github.com/ApeironFoundation/axle/contracts/go/ai/v1"
+	gatewayv1 "github.com/ApeironFoundation/axle/contracts/go/gateway/v1"
+)
+
+// taskEvent is a TASK_UPDATED snapshot of task id.
+func taskEvent(seq uint64, id string) *gatewayv1.Event {
+	payload, _ := proto.Marshal(&aiv1.AITask{Id: id})
+	e := testEvent(seq, "p1", taskUpdated)
+	e.Payload = payload
+	return e
+}
+
+func TestSlowConsumerPolicies(t *testing.T) {
+	tests := []struct {
+		name       string
+		policy     Policy
+		events     []*gatewayv1.Event
+		queued     []uint64
+		dropped    uint64
+		overflowed bool
+	}{
+		{"disconnect within buffer", PolicyDisconnect,
+			[]*gatewayv1.Event{taskEvent(1, "a"), taskEvent(2, "a")}, []uint64{1, 2}, 0, false},
+		{"disconnect on overflow", PolicyDisconnect,
+			[]*gatewayv1.Event{taskEvent(1, "a"), taskEvent(2, "b"), taskEvent(3, "c"), taskEvent(4, "d")}, []uint64{1, 2}, 2, true},
+		{"drop oldest", PolicyDropOldest,
+			[]*gatewayv1.Event{taskEvent(1, "a"), taskEvent(2, "b"), taskEvent(3, "c"), taskEvent(4, "d")}, []uint64{3, 4}, 2, false},
+		{"coalesce keeps the latest per entity", PolicyCoalesce,
+			[]*gatewayv1.Event{taskEvent(1, "a"), taskEvent(2, "b"), taskEvent(3, "a"), taskEvent(4, "a")}, []uint64{2, 4}, 0, false},
+		{"coalesce disconnects when entities differ", PolicyCoalesce,
+			[]*gatewayv1.Event{taskEvent(1, "a"), taskEvent(2, "b"), taskEvent(3, "c")}, []uint64{1, 2}, 1, true},
+		{"coalesce leaves keyless events alone", PolicyCoalesce,
+			[]*gatewayv1.Event{testEvent(1, "p1", memberAdded), testEvent(2, "p1", memberAdded), testEvent(3, "p1", memberAdded)}, []uint64{1, 2}, 1, true},
+		{"coalesce ignores undecodable payloads", PolicyCoalesce,
+			[]*gatewayv1.Event{{Sequence: 1, Type: taskUpdated, Payload: []byte{0xff}}, {Sequence: 2, Type: taskUpdated, Payload: []byte{0xff}}}, []uint64{1, 2}, 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := New(Options{BufferSize: 2})
+			sub, unsub := h.Subscribe("s", Filter{AllProjects: true}, tt.policy)
+			defer unsub()
+			for _, e := range tt.events {
+				h.Publish(context.Background(), e)
+			}
+
+			overflowed := false
+			select {
+			case <-sub.Overflowed():
+				overflowed = true
+			default:
+			}
+			if overflowed != tt.overflowed {
+				t.Errorf("overflowed = %v, want %v", overflowed, tt.overflowed)
+			}
+			if got := sub.Dropped(); got != tt.dropped {
+				t.Errorf("dropped %d, want %d", got, tt.dropped)
+			}
+			if got := sequences(sub.Drain()); !slices.Equal(got, tt.queued) {
+				t.Errorf("queued %v, want %v", got, tt.queued)
+			}
+		})
+	}
+}
+
+func TestClearRearmsOverflow(t *testing.T) {
+	h := New(Options{BufferSize: 1})
+	sub, unsub := h.Subscribe("s", Filter{AllProjects: true}, PolicyDisconnect)
+	defer unsub()
+	h.Publish(context.Background(), taskEvent(1, "a"))
+	h.Publish(context.Background(), taskEvent(2, "b"))
+	<-sub.Overflowed()
+
+	sub.Clear()
+	select {
+	case <-sub.Overflowed():
+		t.Fatal("still overflowed after Clear")
+	default:
+	}
+	h.Publish(context.Background(), taskEvent(3, "c"))
+	if got := sequences(sub.Drain()); !slices.Equal(got, []uint64{3}) {
+		t.Errorf("queued %v after Clear, want [3]", got)
+	}
+}
+
+func TestCoalesceKeyIsLazy(t *testing.T) {
+	tests := []struct {
+		policy Policy
+		calls  int
+	}{
+		{PolicyDisconnect, 0},
+		{PolicyDropOldest, 0},
+		{PolicyCoalesce, 1},
+	}
+	for _, tt := range tests {
+		sub, _ := New(Options{}).Subscribe("s", Filter{}, tt.policy)
+		calls := 0
+		sub.push(taskEvent(1, "a"), func() string { calls++; return "" })
+		if calls != tt.calls {
+			t.Errorf("policy %d: key computed %d times, want %d", tt.policy, calls, tt.calls)
+		}
+	}
+}
@@ -0,0 +1,29 @@
+package interceptor
+
+import (
+	"context"
+	"net/http"
+
+	"connectrpc.com/connect"
+	"github.com/rs/zerolog/log"
+
+	"github.com/ApeironFoundation/axle/gateway/internal/access"
+	shared "github.com/ApeironFoundation/axle/shared/interceptor"
+)
+
+// Auth resolves the caller of every call from its Authorization header and
+// stores their grant in the context (see access.GrantFromContext), stamped
+// with the revision of w it was resolved at. Handler logs are tagged with
+// the caller's user ID.
+func Auth(a access.Authorizer, w *access.Watcher) connect.Interceptor {
+	return shared.Auth(func(ctx context.Context, header http.Header) (context.Context, error) {
+		rev := w.Revision()
+		g, err := a.Resolve(ctx, header.Get("Authorization"))
+		if err != nil {
+			return ctx, err
+		}
+		g.Revision = rev
+		ctx = log.Ctx(ctx).With().Str("user_id", g.UserID).Logger().WithContext(ctx)
+		return access.WithGrant(ctx, g), nil
+	})
+}
@@ -0,0 +1,17 @@
+// Package interceptor holds the ConnectRPC interceptor chain that every
+// Gateway service handler is mounted with.
+package interceptor
+
+import (
+	"connectrpc.com/connect"
+
+	"github.com/ApeironFoundation/axle/gateway/internal/access"
+	shared "github.com/ApeironFoundation/axle/shared/interceptor"
+)
+
+// HandlerOptions returns the options to pass to each New*ServiceHandler:
+// logging, panic recovery, authentication with a and w and request
+// validation, outermost first.
+func HandlerOptions(a access.Authorizer, w *access.Watcher) connect.HandlerOption {
+	return connect.WithInterceptors(shared.Logging(), shared.Recover(), Auth(a, w), shared.Validate())
+}
@@ -2,12 +2,17 @@ package streaming
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
 
 	"connectrpc.com/connect"
 	"github.com/rs/zerolog/log"
-	"google.golang.org/protobuf/encoding/protojson"
-	"google.golang.org/protobuf/proto"
 
+	"github.com/ApeironFoundation/axle/gateway/internal/access"
+	"github.com/ApeironFoundation/axle/gateway/internal/eventlog"
 	"github.com/ApeironFoundation/axle/gateway/internal/hub"
 
 	gatewayv1 "github.com/ApeironFoundation/axle/contracts/go/gateway/v1"
@@ -15,60 +20,218 @@ import (
 	"github.com/google/uuid"
 )
 
+// resumeAfterKey is the error metadata telling a disconnected slow subscriber
+// where to resume.
+const resumeAfterKey = "Axle-Resume-After"
+
+// policies maps the requested slow-consumer policy to the hub's.
+var policies = map[gatewayv1.SlowConsumerPolicy]hub.Policy{
+	gatewayv1.SlowConsumerPolicy_SLOW_CONSUMER_POLICY_UNSPECIFIED: hub.PolicyDefault,
+	gatewayv1.SlowConsumerPolicy_SLOW_CONSUMER_POLICY_DISCONNECT:  hub.PolicyDisconnect,
+	gatewayv1.SlowConsumerPolicy_SLOW_CONSUMER_POLICY_DROP_OLDEST: hub.PolicyDropOldest,
+	gatewayv1.SlowConsumerPolicy_SLOW_CONSUMER_POLICY_COALESCE:    hub.PolicyCoalesce,
+}
+
 // Compile-time interface check.
 var _ gen_gateway_v1connect.StreamingServiceHandler = (*Handler)(nil)
 
 // Handler implements the gateway.v1.StreamingService ConnectRPC handler.
 type Handler struct {
-	hub *hub.Hub
+	hub     *hub.Hub
+	events  *eventlog.Log
+	watcher *access.Watcher
 }
 
-// NewHandler returns a StreamingService handler backed by the given Hub.
-func NewHandler(h *hub.Hub) *Handler {
-	return &Handler{hub: h}
+// NewHandler returns a StreamingService handler backed by the given Hub, which
+// replays missed events from l. Each subscriber sees what the grant the
+// interceptor chain resolved allows, and is kept within it by w.
+func NewHandler(h *hub.Hub, l *eventlog.Log, w *access.Watcher) *Handler {
+	return &Handler{hub: h, events: l, watcher: w}
 }
 
 // Subscribe implements the server-streaming RPC.
-// It subscribes the caller to the hub and streams events until the client
-// disconnects or the server shuts down.
+// It subscribes the caller to the hub with the request's project and event
+// type filters and streams matching events until the client disconnects or
+// the server shuts down. With resume_after set, the events logged after it
+// are replayed first. Callers only receive events of projects they can see:
+// asking for any other project is refused, and no project filter means all
+// of theirs. The stream ends with CodePermissionDenied once the caller loses
+// access to a project it follows, with CodeAborted when its access changes
+// while the stream is being set up, and with CodeResourceExhausted and the
+// sequence to resume after when its slow_consumer_policy disconnects it.
 func (h *Handler) Subscribe(
 	ctx context.Context,
 	req *gatewayv1.SubscribeRequest,
 	stream *connect.ServerStream[gatewayv1.Event],
 ) error {
+	grant, ok := access.GrantFromContext(ctx)
+	if !ok {
+		return connect.NewError(connect.CodeUnauthenticated, errors.New("authentication required"))
+	}
+	for _, p := range req.GetProjectIds() {
+		if !grant.CanSee(p) {
+			return connect.NewError(connect.CodePermissionDenied, fmt.Errorf("no access to project %s", p))
+		}
+	}
+
+	// Without project filters a subscriber follows everything it may see:
+	// every project for admins, otherwise its own projects plus the events
+	// that belong to none.
+	filter := hub.Filter{ProjectIDs: req.GetProjectIds(), EventTypes: req.GetEventTypes()}
+	all := len(filter.ProjectIDs) == 0
+	if all {
+		if grant.Admin {
+			filter.AllProjects = true
+		} else {
+			filter.ProjectIDs = slices.Collect(maps.Keys(grant.Projects))
+			filter.Global = true
+		}
+	}
+
+	ctx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+
 	id := uuid.New().String()
-	projectIDs := req.GetProjectIds() // repeated string
-	ch, unsub := h.hub.Subscribe(id)
+	sub, unsub := h.hub.Subscribe(id, filter, policies[req.GetSlowConsumerPolicy()])
 	defer unsub()
+	unwatch, err := h.watcher.Watch(grant, filter.ProjectIDs, all, cancel, func(projectID string) {
+		h.hub.Extend(sub, projectID)
+	})
+	if err != nil {
+		return connect.NewError(connect.CodeAborted, err)
+	}
+	defer unwatch()
 
+	types := make([]string, 0, len(req.GetEventTypes()))
+	for _, t := range req.GetEventTypes() {
+		types = append(types, t.String())
+	}
 	log.Ctx(ctx).Info().
 		Str("subscriber_id", id).
-		Strs("project_ids", projectIDs).
+		Strs("project_ids", req.GetProjectIds()).
+		Strs("event_types", types).
 		Msg("streaming: client connected")
 
+	// Replay what the client missed before going live.
+	var sent uint64
+	if after := req.GetResumeAfter(); after > 0 {
+		if sent, err = h.catchUp(ctx, id, sub, stream, after); err != nil {
+			return err
+		}
+		log.Ctx(ctx).Info().Str("subscriber_id", id).Uint64("from", after).Uint64("to", sent).Msg("streaming: replayed missed events")
+	}
+
 	for {
 		select {
 		case <-ctx.Done():
-			log.Ctx(ctx).Info().Str("subscriber_id", id).Msg("streaming: client disconnected")
-			return nil
-		case payload, ok := <-ch:
-			if !ok {
-				return nil
+			return h.ended(ctx, id)
+		case <-sub.Overflowed():
+			resume := h.resumePoint(ctx, sent, sub.Drain())
+			if resume == 0 {
+				// The first event the log ever stored is unseen, and a
+				// resume_after of 0 would skip the replay, so catch up here.
+				sub.Clear()
+				if sent, err = h.catchUp(ctx, id, sub, stream, 0); err != nil {
+					return err
+				}
+				continue
 			}
-			// Attempt to unmarshal raw NATS payload as a protobuf Event message.
-			// NATS publishers must serialize events with proto.Marshal or protojson.
-			var event gatewayv1.Event
-			if err := proto.Unmarshal(payload, &event); err != nil {
-				// Fallback: try JSON (handy for ad-hoc pub from curl / tests).
-				if jsonErr := protojson.Unmarshal(payload, &event); jsonErr != nil {
-					log.Ctx(ctx).Warn().Err(err).Msg("streaming: dropping unparseable event")
-					continue
+			log.Ctx(ctx).Warn().Str("subscriber_id", id).Uint64("resume_after", resume).
+				Msg("streaming: disconnecting slow subscriber")
+			err := connect.NewError(connect.CodeResourceExhausted,
+				errors.New("subscriber fell behind; resubscribe with resume_after from Axle-Resume-After"))
+			err.Meta().Set(resumeAfterKey, strconv.FormatUint(resume, 10))
+			return err
+		case <-sub.Ready():
+			for _, event := range sub.Drain() {
+				if ctx.Err() != nil {
+					// Revoked by one of these events; report that instead.
+					break
+				}
+				if event.GetSequence() <= sent {
+					continue // already replayed
 				}
+				if err := stream.Send(event); err != nil {
+					log.Ctx(ctx).Error().Err(err).Msg("streaming: send failed")
+					return err
+				}
+				sent = event.GetSequence()
 			}
-			if err := stream.Send(&event); err != nil {
-				log.Ctx(ctx).Error().Err(err).Msg("streaming: send failed")
-				return err
+		}
+	}
+}
+
+// catchUp sends sub the matching events logged after sequence after, and
+// returns the sequence it caught up to. Live events queue up on sub
+// meanwhile; if some were dropped for lack of room, it replays again up to
+// them. Its errors are ready to return from Subscribe.
+func (h *Handler) catchUp(
+	ctx context.Context,
+	id string,
+	sub *hub.Subscription,
+	stream *connect.ServerStream[gatewayv1.Event],
+	after uint64,
+) (uint64, error) {
+	last := after
+	for {
+		dropped := sub.Dropped()
+		var (
+			sendErr error
+			err     error
+		)
+		last, err = h.events.Replay(ctx, last, func(event *gatewayv1.Event) error {
+			if !sub.Matches(event) {
+				return nil
 			}
+			sendErr = stream.Send(event)
+			return sendErr
+		})
+		switch {
+		case errors.Is(err, eventlog.ErrResumeExpired):
+			return last, connect.NewError(connect.CodeOutOfRange, fmt.Errorf("resume_after %d: %w", after, err))
+		case ctx.Err() != nil:
+			return last, h.ended(ctx, id)
+		case sendErr != nil:
+			log.Ctx(ctx).Error().Err(sendErr).Msg("streaming: send failed")
+			return last, sendErr
+		case err != nil:
+			log.Ctx(ctx).Error().Err(err).Str("subscriber_id", id).Msg("streaming: replay failed")
+			return last, connect.NewError(connect.CodeUnavailable, errors.New("cannot replay events right now"))
 		}
+		if sub.Dropped() == dropped {
+			return last, nil
+		}
+		// Everything queued or dropped so far is in the log after last.
+		sub.Clear()
+	}
+}
+
+// resumePoint returns the sequence a disconnected slow subscriber resumes
+// after: the last one it was sent, or else the one before the oldest it has
+// not seen, taken from its pending events or, with none, the log. It is 0
+// when that oldest event is the first the log ever stored, or the log cannot
+// be read.
+func (h *Handler) resumePoint(ctx context.Context, sent uint64, pending []*gatewayv1.Event) uint64 {
+	if sent > 0 {
+		return sent
+	}
+	if len(pending) > 0 {
+		return pending[0].GetSequence() - 1
+	}
+	first, err := h.events.FirstSeq(ctx)
+	if err != nil || first == 0 {
+		return 0
+	}
+	return first - 1
+}
+
+// ended returns what Subscribe returns once ctx is done: an error when the
+// subscriber lost access, otherwise nil.
+func (h *Handler) ended(ctx context.Context, id string) error {
+	if cause := context.Cause(ctx); errors.Is(cause, access.ErrMembershipRemoved) || errors.Is(cause, access.ErrAccountChanged) {
+		log.Ctx(ctx).Info().Str("subscriber_id", id).Err(cause).Msg("streaming: access revoked")
+		return connect.NewError(connect.CodePermissionDenied, cause)
 	}
+	log.Ctx(ctx).Info().Str("subscriber_id", id).Msg("streaming: client disconnected")
+	return nil
 }
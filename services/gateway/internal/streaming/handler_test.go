@@ -0,0 +1,94 @@
+package streaming
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/nats-io/nats.go/jetstream"
+
+	"github.com/ApeironFoundation/axle/gateway/internal/access"
+	"github.com/ApeironFoundation/axle/gateway/internal/eventlog"
+	"github.com/ApeironFoundation/axle/gateway/internal/hub"
+
+	gatewayv1 "github.com/ApeironFoundation/axle/contracts/go/gateway/v1"
+)
+
+// fakeJS serves a stream with a fixed state; anything else it is asked for
+// panics on the nil embedded interface.
+type fakeJS struct {
+	jetstream.JetStream
+	state jetstream.StreamState
+}
+
+func (f fakeJS) Stream(context.Context, string) (jetstream.Stream, error) {
+	return fakeStream{state: f.state}, nil
+}
+
+type fakeStream struct {
+	jetstream.Stream
+	state jetstream.StreamState
+}
+
+func (s fakeStream) CachedInfo() *jetstream.StreamInfo {
+	return &jetstream.StreamInfo{State: s.state}
+}
+
+func newTestHandler(state jetstream.StreamState) *Handler {
+	return NewHandler(hub.New(hub.Options{}), &eventlog.Log{JS: fakeJS{state: state}}, access.NewWatcher())
+}
+
+func TestCatchUpBounds(t *testing.T) {
+	// Events 1..10 aged out of the stream.
+	h := newTestHandler(jetstream.StreamState{FirstSeq: 11, LastSeq: 20})
+	tests := []struct {
+		name  string
+		after uint64
+		code  connect.Code
+	}{
+		{"past MaxAge", 5, connect.CodeOutOfRange},
+		{"never given", 21, connect.CodeOutOfRange},
+		{"caught up", 20, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sub, unsub := h.hub.Subscribe("s", hub.Filter{AllProjects: true}, hub.PolicyDefault)
+			defer unsub()
+			last, err := h.catchUp(t.Context(), "s", sub, nil, tt.after)
+			if tt.code == 0 {
+				if err != nil || last != tt.after {
+					t.Errorf("catchUp = %d, %v; want %d, nil", last, err, tt.after)
+				}
+				return
+			}
+			if connect.CodeOf(err) != tt.code {
+				t.Errorf("err = %v, want %v", err, tt.code)
+			}
+		})
+	}
+}
+
+func TestResumePoint(t *testing.T) {
+	pending := []*gatewayv1.Event{{Sequence: 42}, {Sequence: 43}}
+	tests := []struct {
+		name    string
+		state   jetstream.StreamState
+		sent    uint64
+		pending []*gatewayv1.Event
+		want    uint64
+	}{
+		{"after the last sent", jetstream.StreamState{FirstSeq: 1, LastSeq: 50}, 41, pending, 41},
+		{"before the oldest pending", jetstream.StreamState{FirstSeq: 1, LastSeq: 50}, 0, pending, 41},
+		{"before the stream's oldest", jetstream.StreamState{FirstSeq: 30, LastSeq: 50}, 0, nil, 29},
+		{"first event ever pending", jetstream.StreamState{FirstSeq: 1, LastSeq: 50}, 0, []*gatewayv1.Event{{Sequence: 1}}, 0},
+		{"stream from its start", jetstream.StreamState{FirstSeq: 1, LastSeq: 50}, 0, nil, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := newTestHandler(tt.state)
+			if got := h.resumePoint(t.Context(), tt.sent, tt.pending); got != tt.want {
+				t.Errorf("resumePoint = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
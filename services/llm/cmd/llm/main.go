@@ -18,6 +18,7 @@ import (
 	"golang.org/x/net/http2/h2c"
 
 	"github.com/ApeironFoundation/axle/contracts/go/ai/v1/gen_ai_v1connect"
+	"github.com/ApeironFoundation/axle/llm/internal/auth"
 	"github.com/ApeironFoundation/axle/llm/internal/bifrostclient"
 	"github.com/ApeironFoundation/axle/llm/internal/config"
 	"github.com/ApeironFoundation/axle/llm/internal/db"
@@ -25,6 +26,7 @@ import (
 	"github.com/ApeironFoundation/axle/llm/internal/handler"
 	"github.com/ApeironFoundation/axle/llm/internal/handler/nats"
 	"github.com/ApeironFoundation/axle/llm/internal/health"
+	"github.com/ApeironFoundation/axle/llm/internal/interceptor"
 	"github.com/ApeironFoundation/axle/llm/internal/natsclient"
 )
 
@@ -32,6 +34,7 @@ func main() {
 	// ── Structured logging ───────────────────────────────────────────────────
 	log.Logger = log.Output(zerolog.ConsoleWriter{Out: os.Stderr, TimeFormat: time.RFC3339})
 	zerolog.TimeFieldFormat = zerolog.TimeFormatUnix
+	zerolog.DefaultContextLogger = &log.Logger
 
 	// ── Config ───────────────────────────────────────────────────────────────
 	cfg, err := config.Load()
@@ -115,10 +118,19 @@ func main() {
 	r.Get("/health", checker.HealthHandler)
 	r.Get("/ready", checker.ReadyHandler)
 
-	// ConnectRPC handlers
+	// ConnectRPC handlers. Only the BFF calls them, with the internal token
+	// and the user it acts for.
+	switch {
+	case cfg.InternalToken != "":
+	case cfg.EnableDev:
+		log.Warn().Msg("DEV-ONLY: INTERNAL_TOKEN is empty, any caller can run AI tasks")
+	default:
+		log.Fatal().Msg("INTERNAL_TOKEN is required")
+	}
+	authn := &auth.Authenticator{Token: cfg.InternalToken}
 	connectMux := http.NewServeMux()
 	connectMux.Handle(gen_ai_v1connect.NewAITaskServiceHandler(
-		handler.NewAITaskHandler(bf, log.Logger),
+		handler.NewAITaskHandler(bf, log.Logger), interceptor.HandlerOptions(authn),
 	))
 
 	// Route all ConnectRPC traffic
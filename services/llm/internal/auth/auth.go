@@ -0,0 +1,48 @@
+// Package auth authenticates calls to the LLM service. Users never call it
+// directly: the BFF authenticates and authorizes them, then forwards their
+// calls with the internal token and the user's identity (see package caller).
+package auth
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"net/http"
+
+	"connectrpc.com/connect"
+
+	"github.com/ApeironFoundation/axle/shared/caller"
+)
+
+// Authenticator admits calls that carry the internal token.
+type Authenticator struct {
+	// Token is the internal token. Empty admits every caller; that is
+	// DEV-ONLY.
+	Token string
+}
+
+// Authenticate checks the internal token in header and returns the user the
+// call is made for.
+func (a *Authenticator) Authenticate(header http.Header) (caller.Identity, error) {
+	if a.Token != "" && subtle.ConstantTimeCompare([]byte(caller.Token(header)), []byte(a.Token)) != 1 {
+		return caller.Identity{}, connect.NewError(connect.CodeUnauthenticated, errors.New("internal token required"))
+	}
+	id, err := caller.Parse(header)
+	if err != nil {
+		return caller.Identity{}, connect.NewError(connect.CodeUnauthenticated, err)
+	}
+	return id, nil
+}
+
+type principalKey struct{}
+
+// WithPrincipal returns a copy of ctx carrying the user a call is made for.
+func WithPrincipal(ctx context.Context, id caller.Identity) context.Context {
+	return context.WithValue(ctx, principalKey{}, id)
+}
+
+// PrincipalFromContext returns the user stored by WithPrincipal.
+func PrincipalFromContext(ctx context.Context) (caller.Identity, bool) {
+	id, ok := ctx.Value(principalKey{}).(caller.Identity)
+	return id, ok
+}
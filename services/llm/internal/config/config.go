@@ -13,6 +13,9 @@ type Config struct {
 	NatsURL     string // NATS_URL
 	LogLevel    string // LOG_LEVEL (default: info)
 	EnableDev   bool   // ENABLE_DEV_ENDPOINTS (default: false)
+	// InternalToken is the bearer token the BFF presents when it forwards
+	// calls.
+	InternalToken string // INTERNAL_TOKEN (required unless ENABLE_DEV_ENDPOINTS)
 
 	// Bifrost provider settings (at least one must be set for LLM calls to work).
 	OpenAIAPIKey    string // OPENAI_API_KEY
@@ -37,6 +40,7 @@ func Load() (*Config, error) {
 		NatsURL:         getEnv("NATS_URL", "nats://localhost:4222"),
 		LogLevel:        getEnv("LOG_LEVEL", "info"),
 		EnableDev:       getEnvBool("ENABLE_DEV_ENDPOINTS", false),
+		InternalToken:   os.Getenv("INTERNAL_TOKEN"),
 		OpenAIAPIKey:    os.Getenv("OPENAI_API_KEY"),
 		AnthropicAPIKey: os.Getenv("ANTHROPIC_API_KEY"),
 		DefaultModel:    defaultModel,
@@ -0,0 +1,31 @@
+package interceptor
+
+import (
+	"context"
+	"net/http"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	"github.com/ApeironFoundation/axle/llm/internal/auth"
+	shared "github.com/ApeironFoundation/axle/shared/interceptor"
+)
+
+// Auth authenticates every call as an internal one and stores the user it is
+// made for in the context (see auth.PrincipalFromContext). Handler logs are
+// tagged with the user's ID.
+func Auth(authn *auth.Authenticator) connect.Interceptor {
+	return shared.Auth(func(ctx context.Context, header http.Header) (context.Context, error) {
+		p, err := authn.Authenticate(header)
+		if err != nil {
+			return ctx, err
+		}
+		logCtx := log.Ctx(ctx).With().Str("user_id", p.UserID.String())
+		if p.TokenID != uuid.Nil {
+			logCtx = logCtx.Str("token_id", p.TokenID.String())
+		}
+		ctx = logCtx.Logger().WithContext(ctx)
+		return auth.WithPrincipal(ctx, p), nil
+	})
+}
@@ -0,0 +1,17 @@
+// Package interceptor holds the ConnectRPC interceptor chain that every
+// LLM service handler is mounted with.
+package interceptor
+
+import (
+	"connectrpc.com/connect"
+
+	"github.com/ApeironFoundation/axle/llm/internal/auth"
+	shared "github.com/ApeironFoundation/axle/shared/interceptor"
+)
+
+// HandlerOptions returns the options to pass to each New*ServiceHandler:
+// logging, panic recovery, authentication with authn and request validation,
+// outermost first.
+func HandlerOptions(authn *auth.Authenticator) connect.HandlerOption {
+	return connect.WithInterceptors(shared.Logging(), shared.Recover(), Auth(authn), shared.Validate())
+}
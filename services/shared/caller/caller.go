@@ -0,0 +1,65 @@
+// Package caller carries the end user a service acts for on calls between
+// Axle services. The BFF authenticates users; services behind it trust the
+// identity it forwards, and only from callers holding the internal token.
+package caller
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// Headers of an internal call.
+const (
+	// UserIDHeader holds the ID of the user the call is made for.
+	UserIDHeader = "Axle-Caller-User-Id"
+	// TokenIDHeader holds the ID of the personal access token the user
+	// authenticated with, if any.
+	TokenIDHeader = "Axle-Caller-Token-Id"
+)
+
+// Identity is the user an internal call is made for.
+type Identity struct {
+	UserID uuid.UUID
+	// TokenID is the personal access token the user authenticated with, or
+	// uuid.Nil for an OIDC session.
+	TokenID uuid.UUID
+}
+
+// Set writes the internal token and id to header.
+func Set(header http.Header, token string, id Identity) {
+	header.Set("Authorization", "Bearer "+token)
+	header.Set(UserIDHeader, id.UserID.String())
+	if id.TokenID != uuid.Nil {
+		header.Set(TokenIDHeader, id.TokenID.String())
+	} else {
+		header.Del(TokenIDHeader)
+	}
+}
+
+// Token returns the bearer token of header, or "" if it has none.
+func Token(header http.Header) string {
+	token, ok := strings.CutPrefix(header.Get("Authorization"), "Bearer ")
+	if !ok {
+		return ""
+	}
+	return token
+}
+
+// Parse reads the identity written by Set.
+func Parse(header http.Header) (Identity, error) {
+	var id Identity
+	userID, err := uuid.Parse(header.Get(UserIDHeader))
+	if err != nil || userID == uuid.Nil {
+		return id, errors.New("missing or invalid " + UserIDHeader)
+	}
+	id.UserID = userID
+	if v := header.Get(TokenIDHeader); v != "" {
+		if id.TokenID, err = uuid.Parse(v); err != nil {
+			return id, errors.New("invalid " + TokenIDHeader)
+		}
+	}
+	return id, nil
+}
@@ -0,0 +1,54 @@
+// Package interceptor holds the ConnectRPC interceptors every Axle service
+// mounts its handlers with. Each service composes them into its own chain,
+// adding the stages only it has:
+//
+//	Logging, Recover, Auth, ..., Validate, ...
+//
+// Logging is outermost, so panics recovered by Recover and calls rejected by
+// the later stages are still logged.
+package interceptor
+
+import (
+	"context"
+	"net/http"
+
+	"connectrpc.com/connect"
+)
+
+// AuthFunc authenticates a call from its request headers. It returns the
+// context the handler runs with, which carries the caller, or the error the
+// call fails with.
+type AuthFunc func(ctx context.Context, header http.Header) (context.Context, error)
+
+// Auth authenticates every call with fn before the rest of the chain runs.
+func Auth(fn AuthFunc) connect.Interceptor {
+	return authInterceptor{fn: fn}
+}
+
+type authInterceptor struct {
+	fn AuthFunc
+}
+
+func (a authInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		ctx, err := a.fn(ctx, req.Header())
+		if err != nil {
+			return nil, err
+		}
+		return next(ctx, req)
+	}
+}
+
+func (a authInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (a authInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		ctx, err := a.fn(ctx, conn.RequestHeader())
+		if err != nil {
+			return err
+		}
+		return next(ctx, conn)
+	}
+}
@@ -0,0 +1,77 @@
+package interceptor
+
+import (
+	"context"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+)
+
+// Logging logs one line per RPC with procedure, status code and duration.
+// Streaming calls are logged once, when the stream ends.
+func Logging() connect.Interceptor {
+	return loggingInterceptor{}
+}
+
+type loggingInterceptor struct{}
+
+func (loggingInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		start := time.Now()
+		res, err := next(ctx, req)
+		logCall(ctx, req.Spec(), req.Peer(), start, err)
+		return res, err
+	}
+}
+
+func (loggingInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (loggingInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		start := time.Now()
+		err := next(ctx, conn)
+		logCall(ctx, conn.Spec(), conn.Peer(), start, err)
+		return err
+	}
+}
+
+func logCall(ctx context.Context, spec connect.Spec, peer connect.Peer, start time.Time, err error) {
+	level, code := zerolog.InfoLevel, "ok"
+	if err != nil {
+		c := connect.CodeOf(err)
+		code = c.String()
+		switch c {
+		case connect.CodeInternal, connect.CodeUnknown, connect.CodeDataLoss, connect.CodeUnavailable:
+			level = zerolog.ErrorLevel
+		default:
+			level = zerolog.WarnLevel
+		}
+	}
+	ev := log.Ctx(ctx).WithLevel(level).
+		Str("procedure", spec.Procedure).
+		Str("stream_type", streamType(spec.StreamType)).
+		Str("code", code).
+		Dur("duration_ms", time.Since(start)).
+		Str("peer", peer.Addr)
+	if err != nil {
+		ev = ev.Err(err)
+	}
+	ev.Msg("rpc")
+}
+
+func streamType(t connect.StreamType) string {
+	switch t {
+	case connect.StreamTypeUnary:
+		return "unary"
+	case connect.StreamTypeClient:
+		return "client_stream"
+	case connect.StreamTypeServer:
+		return "server_stream"
+	default:
+		return "bidi_stream"
+	}
+}
@@ -0,0 +1,57 @@
+package interceptor
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"runtime/debug"
+
+	"connectrpc.com/connect"
+	"github.com/rs/zerolog/log"
+)
+
+// Recover turns a panic in a handler into a CodeInternal error instead of
+// tearing down the connection. http.ErrAbortHandler is re-raised untouched.
+func Recover() connect.Interceptor {
+	return recoverInterceptor{}
+}
+
+type recoverInterceptor struct{}
+
+func (recoverInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (res connect.AnyResponse, err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				err = panicError(ctx, req.Spec().Procedure, r)
+			}
+		}()
+		return next(ctx, req)
+	}
+}
+
+func (recoverInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (recoverInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) (err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				err = panicError(ctx, conn.Spec().Procedure, r)
+			}
+		}()
+		return next(ctx, conn)
+	}
+}
+
+func panicError(ctx context.Context, procedure string, r any) error {
+	if r == http.ErrAbortHandler {
+		panic(r)
+	}
+	log.Ctx(ctx).Error().
+		Str("procedure", procedure).
+		Interface("panic", r).
+		Bytes("stack", debug.Stack()).
+		Msg("recovered from panic")
+	return connect.NewError(connect.CodeInternal, errors.New("internal error"))
+}
@@ -0,0 +1,88 @@
+package interceptor
+
+import (
+	"context"
+	"errors"
+
+	"buf.build/go/protovalidate"
+	"connectrpc.com/connect"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/protobuf/proto"
+)
+
+// Validate checks every request message against its buf.validate annotations
+// before the handler runs. A message that breaks a rule fails with
+// CodeInvalidArgument and an errdetails.BadRequest listing each field
+// violation, so clients can point at the offending input.
+func Validate() connect.Interceptor {
+	return validateInterceptor{}
+}
+
+type validateInterceptor struct{}
+
+func (validateInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if err := validateMessage(ctx, req.Any()); err != nil {
+			return nil, err
+		}
+		return next(ctx, req)
+	}
+}
+
+func (validateInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (validateInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		return next(ctx, validatingConn{StreamingHandlerConn: conn, ctx: ctx})
+	}
+}
+
+// validatingConn validates each message as the handler receives it.
+type validatingConn struct {
+	connect.StreamingHandlerConn
+	ctx context.Context
+}
+
+func (c validatingConn) Receive(msg any) error {
+	if err := c.StreamingHandlerConn.Receive(msg); err != nil {
+		return err
+	}
+	return validateMessage(c.ctx, msg)
+}
+
+func validateMessage(ctx context.Context, msg any) error {
+	m, ok := msg.(proto.Message)
+	if !ok {
+		return nil
+	}
+	err := protovalidate.Validate(m)
+	if err == nil {
+		return nil
+	}
+	var verr *protovalidate.ValidationError
+	if !errors.As(err, &verr) {
+		// Compilation and runtime errors mean the rules themselves are broken.
+		log.Ctx(ctx).Error().Err(err).Msg("request validation failed")
+		return connect.NewError(connect.CodeInternal, errors.New("internal error"))
+	}
+	return invalidArgument(verr)
+}
+
+func invalidArgument(verr *protovalidate.ValidationError) error {
+	cerr := connect.NewError(connect.CodeInvalidArgument, verr)
+	badRequest := &errdetails.BadRequest{}
+	for _, v := range verr.Violations {
+		badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       protovalidate.FieldPathString(v.Proto.GetField()),
+			Description: v.Proto.GetMessage(),
+			Reason:      v.Proto.GetRuleId(),
+		})
+	}
+	if detail, err := connect.NewErrorDetail(badRequest); err == nil {
+		cerr.AddDetail(detail)
+	}
+	return cerr
+}